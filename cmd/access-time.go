@@ -0,0 +1,179 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/bucket/lifecycle"
+)
+
+const (
+	// objectAccessTimeKey - internal metadata holding the
+	// time an object version was last read.
+	objectAccessTimeKey = ReservedMetadataPrefixLower + "access-time"
+
+	// The recorded access times are saved this often.
+	accessTimeFlushInterval = time.Minute
+
+	// Maximum number of access times waiting to be saved, further
+	// reads are recorded again once the pending ones are saved.
+	accessTimeMaxPending = 10000
+)
+
+type accessTimeKey struct {
+	bucket    string
+	object    string
+	versionID string
+}
+
+// accessTimeTracker - records when objects are read and saves it in
+// their metadata, for the lifecycle transitions which are based on the
+// last access. Only the objects under such rules are tracked, and the
+// recorded access time of an object is updated at most once per
+// interval to keep reads from turning into metadata writes.
+type accessTimeTracker struct {
+	mu       sync.Mutex
+	enabled  bool
+	interval time.Duration
+	pending  map[accessTimeKey]time.Time
+}
+
+func newAccessTimeTracker() *accessTimeTracker {
+	return &accessTimeTracker{
+		enabled:  true,
+		interval: 24 * time.Hour,
+		pending:  make(map[accessTimeKey]time.Time),
+	}
+}
+
+// Update - updates the tracker settings from the scanner config.
+func (t *accessTimeTracker) Update(enabled bool, interval time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.enabled = enabled
+	t.interval = interval
+}
+
+// objectAccessTime - returns the time the object version was last read
+// as saved in its metadata, zero if it was not recorded.
+func objectAccessTime(objInfo ObjectInfo) time.Time {
+	v, ok := objInfo.UserDefined[objectAccessTimeKey]
+	if !ok {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// AccessTime - returns the time the object version was last read,
+// including the reads on this server which are not saved yet.
+func (t *accessTimeTracker) AccessTime(objInfo ObjectInfo) time.Time {
+	accessTime := objectAccessTime(objInfo)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if pending, ok := t.pending[accessTimeKey{objInfo.Bucket, objInfo.Name, objInfo.VersionID}]; ok && pending.After(accessTime) {
+		accessTime = pending
+	}
+	return accessTime
+}
+
+// Record - records that the object version was read.
+func (t *accessTimeTracker) Record(objInfo ObjectInfo) {
+	if objInfo.DeleteMarker || objInfo.TransitionStatus == lifecycle.TransitionComplete {
+		return
+	}
+
+	t.mu.Lock()
+	enabled, interval := t.enabled, t.interval
+	t.mu.Unlock()
+	if !enabled {
+		return
+	}
+
+	now := UTCNow()
+	lastAccess := objectAccessTime(objInfo)
+	if objInfo.ModTime.After(lastAccess) {
+		lastAccess = objInfo.ModTime
+	}
+	if now.Sub(lastAccess) < interval {
+		return
+	}
+
+	lc, err := globalLifecycleSys.Get(objInfo.Bucket)
+	if err != nil || !lc.TracksAccessTime(objInfo.Name) {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := accessTimeKey{objInfo.Bucket, objInfo.Name, objInfo.VersionID}
+	if _, ok := t.pending[key]; !ok && len(t.pending) >= accessTimeMaxPending {
+		return
+	}
+	t.pending[key] = now
+}
+
+// flush - saves the recorded access times in the object metadata.
+func (t *accessTimeTracker) flush(ctx context.Context, objAPI ObjectLayer) {
+	t.mu.Lock()
+	pending := t.pending
+	t.pending = make(map[accessTimeKey]time.Time)
+	t.mu.Unlock()
+
+	for key, accessTime := range pending {
+		_, err := objAPI.PutObjectMetadata(ctx, key.bucket, key.object, ObjectOptions{
+			VersionID: key.versionID,
+			UserDefined: map[string]string{
+				objectAccessTimeKey: accessTime.Format(time.RFC3339),
+			},
+		})
+		switch err.(type) {
+		case nil, ObjectNotFound, VersionNotFound, MethodNotAllowed, PoolInMaintenance:
+			// The object was removed meanwhile, or cannot be
+			// updated for now, its next read is recorded again.
+		default:
+			logger.LogIf(ctx, err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func initAccessTime(ctx context.Context, objAPI ObjectLayer) {
+	go func() {
+		ticker := time.NewTicker(accessTimeFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				globalAccessTimeTracker.flush(ctx, objAPI)
+			}
+		}
+	}()
+}
@@ -0,0 +1,65 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccessTimeTracker(t *testing.T) {
+	now := UTCNow().Truncate(time.Second)
+	saved := now.Add(-48 * time.Hour)
+	objInfo := ObjectInfo{
+		Bucket:  "bucket",
+		Name:    "object",
+		ModTime: now.Add(-72 * time.Hour),
+		UserDefined: map[string]string{
+			objectAccessTimeKey: saved.Format(time.RFC3339),
+		},
+	}
+
+	if got := objectAccessTime(objInfo); !got.Equal(saved) {
+		t.Fatalf("expected the saved access time %s, got %s", saved, got)
+	}
+	if got := objectAccessTime(ObjectInfo{}); !got.IsZero() {
+		t.Fatalf("expected no access time, got %s", got)
+	}
+
+	tracker := newAccessTimeTracker()
+	if got := tracker.AccessTime(objInfo); !got.Equal(saved) {
+		t.Fatalf("expected the saved access time %s, got %s", saved, got)
+	}
+
+	// Reads which are not saved yet are more recent.
+	tracker.pending[accessTimeKey{"bucket", "object", ""}] = now
+	if got := tracker.AccessTime(objInfo); !got.Equal(now) {
+		t.Fatalf("expected the pending access time %s, got %s", now, got)
+	}
+
+	// Objects read within the interval are not recorded again,
+	// the same goes for all objects when the tracking is off.
+	tracker = newAccessTimeTracker()
+	tracker.Update(true, 72*time.Hour)
+	tracker.Record(objInfo)
+	tracker.Update(false, time.Hour)
+	tracker.Record(objInfo)
+	if len(tracker.pending) != 0 {
+		t.Fatalf("expected no pending access time, got %d", len(tracker.pending))
+	}
+}
@@ -21,9 +21,14 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	xhttp "github.com/minio/minio/cmd/http"
 	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/event"
 	iampolicy "github.com/minio/minio/pkg/iam/policy"
 	"github.com/minio/minio/pkg/madmin"
 )
@@ -113,6 +118,85 @@ func (a adminAPIHandlers) GetBucketQuotaConfigHandler(w http.ResponseWriter, r *
 	writeSuccessResponseJSON(w, configData)
 }
 
+// PutBucketThrottleConfigHandler - PUT Bucket throttle configuration.
+// ----------
+// Places request rate and concurrency limits on the specified bucket,
+// the requests exceeding them are rejected with SlowDown.
+func (a adminAPIHandlers) PutBucketThrottleConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucketThrottleConfig")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.SetBucketThrottleAdminAction)
+	if objectAPI == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
+
+	if _, err = parseBucketThrottle(bucket, data); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+		return
+	}
+
+	if err = globalBucketMetadataSys.Update(bucket, bucketThrottleConfigFile, data); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketThrottleConfigHandler - gets bucket throttle configuration
+func (a adminAPIHandlers) GetBucketThrottleConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketThrottleConfig")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.GetBucketThrottleAdminAction)
+	if objectAPI == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := pathClean(vars["bucket"])
+
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	config, err := globalBucketMetadataSys.GetThrottleConfig(bucket)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	configData, err := json.Marshal(config)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, configData)
+}
+
 // SetRemoteTargetHandler - sets a remote target for bucket
 func (a adminAPIHandlers) SetRemoteTargetHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "SetBucketTarget")
@@ -293,3 +377,417 @@ func (a adminAPIHandlers) RemoveRemoteTargetHandler(w http.ResponseWriter, r *ht
 	// Write success response.
 	writeSuccessNoContent(w)
 }
+
+// ObjectLockReportHandler - GET /minio/admin/v3/object-lock-report?bucket=mybucket
+// ----------
+// Reports the object versions under retention or legal hold for the
+// specified bucket, or for all buckets with object lock enabled when
+// no bucket is specified.
+func (a adminAPIHandlers) ObjectLockReportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ObjectLockReport")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ObjectLockReportAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	var buckets []string
+	if bucket := r.URL.Query().Get("bucket"); bucket != "" {
+		if _, err := objectAPI.GetBucketInfo(ctx, bucket); err != nil {
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		buckets = append(buckets, bucket)
+	} else {
+		bucketsInfo, err := objectAPI.ListBuckets(ctx)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		for _, bucketInfo := range bucketsInfo {
+			if rcfg, err := globalBucketObjectLockSys.Get(bucketInfo.Name); err == nil && rcfg.LockEnabled {
+				buckets = append(buckets, bucketInfo.Name)
+			}
+		}
+	}
+
+	report := madmin.ObjectLockReport{
+		Generated: UTCNow(),
+		Buckets:   make([]madmin.ObjectLockBucketReport, 0, len(buckets)),
+	}
+	for _, bucket := range buckets {
+		bucketReport, err := getObjectLockBucketReport(ctx, objectAPI, bucket)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		report.Buckets = append(report.Buckets, bucketReport)
+	}
+
+	reportData, err := json.Marshal(report)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, reportData)
+}
+
+// ExportBucketHandler - GET /minio/admin/v3/export-bucket?bucket=mybucket
+// ----------
+// Streams an export of the object versions of the specified bucket,
+// optionally limited to a prefix and a time range, as a tar archive.
+// Versions created after the export started are not exported.
+func (a adminAPIHandlers) ExportBucketHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ExportBucket")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ExportBucketAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	info := madmin.BucketExportInfo{
+		Bucket: query.Get("bucket"),
+		Prefix: query.Get("prefix"),
+		Before: UTCNow(),
+	}
+
+	var err error
+	if after := query.Get("after"); after != "" {
+		if info.After, err = time.Parse(time.RFC3339Nano, after); err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+			return
+		}
+	}
+	if before := query.Get("before"); before != "" {
+		t, err := time.Parse(time.RFC3339Nano, before)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+			return
+		}
+		if t.Before(info.Before) {
+			info.Before = t
+		}
+	}
+	if latest := query.Get("latest"); latest != "" {
+		if info.LatestOnly, err = strconv.ParseBool(latest); err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+			return
+		}
+	}
+
+	if _, err = objectAPI.GetBucketInfo(ctx, info.Bucket); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	info.Versioned = globalBucketVersioningSys.Enabled(info.Bucket) || globalBucketVersioningSys.Suspended(info.Bucket)
+	if rcfg, err := globalBucketObjectLockSys.Get(info.Bucket); err == nil {
+		info.LockEnabled = rcfg.LockEnabled
+	}
+
+	meta, err := globalBucketMetadataSys.GetConfig(info.Bucket)
+	if err != nil && err != errConfigNotFound {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	w.Header().Set(xhttp.ContentType, "application/x-tar")
+	// Errors past this point cannot be reported to the client,
+	// the export then lacks its summary entry.
+	logger.LogIf(ctx, exportBucket(ctx, objectAPI, info, meta, w))
+}
+
+// ImportBucketHandler - POST /minio/admin/v3/import-bucket?bucket=mybucket&archive-bucket=b&archive-object=o
+// ----------
+// Restores a bucket from an archive created by ExportBucketHandler and
+// uploaded as an object, creating the bucket if it does not exist.
+// Whitespace is sent until the import is done, followed by the JSON
+// summary of the import.
+func (a adminAPIHandlers) ImportBucketHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ImportBucket")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ImportBucketAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	bucket := query.Get("bucket")
+	opts := madmin.ImportBucketOptions{
+		ArchiveBucket: query.Get("archive-bucket"),
+		ArchiveObject: query.Get("archive-object"),
+		Conflict:      madmin.BucketImportConflict(query.Get("conflict")),
+	}
+	switch opts.Conflict {
+	case "":
+		opts.Conflict = madmin.BucketImportSkip
+	case madmin.BucketImportSkip, madmin.BucketImportOverwrite, madmin.BucketImportFail:
+	default:
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+		return
+	}
+	if skipConfig := query.Get("skip-config"); skipConfig != "" {
+		var err error
+		if opts.SkipConfig, err = strconv.ParseBool(skipConfig); err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+			return
+		}
+	}
+
+	if err := s3utils.CheckValidBucketNameStrict(bucket); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidBucketName), r.URL)
+		return
+	}
+	if opts.ArchiveBucket == bucket {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+		return
+	}
+
+	gr, err := objectAPI.GetObjectNInfo(ctx, opts.ArchiveBucket, opts.ArchiveObject, nil, r.Header, readLock, ObjectOptions{})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	defer gr.Close()
+
+	type importResult struct {
+		summary madmin.BucketImportSummary
+		err     error
+	}
+	resultCh := make(chan importResult, 1)
+	go func() {
+		summary, err := importBucket(ctx, objectAPI, bucket, opts, gr)
+		resultCh <- importResult{summary: summary, err: err}
+	}()
+
+	w.Header().Set(xhttp.ContentType, "application/json")
+	keepAliveTicker := time.NewTicker(500 * time.Millisecond)
+	defer keepAliveTicker.Stop()
+
+	for {
+		select {
+		case result := <-resultCh:
+			if result.err != nil {
+				logger.LogIf(ctx, result.err)
+				result.summary.Error = result.err.Error()
+			}
+			data, err := json.Marshal(result.summary)
+			if err != nil {
+				logger.LogIf(ctx, err)
+				return
+			}
+			w.Write(data)
+			w.(http.Flusher).Flush()
+			return
+		case <-keepAliveTicker.C:
+			if _, err := w.Write([]byte(" ")); err != nil {
+				// The client went away, which cancels the import,
+				// wait for it to stop before closing the archive.
+				<-resultCh
+				return
+			}
+			w.(http.Flusher).Flush()
+		}
+	}
+}
+
+// ReplayEventsHandler - POST /minio/admin/v3/replay-events?bucket=mybucket&arn=arn&start=time
+// ----------
+// Sends the logged events of the specified bucket, optionally limited
+// to a prefix, emitted between start and end (now by default) again to
+// a notification target. Whitespace is sent until the replay is done,
+// followed by the JSON result of the replay.
+func (a adminAPIHandlers) ReplayEventsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ReplayEvents")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ReplayEventsAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	bucket, prefix := query.Get("bucket"), query.Get("prefix")
+	start, err := time.Parse(time.RFC3339Nano, query.Get("start"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+		return
+	}
+	end := UTCNow()
+	if e := query.Get("end"); e != "" {
+		if end, err = time.Parse(time.RFC3339Nano, e); err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+			return
+		}
+	}
+	arn, err := event.ParseARN(query.Get("arn"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+		return
+	}
+	if !globalNotificationSys.targetList.Exists(arn.TargetID) {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errEventReplayNoSuchTarget), r.URL)
+		return
+	}
+	if _, err = objectAPI.GetBucketInfo(ctx, bucket); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	resultCh := make(chan madmin.ReplayEventsResult, 1)
+	go func() {
+		replayed, err := replayEvents(ctx, objectAPI, bucket, prefix, start, end, arn.TargetID)
+		result := madmin.ReplayEventsResult{Replayed: replayed}
+		if err != nil {
+			logger.LogIf(ctx, err)
+			result.Error = err.Error()
+		}
+		resultCh <- result
+	}()
+
+	w.Header().Set(xhttp.ContentType, "application/json")
+	keepAliveTicker := time.NewTicker(500 * time.Millisecond)
+	defer keepAliveTicker.Stop()
+
+	for {
+		select {
+		case result := <-resultCh:
+			data, err := json.Marshal(result)
+			if err != nil {
+				logger.LogIf(ctx, err)
+				return
+			}
+			w.Write(data)
+			w.(http.Flusher).Flush()
+			return
+		case <-keepAliveTicker.C:
+			if _, err := w.Write([]byte(" ")); err != nil {
+				// The client went away, which cancels the replay.
+				<-resultCh
+				return
+			}
+			w.(http.Flusher).Flush()
+		}
+	}
+}
+
+// ListMultipartUploadsHandler - GET /minio/admin/v3/list-multipart-uploads?bucket=mybucket&prefix=prefix&older-than=24h&max-uploads=1000
+// ----------
+// Lists the in-progress multipart uploads of all buckets, or of the
+// specified bucket, oldest first.
+func (a adminAPIHandlers) ListMultipartUploadsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ListMultipartUploads")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ListMultipartUploadsAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	z, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := multipartUploadsFilter{
+		bucket: query.Get("bucket"),
+		prefix: query.Get("prefix"),
+	}
+	if v := query.Get("older-than"); v != "" {
+		olderThan, err := time.ParseDuration(v)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+			return
+		}
+		filter.olderThan = UTCNow().Add(-olderThan)
+	}
+	maxUploads := 1000
+	if v := query.Get("max-uploads"); v != "" {
+		var err error
+		if maxUploads, err = strconv.Atoi(v); err != nil || maxUploads <= 0 {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+			return
+		}
+	}
+	if filter.bucket != "" {
+		if _, err := objectAPI.GetBucketInfo(ctx, filter.bucket); err != nil {
+			writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+
+	uploads, err := z.listAllMultipartUploads(ctx, filter)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	result := madmin.ListMultipartUploadsResult{Uploads: uploads}
+	if len(uploads) > maxUploads {
+		result.Uploads = uploads[:maxUploads]
+		result.Truncated = true
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// AbortMultipartUploadsHandler - POST /minio/admin/v3/abort-multipart-uploads
+// ----------
+// Forcibly aborts the multipart uploads listed in the request body,
+// the uploads which could not be aborted are reported in the result.
+func (a adminAPIHandlers) AbortMultipartUploadsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "AbortMultipartUploads")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.AbortMultipartUploadsAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	var uploads []madmin.MultipartUploadInfo
+	if err := json.NewDecoder(r.Body).Decode(&uploads); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+		return
+	}
+
+	var result madmin.AbortMultipartUploadsResult
+	for _, u := range uploads {
+		err := objectAPI.AbortMultipartUpload(ctx, u.Bucket, u.Object, u.UploadID, ObjectOptions{})
+		if err != nil {
+			result.Errors = append(result.Errors, madmin.AbortMultipartUploadError{
+				Bucket:   u.Bucket,
+				Object:   u.Object,
+				UploadID: u.UploadID,
+				Error:    err.Error(),
+			})
+			continue
+		}
+		result.Aborted++
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
@@ -0,0 +1,202 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/cmd/logger"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// StartBatchJobHandler - POST /minio/admin/v3/batch-jobs/start
+// ----------
+// Starts a new batch job described by the JSON request body on
+// this node and returns its initial status.
+func (a adminAPIHandlers) StartBatchJobHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "StartBatchJob")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.BatchJobAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	reqBytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+		return
+	}
+	var req madmin.BatchJobRequest
+	if err = json.Unmarshal(reqBytes, &req); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+		return
+	}
+
+	if _, err = objectAPI.GetBucketInfo(ctx, req.Bucket); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	status, err := globalBatchJobsSys.Start(ctx, objectAPI, req)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// ListBatchJobsHandler - GET /minio/admin/v3/batch-jobs/list
+// ----------
+// Lists the status of all batch jobs in the cluster.
+func (a adminAPIHandlers) ListBatchJobsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ListBatchJobs")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.BatchJobAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jobs, err := globalBatchJobsSys.List(ctx, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if jobs == nil {
+		jobs = []madmin.BatchJobStatus{}
+	}
+
+	data, err := json.Marshal(jobs)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// BatchJobStatusHandler - GET /minio/admin/v3/batch-jobs/status?id=jobid
+// ----------
+// Returns the progress of a batch job.
+func (a adminAPIHandlers) BatchJobStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "BatchJobStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.BatchJobAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	status, err := globalBatchJobsSys.Status(ctx, objectAPI, r.URL.Query().Get("id"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// CancelBatchJobHandler - POST /minio/admin/v3/batch-jobs/cancel?id=jobid
+// ----------
+// Cancels a running batch job.
+func (a adminAPIHandlers) CancelBatchJobHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "CancelBatchJob")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.BatchJobAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if err := globalBatchJobsSys.Cancel(ctx, objectAPI, r.URL.Query().Get("id")); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// RemoveBatchJobHandler - DELETE /minio/admin/v3/batch-jobs/remove?id=jobid
+// ----------
+// Removes the status and the result manifest of a batch job
+// which is no longer running.
+func (a adminAPIHandlers) RemoveBatchJobHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "RemoveBatchJob")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.BatchJobAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if err := globalBatchJobsSys.Remove(ctx, objectAPI, r.URL.Query().Get("id")); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// BatchJobManifestHandler - GET /minio/admin/v3/batch-jobs/manifest?id=jobid
+// ----------
+// Streams the result manifest of a batch job as CSV, one
+// "object,versionId,result,error" record per processed version.
+func (a adminAPIHandlers) BatchJobManifestHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "BatchJobManifest")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.BatchJobAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	id := r.URL.Query().Get("id")
+	if _, err := globalBatchJobsSys.Status(ctx, objectAPI, id); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	w.Header().Set(xhttp.ContentType, "text/csv")
+	// Errors past this point cannot be reported to the
+	// client since the response has already started.
+	logger.LogIf(ctx, globalBatchJobsSys.WriteManifest(ctx, objectAPI, id, w))
+}
@@ -477,3 +477,84 @@ func (a adminAPIHandlers) GetConfigHandler(w http.ResponseWriter, r *http.Reques
 
 	writeSuccessResponseJSON(w, econfigData)
 }
+
+// configBackupBucket returns the bucket given in the request, or the
+// bucket set in the backup configuration.
+func configBackupBucket(r *http.Request) string {
+	if bucket := r.URL.Query().Get("bucket"); bucket != "" {
+		return bucket
+	}
+	return getBackupConfig().Bucket
+}
+
+// ListConfigBackupsHandler - GET /minio/admin/v3/config-backups?bucket={bucket}
+// ----------
+// Lists the backups of the IAM and server configuration, oldest first.
+func (a adminAPIHandlers) ListConfigBackupsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ListConfigBackups")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	_, objectAPI := validateAdminReqConfigKV(ctx, w, r)
+	if objectAPI == nil {
+		return
+	}
+
+	bucket := configBackupBucket(r)
+	if bucket == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidBucketName), r.URL)
+		return
+	}
+
+	backups, err := listConfigBackups(ctx, objectAPI, bucket)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if backups == nil {
+		backups = []madmin.ConfigBackupInfo{}
+	}
+
+	data, err := json.Marshal(backups)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// RestoreConfigBackupHandler - POST /minio/admin/v3/restore-config-backup?bucket={bucket}&name={name}
+// ----------
+// Restores the IAM and server configuration from a backup, the
+// servers must be restarted for it to take effect.
+func (a adminAPIHandlers) RestoreConfigBackupHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "RestoreConfigBackup")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	_, objectAPI := validateAdminReqConfigKV(ctx, w, r)
+	if objectAPI == nil {
+		return
+	}
+
+	bucket := configBackupBucket(r)
+	if bucket == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidBucketName), r.URL)
+		return
+	}
+
+	summary, err := restoreConfigBackup(ctx, objectAPI, bucket, r.URL.Query().Get("name"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(summary)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
@@ -0,0 +1,136 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/minio/minio/cmd/logger"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// AddTenantHandler - PUT /minio/admin/v3/add-tenant
+// ----------
+// Creates a tenant and its root user, the request body holds the
+// encrypted madmin.AddTenantReq.
+func (a adminAPIHandlers) AddTenantHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "AddTenant")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, cred := validateAdminUsersReq(ctx, w, r, iampolicy.TenantAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if r.ContentLength > maxEConfigJSONSize || r.ContentLength == -1 {
+		// More than maxConfigSize bytes were available
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminConfigTooLarge), r.URL)
+		return
+	}
+
+	reqBytes, err := madmin.DecryptData(cred.SecretKey, io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		logger.LogIf(ctx, err)
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminConfigBadJSON), r.URL)
+		return
+	}
+
+	var req madmin.AddTenantReq
+	if err = json.Unmarshal(reqBytes, &req); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminConfigBadJSON, err), r.URL)
+		return
+	}
+
+	if _, err = globalTenantSys.Add(ctx, objectAPI, req); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Notify all other MinIO peers to load the root user.
+	for _, nerr := range globalNotificationSys.LoadUser(req.AccessKey, false) {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+}
+
+// RemoveTenantHandler - DELETE /minio/admin/v3/remove-tenant?name=<tenant>
+// ----------
+// Removes a tenant which has no buckets left and all of its users.
+func (a adminAPIHandlers) RemoveTenantHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "RemoveTenant")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminUsersReq(ctx, w, r, iampolicy.TenantAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	removed, err := globalTenantSys.Remove(ctx, objectAPI, mux.Vars(r)["name"])
+
+	// Notify all other MinIO peers to delete the users removed,
+	// even if not all of them could be.
+	for _, accessKey := range removed {
+		for _, nerr := range globalNotificationSys.DeleteUser(accessKey) {
+			if nerr.Err != nil {
+				logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+				logger.LogIf(ctx, nerr.Err)
+			}
+		}
+	}
+
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+}
+
+// ListTenantsHandler - GET /minio/admin/v3/list-tenants
+// ----------
+// Lists the tenants of the deployment.
+func (a adminAPIHandlers) ListTenantsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ListTenants")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminUsersReq(ctx, w, r, iampolicy.TenantAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	tenants, err := globalTenantSys.List(ctx, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(tenants)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
@@ -36,14 +36,17 @@ import (
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/minio/minio-go/v7/pkg/set"
 	"github.com/minio/minio/cmd/config"
 	"github.com/minio/minio/cmd/crypto"
 	xhttp "github.com/minio/minio/cmd/http"
 	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/cmd/logger/message/audit"
 	"github.com/minio/minio/cmd/logger/message/log"
 	"github.com/minio/minio/pkg/auth"
 	"github.com/minio/minio/pkg/bandwidth"
 	"github.com/minio/minio/pkg/dsync"
+	"github.com/minio/minio/pkg/event"
 	"github.com/minio/minio/pkg/handlers"
 	iampolicy "github.com/minio/minio/pkg/iam/policy"
 	"github.com/minio/minio/pkg/kms"
@@ -314,6 +317,38 @@ func (a adminAPIHandlers) StorageInfoHandler(w http.ResponseWriter, r *http.Requ
 
 }
 
+// PoolsStatusHandler - GET /minio/admin/v3/pools/status
+// ----------
+// Get drive, capacity, quorum and healing status of every erasure set
+// in all the pools.
+func (a adminAPIHandlers) PoolsStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PoolsStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.StorageInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	z, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	// Heal status is best effort, ignore any errors here.
+	healState, _ := getAggregatedBackgroundHealState(ctx, objectAPI)
+
+	jsonBytes, err := json.Marshal(z.PoolsStatus(ctx, healState))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
 // DataUsageInfoHandler - GET /minio/admin/v3/datausage
 // ----------
 // Get server/cluster data usage info
@@ -344,13 +379,15 @@ func (a adminAPIHandlers) DataUsageInfoHandler(w http.ResponseWriter, r *http.Re
 
 func lriToLockEntry(l lockRequesterInfo, resource, server string) *madmin.LockEntry {
 	entry := &madmin.LockEntry{
-		Timestamp:  l.Timestamp,
-		Resource:   resource,
-		ServerList: []string{server},
-		Source:     l.Source,
-		Owner:      l.Owner,
-		ID:         l.UID,
-		Quorum:     l.Quorum,
+		Timestamp:   l.Timestamp,
+		Resource:    resource,
+		ServerList:  []string{server},
+		Source:      l.Source,
+		Owner:       l.Owner,
+		ID:          l.UID,
+		Quorum:      l.Quorum,
+		Elapsed:     UTCNow().Sub(l.Timestamp),
+		LastRefresh: l.TimeLastRefresh,
 	}
 	if l.Writer {
 		entry.Type = "WRITE"
@@ -360,7 +397,7 @@ func lriToLockEntry(l lockRequesterInfo, resource, server string) *madmin.LockEn
 	return entry
 }
 
-func topLockEntries(peerLocks []*PeerLocks, stale bool) madmin.LockEntries {
+func topLockEntries(peerLocks []*PeerLocks, stale bool, olderThan time.Duration) madmin.LockEntries {
 	entryMap := make(map[string]*madmin.LockEntry)
 	for _, peerLock := range peerLocks {
 		if peerLock == nil {
@@ -378,6 +415,9 @@ func topLockEntries(peerLocks []*PeerLocks, stale bool) madmin.LockEntries {
 	}
 	var lockEntries madmin.LockEntries
 	for _, v := range entryMap {
+		if v.Elapsed < olderThan {
+			continue
+		}
 		if stale {
 			lockEntries = append(lockEntries, *v)
 			continue
@@ -416,23 +456,64 @@ func (a adminAPIHandlers) ForceUnlockHandler(w http.ResponseWriter, r *http.Requ
 	vars := mux.Vars(r)
 
 	var args dsync.LockArgs
+	args.UID = r.URL.Query().Get("id")
 	lockersMap := make(map[string]dsync.NetLocker)
 	for _, path := range strings.Split(vars["paths"], ",") {
 		if path == "" {
 			continue
 		}
 		args.Resources = append(args.Resources, path)
-		lockers, _ := z.serverPools[0].getHashedSet(path).getLockers()
-		for _, locker := range lockers {
-			if locker != nil {
-				lockersMap[locker.String()] = locker
+		for _, pool := range z.serverPools {
+			lockers, _ := pool.getHashedSet(path).getLockers()
+			for _, locker := range lockers {
+				if locker != nil {
+					lockersMap[locker.String()] = locker
+				}
 			}
 		}
 	}
+	if len(args.Resources) == 0 {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		return
+	}
 
+	var released []string
 	for _, locker := range lockersMap {
-		locker.ForceUnlock(ctx, args)
+		ok, err := locker.ForceUnlock(ctx, args)
+		if err != nil {
+			logger.LogIf(ctx, fmt.Errorf("Unable to force unlock %s on %s: %w", args.Resources, locker, err))
+			continue
+		}
+		if ok {
+			released = append(released, locker.String())
+		}
 	}
+	sort.Strings(released)
+	auditLogForceUnlock(ctx, r, args, released)
+}
+
+// auditLogForceUnlock - sends a dedicated audit entry whenever
+// locks are forcibly released, with the lock servers which
+// released them.
+func auditLogForceUnlock(ctx context.Context, r *http.Request, args dsync.LockArgs, released []string) {
+	entry := audit.NewEntry(globalDeploymentID)
+	entry.Trigger = "force-unlock"
+	entry.RemoteHost = handlers.GetSourceIP(r)
+	entry.UserAgent = r.UserAgent()
+	entry.Tags = map[string]interface{}{
+		"resources": args.Resources,
+		"servers":   released,
+	}
+	if args.UID != "" {
+		entry.Tags["lockId"] = args.UID
+	}
+	if reqInfo := logger.GetReqInfo(ctx); reqInfo != nil {
+		entry.API.Name = reqInfo.API
+		entry.RequestID = reqInfo.RequestID
+		entry.Tags["accessKey"] = reqInfo.AccessKey
+	}
+	ctx = logger.SetAuditEntry(ctx, &entry)
+	logger.AuditLog(ctx, nil, nil, nil)
 }
 
 // TopLocksHandler Get list of locks in use
@@ -456,10 +537,19 @@ func (a adminAPIHandlers) TopLocksHandler(w http.ResponseWriter, r *http.Request
 		}
 	}
 	stale := r.URL.Query().Get("stale") == "true" // list also stale locks
+	var olderThan time.Duration
+	if olderThanStr := r.URL.Query().Get("older-than"); olderThanStr != "" {
+		var err error
+		olderThan, err = time.ParseDuration(olderThanStr)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+			return
+		}
+	}
 
 	peerLocks := globalNotificationSys.GetLocks(ctx, r)
 
-	topLocks := topLockEntries(peerLocks, stale)
+	topLocks := topLockEntries(peerLocks, stale, olderThan)
 
 	// Marshal API response upto requested count.
 	if len(topLocks) > count && count > 0 {
@@ -876,6 +966,7 @@ func getAggregatedBackgroundHealState(ctx context.Context, o ObjectLayer) (madmi
 		}
 		bgHealStates.Merge(peersHealStates...)
 	}
+	bgHealStates.EstimateHealProgress()
 
 	return bgHealStates, nil
 }
@@ -1322,6 +1413,108 @@ func (a adminAPIHandlers) KMSKeyStatusHandler(w http.ResponseWriter, r *http.Req
 	writeSuccessResponseJSON(w, resp)
 }
 
+// collectHealthInfo - collects the health information selected in
+// query from all the servers, partialWrite is called every time
+// more information is available.
+func collectHealthInfo(ctx context.Context, objectAPI ObjectLayer, r *http.Request, query url.Values, partialWrite func(madmin.HealthInfo)) madmin.HealthInfo {
+	healthInfo := madmin.HealthInfo{}
+
+	if cpu := query.Get("syscpu"); cpu == "true" {
+		cpuInfo := getLocalCPUInfo(ctx, r)
+
+		healthInfo.Sys.CPUInfo = append(healthInfo.Sys.CPUInfo, cpuInfo)
+		healthInfo.Sys.CPUInfo = append(healthInfo.Sys.CPUInfo, globalNotificationSys.CPUInfo(ctx)...)
+		partialWrite(healthInfo)
+	}
+
+	if diskHw := query.Get("sysdiskhw"); diskHw == "true" {
+		diskHwInfo := getLocalDiskHwInfo(ctx, r)
+
+		healthInfo.Sys.DiskHwInfo = append(healthInfo.Sys.DiskHwInfo, diskHwInfo)
+		healthInfo.Sys.DiskHwInfo = append(healthInfo.Sys.DiskHwInfo, globalNotificationSys.DiskHwInfo(ctx)...)
+		partialWrite(healthInfo)
+	}
+
+	if osInfo := query.Get("sysosinfo"); osInfo == "true" {
+		osInfo := getLocalOsInfo(ctx, r)
+
+		healthInfo.Sys.OsInfo = append(healthInfo.Sys.OsInfo, osInfo)
+		healthInfo.Sys.OsInfo = append(healthInfo.Sys.OsInfo, globalNotificationSys.OsInfo(ctx)...)
+		partialWrite(healthInfo)
+	}
+
+	if mem := query.Get("sysmem"); mem == "true" {
+		memInfo := getLocalMemInfo(ctx, r)
+
+		healthInfo.Sys.MemInfo = append(healthInfo.Sys.MemInfo, memInfo)
+		healthInfo.Sys.MemInfo = append(healthInfo.Sys.MemInfo, globalNotificationSys.MemInfo(ctx)...)
+		partialWrite(healthInfo)
+	}
+
+	if proc := query.Get("sysprocess"); proc == "true" {
+		procInfo := getLocalProcInfo(ctx, r)
+
+		healthInfo.Sys.ProcInfo = append(healthInfo.Sys.ProcInfo, procInfo)
+		healthInfo.Sys.ProcInfo = append(healthInfo.Sys.ProcInfo, globalNotificationSys.ProcInfo(ctx)...)
+		partialWrite(healthInfo)
+	}
+
+	if config := query.Get("minioconfig"); config == "true" {
+		cfg, err := readServerConfig(ctx, objectAPI)
+		logger.LogIf(ctx, err)
+		healthInfo.Minio.Config = cfg
+		partialWrite(healthInfo)
+	}
+
+	if drive := query.Get("perfdrive"); drive == "true" {
+		// Get drive perf details from local server's drive(s)
+		drivePerfSerial := getLocalDrives(ctx, false, globalEndpoints, r)
+		drivePerfParallel := getLocalDrives(ctx, true, globalEndpoints, r)
+
+		errStr := ""
+		if drivePerfSerial.Error != "" {
+			errStr = "serial: " + drivePerfSerial.Error
+		}
+		if drivePerfParallel.Error != "" {
+			errStr = errStr + " parallel: " + drivePerfParallel.Error
+		}
+
+		driveInfo := madmin.ServerDrivesInfo{
+			Addr:     drivePerfSerial.Addr,
+			Serial:   drivePerfSerial.Serial,
+			Parallel: drivePerfParallel.Parallel,
+			Error:    errStr,
+		}
+		healthInfo.Perf.DriveInfo = append(healthInfo.Perf.DriveInfo, driveInfo)
+		partialWrite(healthInfo)
+
+		// Notify all other MinIO peers to report drive perf numbers
+		driveInfos := globalNotificationSys.DrivePerfInfoChan(ctx)
+		for obd := range driveInfos {
+			healthInfo.Perf.DriveInfo = append(healthInfo.Perf.DriveInfo, obd)
+			partialWrite(healthInfo)
+		}
+		partialWrite(healthInfo)
+	}
+
+	if net := query.Get("perfnet"); net == "true" && globalIsDistErasure {
+		healthInfo.Perf.Net = append(healthInfo.Perf.Net, globalNotificationSys.NetInfo(ctx))
+		partialWrite(healthInfo)
+
+		netInfos := globalNotificationSys.DispatchNetPerfChan(ctx)
+		for netInfo := range netInfos {
+			healthInfo.Perf.Net = append(healthInfo.Perf.Net, netInfo)
+			partialWrite(healthInfo)
+		}
+		partialWrite(healthInfo)
+
+		healthInfo.Perf.NetParallel = globalNotificationSys.NetPerfParallelInfo(ctx)
+		partialWrite(healthInfo)
+	}
+
+	return healthInfo
+}
+
 // HealthInfoHandler - GET /minio/admin/v3/healthinfo
 // ----------
 // Get server health info
@@ -1382,100 +1575,7 @@ func (a adminAPIHandlers) HealthInfoHandler(w http.ResponseWriter, r *http.Reque
 
 	go func() {
 		defer close(healthInfoCh)
-
-		if cpu := query.Get("syscpu"); cpu == "true" {
-			cpuInfo := getLocalCPUInfo(deadlinedCtx, r)
-
-			healthInfo.Sys.CPUInfo = append(healthInfo.Sys.CPUInfo, cpuInfo)
-			healthInfo.Sys.CPUInfo = append(healthInfo.Sys.CPUInfo, globalNotificationSys.CPUInfo(deadlinedCtx)...)
-			partialWrite(healthInfo)
-		}
-
-		if diskHw := query.Get("sysdiskhw"); diskHw == "true" {
-			diskHwInfo := getLocalDiskHwInfo(deadlinedCtx, r)
-
-			healthInfo.Sys.DiskHwInfo = append(healthInfo.Sys.DiskHwInfo, diskHwInfo)
-			healthInfo.Sys.DiskHwInfo = append(healthInfo.Sys.DiskHwInfo, globalNotificationSys.DiskHwInfo(deadlinedCtx)...)
-			partialWrite(healthInfo)
-		}
-
-		if osInfo := query.Get("sysosinfo"); osInfo == "true" {
-			osInfo := getLocalOsInfo(deadlinedCtx, r)
-
-			healthInfo.Sys.OsInfo = append(healthInfo.Sys.OsInfo, osInfo)
-			healthInfo.Sys.OsInfo = append(healthInfo.Sys.OsInfo, globalNotificationSys.OsInfo(deadlinedCtx)...)
-			partialWrite(healthInfo)
-		}
-
-		if mem := query.Get("sysmem"); mem == "true" {
-			memInfo := getLocalMemInfo(deadlinedCtx, r)
-
-			healthInfo.Sys.MemInfo = append(healthInfo.Sys.MemInfo, memInfo)
-			healthInfo.Sys.MemInfo = append(healthInfo.Sys.MemInfo, globalNotificationSys.MemInfo(deadlinedCtx)...)
-			partialWrite(healthInfo)
-		}
-
-		if proc := query.Get("sysprocess"); proc == "true" {
-			procInfo := getLocalProcInfo(deadlinedCtx, r)
-
-			healthInfo.Sys.ProcInfo = append(healthInfo.Sys.ProcInfo, procInfo)
-			healthInfo.Sys.ProcInfo = append(healthInfo.Sys.ProcInfo, globalNotificationSys.ProcInfo(deadlinedCtx)...)
-			partialWrite(healthInfo)
-		}
-
-		if config := query.Get("minioconfig"); config == "true" {
-			cfg, err := readServerConfig(ctx, objectAPI)
-			logger.LogIf(ctx, err)
-			healthInfo.Minio.Config = cfg
-			partialWrite(healthInfo)
-		}
-
-		if drive := query.Get("perfdrive"); drive == "true" {
-			// Get drive perf details from local server's drive(s)
-			drivePerfSerial := getLocalDrives(deadlinedCtx, false, globalEndpoints, r)
-			drivePerfParallel := getLocalDrives(deadlinedCtx, true, globalEndpoints, r)
-
-			errStr := ""
-			if drivePerfSerial.Error != "" {
-				errStr = "serial: " + drivePerfSerial.Error
-			}
-			if drivePerfParallel.Error != "" {
-				errStr = errStr + " parallel: " + drivePerfParallel.Error
-			}
-
-			driveInfo := madmin.ServerDrivesInfo{
-				Addr:     drivePerfSerial.Addr,
-				Serial:   drivePerfSerial.Serial,
-				Parallel: drivePerfParallel.Parallel,
-				Error:    errStr,
-			}
-			healthInfo.Perf.DriveInfo = append(healthInfo.Perf.DriveInfo, driveInfo)
-			partialWrite(healthInfo)
-
-			// Notify all other MinIO peers to report drive perf numbers
-			driveInfos := globalNotificationSys.DrivePerfInfoChan(deadlinedCtx)
-			for obd := range driveInfos {
-				healthInfo.Perf.DriveInfo = append(healthInfo.Perf.DriveInfo, obd)
-				partialWrite(healthInfo)
-			}
-			partialWrite(healthInfo)
-		}
-
-		if net := query.Get("perfnet"); net == "true" && globalIsDistErasure {
-			healthInfo.Perf.Net = append(healthInfo.Perf.Net, globalNotificationSys.NetInfo(deadlinedCtx))
-			partialWrite(healthInfo)
-
-			netInfos := globalNotificationSys.DispatchNetPerfChan(deadlinedCtx)
-			for netInfo := range netInfos {
-				healthInfo.Perf.Net = append(healthInfo.Perf.Net, netInfo)
-				partialWrite(healthInfo)
-			}
-			partialWrite(healthInfo)
-
-			healthInfo.Perf.NetParallel = globalNotificationSys.NetPerfParallelInfo(deadlinedCtx)
-			partialWrite(healthInfo)
-		}
-
+		collectHealthInfo(deadlinedCtx, objectAPI, r, query, partialWrite)
 	}()
 
 	ticker := time.NewTicker(5 * time.Second)
@@ -1502,6 +1602,58 @@ func (a adminAPIHandlers) HealthInfoHandler(w http.ResponseWriter, r *http.Reque
 
 }
 
+// ListHealthReportsHandler - GET /minio/admin/v3/health-reports
+// ----------
+// Lists the health reports collected as set in the diagnostics
+// configuration, oldest first.
+func (a adminAPIHandlers) ListHealthReportsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ListHealthReports")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealthInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	reports, err := listHealthReports(ctx, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(reports)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// GetHealthReportHandler - GET /minio/admin/v3/health-report?name={name}
+// ----------
+// Returns a health report collected as set in the diagnostics
+// configuration.
+func (a adminAPIHandlers) GetHealthReportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetHealthReport")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealthInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	data, err := readHealthReport(ctx, objectAPI, r.URL.Query().Get("name"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
 // BandwidthMonitorHandler - GET /minio/admin/v3/bandwidth
 // ----------
 // Get bandwidth consumption information
@@ -1558,6 +1710,432 @@ func (a adminAPIHandlers) BandwidthMonitorHandler(w http.ResponseWriter, r *http
 	}
 }
 
+// CopyProgressHandler - GET /minio/admin/v3/copy-progress
+// ----------
+// Lists the server-side copies in progress on all servers, oldest first.
+func (a adminAPIHandlers) CopyProgressHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "CopyProgress")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.CopyProgressAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	data, err := json.Marshal(globalNotificationSys.GetCopyProgress(ctx))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// GetLogLevelsHandler - GET /minio/admin/v3/log-level
+// ----------
+// Returns the log level of every subsystem on all servers.
+func (a adminAPIHandlers) GetLogLevelsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetLogLevels")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.LogLevelAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	data, err := json.Marshal(globalNotificationSys.GetLogLevels(ctx))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// SetLogLevelHandler - POST /minio/admin/v3/log-level?subsys={subsys}&level={level}&nodes={nodes}
+// ----------
+// Sets the log level of a subsystem, of all subsystems when subsys is
+// empty, on the comma separated list of nodes, or on all servers when
+// no nodes are given.
+func (a adminAPIHandlers) SetLogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "SetLogLevel")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.LogLevelAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	subsys := query.Get("subsys")
+	level := madmin.LogLevel(query.Get("level"))
+	if err := globalDebugLog.validate(subsys, level); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+		return
+	}
+
+	nodes := set.NewStringSet()
+	for _, node := range strings.Split(query.Get("nodes"), ",") {
+		if node != "" {
+			nodes.Add(node)
+		}
+	}
+
+	data, err := json.Marshal(globalNotificationSys.SetLogLevel(ctx, subsys, level, nodes))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// NotificationTargetsStatusHandler - GET /minio/admin/v3/notification-targets
+// ----------
+// Returns the connection and delivery state of the notification targets
+// on all servers, connecting the targets not connected yet.
+func (a adminAPIHandlers) NotificationTargetsStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "NotificationTargetsStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.NotificationTargetsAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	data, err := json.Marshal(globalNotificationSys.NotificationTargetsStatus(ctx))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// ReconnectNotificationTargetHandler - POST /minio/admin/v3/notification-targets/reconnect?target={target}
+// ----------
+// Connects the notification target on all servers where it is not
+// connected and returns its state.
+func (a adminAPIHandlers) ReconnectNotificationTargetHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ReconnectNotificationTarget")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	a.notificationTargetHandler(ctx, w, r, globalNotificationSys.ReconnectNotificationTarget)
+}
+
+// SendTestNotificationHandler - POST /minio/admin/v3/notification-targets/test?target={target}
+// ----------
+// Sends an s3:TestEvent event to the notification target from all
+// servers and returns its state.
+func (a adminAPIHandlers) SendTestNotificationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "SendTestNotification")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	a.notificationTargetHandler(ctx, w, r, globalNotificationSys.SendTestNotification)
+}
+
+func (a adminAPIHandlers) notificationTargetHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, fn func(context.Context, event.TargetID) []madmin.NotificationTargetStatus) {
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.NotificationTargetsAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	targetID, err := event.ParseTargetID(r.URL.Query().Get("target"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+		return
+	}
+
+	statuses := fn(ctx, *targetID)
+	if len(statuses) == 0 {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, errNotificationTargetNotFound), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(statuses)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// MaintenanceStatusHandler - GET /minio/admin/v3/maintenance
+// ----------
+// Returns the nodes and pools in maintenance mode.
+func (a adminAPIHandlers) MaintenanceStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "MaintenanceStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.MaintenanceAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	data, err := json.Marshal(globalMaintenanceSys.Status())
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// SetMaintenanceHandler - POST /minio/admin/v3/maintenance?node={host:port}|pool={index}&enable={bool}
+// ----------
+// Puts a node or a pool into maintenance mode, or takes it out of it,
+// and returns the nodes and pools in maintenance mode.
+func (a adminAPIHandlers) SetMaintenanceHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "SetMaintenance")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.MaintenanceAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	enable, err := strconv.ParseBool(query.Get("enable"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+		return
+	}
+
+	var status madmin.MaintenanceStatus
+	switch node, pool := query.Get("node"), query.Get("pool"); {
+	case node != "" && pool == "":
+		status, err = globalMaintenanceSys.SetNode(ctx, objectAPI, node, enable)
+	case pool != "" && node == "":
+		var idx int
+		if idx, err = strconv.Atoi(pool); err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+			return
+		}
+		status, err = globalMaintenanceSys.SetPool(ctx, objectAPI, idx, enable)
+	default:
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument,
+			errors.New("exactly one of node or pool must be given")), r.URL)
+		return
+	}
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Notify all other MinIO peers to reload the maintenance state.
+	for _, nerr := range globalNotificationSys.LoadMaintenance(ctx) {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// InflightRequestsHandler - GET /minio/admin/v3/requests
+// ----------
+// Returns the S3 requests being served by all servers, the longest
+// running first.
+func (a adminAPIHandlers) InflightRequestsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "InflightRequests")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ListRequestsAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	data, err := json.Marshal(globalNotificationSys.InflightRequests(ctx))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// CancelRequestHandler - POST /minio/admin/v3/requests/cancel?id={id}
+// ----------
+// Cancels the S3 request with the given x-amz-request-id on the server
+// serving it.
+func (a adminAPIHandlers) CancelRequestHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "CancelRequest")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.CancelRequestAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument,
+			errors.New("request id must be given")), r.URL)
+		return
+	}
+
+	node, err := globalNotificationSys.CancelRequest(ctx, id)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	auditLogCancelRequest(ctx, r, id, node)
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// auditLogCancelRequest - sends a dedicated audit entry whenever an
+// S3 request is canceled, with the server which was serving it.
+func auditLogCancelRequest(ctx context.Context, r *http.Request, id, node string) {
+	entry := audit.NewEntry(globalDeploymentID)
+	entry.Trigger = "cancel-request"
+	entry.RemoteHost = handlers.GetSourceIP(r)
+	entry.UserAgent = r.UserAgent()
+	entry.Tags = map[string]interface{}{
+		"canceledRequestId": id,
+		"server":            node,
+	}
+	if reqInfo := logger.GetReqInfo(ctx); reqInfo != nil {
+		entry.API.Name = reqInfo.API
+		entry.RequestID = reqInfo.RequestID
+		entry.Tags["accessKey"] = reqInfo.AccessKey
+	}
+	ctx = logger.SetAuditEntry(ctx, &entry)
+	logger.AuditLog(ctx, nil, nil, nil)
+}
+
+// BreakGlassStatusHandler - GET /minio/admin/v3/breakglass
+// ----------
+// Returns the state of the break-glass credential.
+func (a adminAPIHandlers) BreakGlassStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "BreakGlassStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.BreakGlassAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	data, err := json.Marshal(globalBreakGlassSys.Status())
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// SetBreakGlassHandler - POST /minio/admin/v3/breakglass?enable={bool}&duration={duration}
+// ----------
+// Enables the break-glass credential for the given duration, one hour
+// by default, or seals it again, and returns its state.
+func (a adminAPIHandlers) SetBreakGlassHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "SetBreakGlass")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, cred := validateAdminReq(ctx, w, r, iampolicy.BreakGlassAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// The break-glass credential must not be able to extend
+	// its own lifetime, nor re-enable itself once sealed.
+	if globalBreakGlassSys.IsAccessKey(cred.AccessKey) {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAccessDenied), r.URL)
+		return
+	}
+
+	query := r.URL.Query()
+	enable, err := strconv.ParseBool(query.Get("enable"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+		return
+	}
+
+	var duration time.Duration
+	if enable {
+		duration = breakGlassDefaultDuration
+		if d := query.Get("duration"); d != "" {
+			if duration, err = time.ParseDuration(d); err != nil {
+				writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+				return
+			}
+			if duration <= 0 || duration > breakGlassMaxDuration {
+				writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument,
+					fmt.Errorf("duration must be positive and at most %s", breakGlassMaxDuration)), r.URL)
+				return
+			}
+		}
+	}
+
+	status, err := globalBreakGlassSys.Set(ctx, objectAPI, cred.AccessKey, duration)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	auditLogBreakGlass(ctx, r, status)
+
+	// Notify all other MinIO peers to reload the break-glass state.
+	for _, nerr := range globalNotificationSys.LoadBreakGlass(ctx) {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// auditLogBreakGlass - sends a dedicated audit entry whenever the
+// break-glass credential is enabled or sealed.
+func auditLogBreakGlass(ctx context.Context, r *http.Request, status madmin.BreakGlassStatus) {
+	entry := audit.NewEntry(globalDeploymentID)
+	entry.Trigger = "break-glass"
+	entry.RemoteHost = handlers.GetSourceIP(r)
+	entry.UserAgent = r.UserAgent()
+	entry.Tags = map[string]interface{}{
+		"breakGlass":          true,
+		"breakGlassAccessKey": status.AccessKey,
+		"enabled":             status.Enabled,
+	}
+	if status.Enabled {
+		entry.Tags["expiresAt"] = status.ExpiresAt
+	}
+	if reqInfo := logger.GetReqInfo(ctx); reqInfo != nil {
+		entry.API.Name = reqInfo.API
+		entry.RequestID = reqInfo.RequestID
+		entry.Tags["accessKey"] = reqInfo.AccessKey
+	}
+	ctx = logger.SetAuditEntry(ctx, &entry)
+	logger.AuditLog(ctx, nil, nil, nil)
+}
+
 // ServerInfoHandler - GET /minio/admin/v3/info
 // ----------
 // Get server information
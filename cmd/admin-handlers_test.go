@@ -284,6 +284,61 @@ func TestAdminServerInfo(t *testing.T) {
 	}
 }
 
+// TestSetBreakGlassHandler - tests that the break-glass credential
+// cannot extend its own lifetime.
+func TestSetBreakGlassHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	adminTestBed, err := prepareAdminErasureTestBed(ctx)
+	if err != nil {
+		t.Fatal("Failed to initialize a single node Erasure backend for admin handler tests.")
+	}
+	defer adminTestBed.TearDown()
+
+	breakGlassCred, err := auth.CreateCredentials("breakglass", "breakglass-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	globalBreakGlassSys = NewBreakGlassSys(breakGlassCred)
+	defer func() { globalBreakGlassSys = nil }()
+
+	queryVal := url.Values{}
+	queryVal.Set("enable", "true")
+	queryVal.Set("duration", "1h")
+
+	// The root user enables the break-glass credential.
+	req, err := buildAdminRequest(queryVal, http.MethodPost, "/breakglass", 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to construct set break-glass request - %v", err)
+	}
+	rec := httptest.NewRecorder()
+	adminTestBed.router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected to succeed but failed with %d: %s", rec.Code, rec.Body)
+	}
+	expiresAt := globalBreakGlassSys.Status().ExpiresAt
+
+	// The break-glass credential then tries to extend itself.
+	queryVal.Set("duration", "24h")
+	req, err = newTestRequest(http.MethodPost,
+		adminPathPrefix+adminAPIVersionPrefix+"/breakglass?"+queryVal.Encode(), 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to construct set break-glass request - %v", err)
+	}
+	if err = signRequestV4(req, breakGlassCred.AccessKey, breakGlassCred.SecretKey); err != nil {
+		t.Fatal(err)
+	}
+	rec = httptest.NewRecorder()
+	adminTestBed.router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("Expected %d, got %d: %s", http.StatusForbidden, rec.Code, rec.Body)
+	}
+	if status := globalBreakGlassSys.Status(); !status.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("Expected the expiry to remain %s, got %s", expiresAt, status.ExpiresAt)
+	}
+}
+
 // TestToAdminAPIErrCode - test for toAdminAPIErrCode helper function.
 func TestToAdminAPIErrCode(t *testing.T) {
 	testCases := []struct {
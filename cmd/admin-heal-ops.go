@@ -77,6 +77,9 @@ type healSequenceStatus struct {
 	FailureDetail string            `json:"Detail,omitempty"`
 	StartTime     time.Time         `json:"StartTime"`
 
+	// items scanned per second, sampled over the last minutes
+	ScanRate float64 `json:"ScanRate,omitempty"`
+
 	// settings for the heal sequence
 	HealSettings madmin.HealOpts `json:"Settings"`
 
@@ -367,6 +370,7 @@ func (ahs *allHealState) PopHealStatusJSON(hpath string,
 	}
 
 	h.lastSentResultIndex = lastResultIndex
+	h.currentStatus.ScanRate = h.sampleScanRate(UTCNow())
 
 	jbytes, err := json.Marshal(h.currentStatus)
 	if err != nil {
@@ -444,6 +448,9 @@ type healSequence struct {
 	// The time of the last scan/heal activity
 	lastHealActivity time.Time
 
+	// The previous and current samples of the scanned items count
+	scanSamples [2]healScanSample
+
 	// Holds the request-info for logging
 	ctx context.Context
 
@@ -513,6 +520,56 @@ func (h *healSequence) getScannedItemsCount() int64 {
 	return count
 }
 
+// healScanSampleInterval is the minimum interval between two samples
+// of the scanned items count of a heal sequence.
+const healScanSampleInterval = time.Minute
+
+// healScanSample is the scanned items count of a heal sequence at a time.
+type healScanSample struct {
+	time  time.Time
+	count int64
+}
+
+// getScanRate - returns the number of items scanned per second
+func (h *healSequence) getScanRate() float64 {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return h.sampleScanRate(UTCNow())
+}
+
+// sampleScanRate - returns the number of items scanned per second since
+// the previous sample, which is at least one sample interval old once
+// the sequence runs for that long, caller must hold the heal sequence lock.
+func (h *healSequence) sampleScanRate(now time.Time) float64 {
+	var count int64
+	for _, v := range h.scannedItemsMap {
+		count += v
+	}
+
+	previous, current := h.scanSamples[0], h.scanSamples[1]
+	switch {
+	case previous.time.IsZero():
+		// First sample, count the items scanned since the start.
+		previous = healScanSample{time: h.startTime}
+		current = previous
+	case count < current.count:
+		// The counters were reset by a new scan cycle.
+		previous = healScanSample{time: current.time}
+		current = previous
+	}
+	if now.Sub(current.time) >= healScanSampleInterval {
+		previous, current = current, healScanSample{time: now, count: count}
+	}
+	h.scanSamples = [2]healScanSample{previous, current}
+
+	elapsed := now.Sub(previous.time).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(count-previous.count) / elapsed
+}
+
 // getScannedItemsMap - returns map of all scanned items against type
 func (h *healSequence) getScannedItemsMap() map[madmin.HealItemType]int64 {
 	h.mutex.RLock()
@@ -72,6 +72,17 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/background-heal/status").HandlerFunc(httpTraceAll(adminAPI.BackgroundHealStatusHandler))
 
+			// Pools and erasure sets status.
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/pools/status").HandlerFunc(httpTraceAll(adminAPI.PoolsStatusHandler))
+
+			// Maintenance mode of nodes and pools.
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/maintenance").HandlerFunc(httpTraceHdrs(adminAPI.MaintenanceStatusHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/maintenance").HandlerFunc(httpTraceHdrs(adminAPI.SetMaintenanceHandler))
+
+			// Break-glass credential.
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/breakglass").HandlerFunc(httpTraceHdrs(adminAPI.BreakGlassStatusHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/breakglass").HandlerFunc(httpTraceHdrs(adminAPI.SetBreakGlassHandler))
+
 			/// Health operations
 
 		}
@@ -98,6 +109,12 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/restore-config-history-kv").HandlerFunc(httpTraceHdrs(adminAPI.RestoreConfigHistoryKVHandler)).Queries("restoreId", "{restoreId:.*}")
 		}
 
+		// Config backup operations.
+		if enableConfigOps {
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/config-backups").HandlerFunc(httpTraceHdrs(adminAPI.ListConfigBackupsHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/restore-config-backup").HandlerFunc(httpTraceHdrs(adminAPI.RestoreConfigBackupHandler)).Queries("name", "{name:.*}")
+		}
+
 		/// Config import/export bulk operations
 		if enableConfigOps {
 			// Get config
@@ -168,6 +185,11 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 
 			// Set Group Status
 			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-group-status").HandlerFunc(httpTraceHdrs(adminAPI.SetGroupStatus)).Queries("group", "{group:.*}").Queries("status", "{status:.*}")
+
+			// Tenant operations
+			adminRouter.Methods(http.MethodPut).Path(adminVersion + "/add-tenant").HandlerFunc(httpTraceHdrs(adminAPI.AddTenantHandler))
+			adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/remove-tenant").HandlerFunc(httpTraceHdrs(adminAPI.RemoveTenantHandler)).Queries("name", "{name:.*}")
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/list-tenants").HandlerFunc(httpTraceHdrs(adminAPI.ListTenantsHandler))
 		}
 
 		if globalIsDistErasure || globalIsErasure {
@@ -177,6 +199,12 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 			// PutBucketQuotaConfig
 			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-quota").HandlerFunc(
 				httpTraceHdrs(adminAPI.PutBucketQuotaConfigHandler)).Queries("bucket", "{bucket:.*}")
+			// GetBucketThrottleConfig
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-throttle").HandlerFunc(
+				httpTraceHdrs(adminAPI.GetBucketThrottleConfigHandler)).Queries("bucket", "{bucket:.*}")
+			// PutBucketThrottleConfig
+			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-throttle").HandlerFunc(
+				httpTraceHdrs(adminAPI.PutBucketThrottleConfigHandler)).Queries("bucket", "{bucket:.*}")
 
 			// Bucket replication operations
 			// GetBucketTargetHandler
@@ -188,6 +216,58 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 			// RemoveRemoteTargetHandler
 			adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/remove-remote-target").HandlerFunc(
 				httpTraceHdrs(adminAPI.RemoveRemoteTargetHandler)).Queries("bucket", "{bucket:.*}", "arn", "{arn:.*}")
+
+			// Object lock compliance report
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/object-lock-report").HandlerFunc(
+				httpTraceHdrs(adminAPI.ObjectLockReportHandler))
+
+			// Batch job operations
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/batch-jobs/start").HandlerFunc(
+				httpTraceHdrs(adminAPI.StartBatchJobHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/batch-jobs/list").HandlerFunc(
+				httpTraceHdrs(adminAPI.ListBatchJobsHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/batch-jobs/status").HandlerFunc(
+				httpTraceHdrs(adminAPI.BatchJobStatusHandler)).Queries("id", "{id:.*}")
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/batch-jobs/cancel").HandlerFunc(
+				httpTraceHdrs(adminAPI.CancelBatchJobHandler)).Queries("id", "{id:.*}")
+			adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/batch-jobs/remove").HandlerFunc(
+				httpTraceHdrs(adminAPI.RemoveBatchJobHandler)).Queries("id", "{id:.*}")
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/batch-jobs/manifest").HandlerFunc(
+				httpTraceAll(adminAPI.BatchJobManifestHandler)).Queries("id", "{id:.*}")
+
+			// Bucket export and import
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/export-bucket").HandlerFunc(
+				httpTraceHdrs(adminAPI.ExportBucketHandler)).Queries("bucket", "{bucket:.*}")
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/import-bucket").HandlerFunc(
+				httpTraceHdrs(adminAPI.ImportBucketHandler)).Queries("bucket", "{bucket:.*}")
+
+			// Bucket event replay
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/replay-events").HandlerFunc(
+				httpTraceHdrs(adminAPI.ReplayEventsHandler)).Queries("bucket", "{bucket:.*}")
+
+			// Multipart uploads of all buckets
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/list-multipart-uploads").HandlerFunc(
+				httpTraceHdrs(adminAPI.ListMultipartUploadsHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/abort-multipart-uploads").HandlerFunc(
+				httpTraceHdrs(adminAPI.AbortMultipartUploadsHandler))
+
+			// Server-side copies in progress
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/copy-progress").HandlerFunc(
+				httpTraceHdrs(adminAPI.CopyProgressHandler))
+
+			// Runtime log levels
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/log-level").HandlerFunc(
+				httpTraceHdrs(adminAPI.GetLogLevelsHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/log-level").HandlerFunc(
+				httpTraceHdrs(adminAPI.SetLogLevelHandler))
+
+			// Notification targets status, reconnect and test events
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/notification-targets").HandlerFunc(
+				httpTraceHdrs(adminAPI.NotificationTargetsStatusHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/notification-targets/reconnect").HandlerFunc(
+				httpTraceHdrs(adminAPI.ReconnectNotificationTargetHandler)).Queries("target", "{target:.*}")
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/notification-targets/test").HandlerFunc(
+				httpTraceHdrs(adminAPI.SendTestNotificationHandler)).Queries("target", "{target:.*}")
 		}
 
 		if globalIsDistErasure {
@@ -216,8 +296,18 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 			// -- Health API --
 			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/healthinfo").
 				HandlerFunc(httpTraceHdrs(adminAPI.HealthInfoHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/health-reports").
+				HandlerFunc(httpTraceHdrs(adminAPI.ListHealthReportsHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/health-report").
+				HandlerFunc(httpTraceAll(adminAPI.GetHealthReportHandler)).Queries("name", "{name:.*}")
 			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/bandwidth").
 				HandlerFunc(httpTraceHdrs(adminAPI.BandwidthMonitorHandler))
+
+			// S3 requests being served, and their cancellation
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/requests").
+				HandlerFunc(httpTraceHdrs(adminAPI.InflightRequestsHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/requests/cancel").
+				HandlerFunc(httpTraceHdrs(adminAPI.CancelRequestHandler)).Queries("id", "{id:.*}")
 		}
 	}
 
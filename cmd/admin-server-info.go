@@ -76,6 +76,7 @@ func getLocalServerProperty(endpointServerPools EndpointServerPools, r *http.Req
 		storageInfo, _ := objLayer.LocalStorageInfo(GlobalContext)
 		props.State = string(madmin.ItemOnline)
 		props.Disks = storageInfo.Disks
+		props.Maintenance = globalMaintenanceSys != nil && globalMaintenanceSys.LocalNodeInMaintenance()
 	}
 
 	return props
@@ -368,6 +368,11 @@ const (
 	ErrAccountNotEligible
 	ErrAdminServiceAccountNotFound
 	ErrPostPolicyConditionInvalidFormat
+	ErrNodeMaintenance
+	ErrPoolMaintenance
+	ErrInvalidObjectExpiration
+	ErrInvalidListenCursor
+	ErrListenCursorNotSupported
 )
 
 type errorCodeMap map[APIErrorCode]APIError
@@ -1255,7 +1260,7 @@ var errorCodes = errorCodeMap{
 	},
 	ErrMaximumExpires: {
 		Code:           "AuthorizationQueryParametersError",
-		Description:    "X-Amz-Expires must be less than a week (in seconds); that is, the given X-Amz-Expires must be less than 604800 seconds",
+		Description:    "X-Amz-Expires must not exceed the maximum allowed validity of presigned URLs, at most a week (604800 seconds)",
 		HTTPStatusCode: http.StatusBadRequest,
 	},
 
@@ -1758,6 +1763,31 @@ var errorCodes = errorCodeMap{
 		Description:    "Invalid according to Policy: Policy Condition failed",
 		HTTPStatusCode: http.StatusForbidden,
 	},
+	ErrNodeMaintenance: {
+		Code:           "XMinioNodeMaintenance",
+		Description:    "The server is in maintenance mode and does not accept writes, please retry on another server.",
+		HTTPStatusCode: http.StatusServiceUnavailable,
+	},
+	ErrPoolMaintenance: {
+		Code:           "XMinioPoolMaintenance",
+		Description:    "The object is stored on a pool in maintenance mode and cannot be modified, please try again later.",
+		HTTPStatusCode: http.StatusServiceUnavailable,
+	},
+	ErrInvalidObjectExpiration: {
+		Code:           "InvalidArgument",
+		Description:    "The X-Minio-Expiration-Seconds header must be a positive number of seconds.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrInvalidListenCursor: {
+		Code:           "InvalidArgument",
+		Description:    "The cursor must be the eventTime and sequencer of a received event.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrListenCursorNotSupported: {
+		Code:           "XMinioListenCursorNotSupported",
+		Description:    "Resuming from a cursor requires the event log to be enabled.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
 	// Add your error structure here.
 }
 
@@ -1794,6 +1824,8 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrAdminNoSuchPolicy
 	case errSignatureMismatch:
 		apiErr = ErrSignatureDoesNotMatch
+	case errContentChecksumMismatch:
+		apiErr = ErrBadDigest
 	case errInvalidRange:
 		apiErr = ErrInvalidRange
 	case errDataTooLarge:
@@ -1999,6 +2031,8 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrOperationTimedOut
 	case BackendDown:
 		apiErr = ErrBackendDown
+	case PoolInMaintenance:
+		apiErr = ErrPoolMaintenance
 	case ObjectNameTooLong:
 		apiErr = ErrKeyTooLongError
 	case dns.ErrInvalidBucketName:
@@ -17,11 +17,105 @@
 package cmd
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"net/url"
 	"strconv"
 )
 
+// Parse list buckets url queries, paginated is set when any of
+// the pagination or filtering queries is present.
+func getListBucketsArgs(values url.Values) (prefix, token string, maxBuckets int, paginated bool, errCode APIErrorCode) {
+	errCode = ErrNone
+
+	// The continuation-token cannot be empty.
+	if val, ok := values["continuation-token"]; ok {
+		if len(val[0]) == 0 {
+			errCode = ErrIncorrectContinuationToken
+			return
+		}
+		paginated = true
+	}
+
+	maxBuckets = maxBucketList
+	if values.Get("max-buckets") != "" {
+		var err error
+		if maxBuckets, err = strconv.Atoi(values.Get("max-buckets")); err != nil || maxBuckets <= 0 {
+			errCode = ErrInvalidMaxKeys
+			return
+		}
+		if maxBuckets > maxBucketList {
+			maxBuckets = maxBucketList
+		}
+		paginated = true
+	}
+
+	if _, ok := values["prefix"]; ok {
+		prefix = values.Get("prefix")
+		paginated = true
+	}
+
+	if token = values.Get("continuation-token"); token != "" {
+		var err error
+		if token, err = decodeListBucketsToken(token); err != nil {
+			errCode = ErrIncorrectContinuationToken
+			return
+		}
+	}
+	return
+}
+
+// listBucketsTokenCipher returns the AEAD which the continuation tokens
+// of bucket listings are encrypted with, keyed the same on all nodes.
+func listBucketsTokenCipher() (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, []byte(globalActiveCred.SecretKey))
+	mac.Write([]byte("list-buckets-continuation-token"))
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encodeListBucketsToken returns the opaque continuation token of a
+// bucket listing which continues after bucket.
+func encodeListBucketsToken(bucket string) (string, error) {
+	aead, err := listBucketsTokenCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(bucket), nil)), nil
+}
+
+// decodeListBucketsToken returns the bucket a continuation token
+// returned by encodeListBucketsToken continues after.
+func decodeListBucketsToken(token string) (string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", err
+	}
+	aead, err := listBucketsTokenCipher()
+	if err != nil {
+		return "", err
+	}
+	if len(data) < aead.NonceSize() {
+		return "", errInvalidArgument
+	}
+	bucket, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(bucket), nil
+}
+
 // Parse bucket url queries
 func getListObjectsV1Args(values url.Values) (prefix, marker, delimiter string, maxkeys int, encodingType string, errCode APIErrorCode) {
 	errCode = ErrNone
@@ -17,6 +17,7 @@
 package cmd
 
 import (
+	"encoding/base64"
 	"net/url"
 	"testing"
 )
@@ -178,6 +179,79 @@ func TestListObjectsV1Resources(t *testing.T) {
 	}
 }
 
+func TestListBucketsResources(t *testing.T) {
+	token, err := encodeListBucketsToken("photos-2020")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		values     url.Values
+		prefix     string
+		token      string
+		maxBuckets int
+		paginated  bool
+		errCode    APIErrorCode
+	}{
+		{
+			values:     url.Values{},
+			maxBuckets: maxBucketList,
+		},
+		{
+			values: url.Values{
+				"prefix":             []string{"photos"},
+				"continuation-token": []string{token},
+				"max-buckets":        []string{"100"},
+			},
+			prefix:     "photos",
+			token:      "photos-2020",
+			maxBuckets: 100,
+			paginated:  true,
+		},
+		{
+			values: url.Values{
+				"max-buckets": []string{"100000"},
+			},
+			maxBuckets: maxBucketList,
+			paginated:  true,
+		},
+		{
+			values: url.Values{
+				"max-buckets": []string{"0"},
+			},
+			errCode: ErrInvalidMaxKeys,
+		},
+		{
+			values: url.Values{
+				"continuation-token": []string{""},
+			},
+			errCode: ErrIncorrectContinuationToken,
+		},
+		// Tokens are opaque, a bucket name is not one.
+		{
+			values: url.Values{
+				"continuation-token": []string{base64.RawURLEncoding.EncodeToString([]byte("photos-2020"))},
+			},
+			errCode: ErrIncorrectContinuationToken,
+		},
+	}
+
+	for i, testCase := range testCases {
+		prefix, token, maxBuckets, paginated, errCode := getListBucketsArgs(testCase.values)
+		if errCode != testCase.errCode {
+			t.Fatalf("Test %d: expected %v, got %v", i+1, testCase.errCode, errCode)
+		}
+		if errCode != ErrNone {
+			continue
+		}
+		if prefix != testCase.prefix || token != testCase.token || maxBuckets != testCase.maxBuckets || paginated != testCase.paginated {
+			t.Errorf("Test %d: expected %s, %s, %d, %t, got %s, %s, %d, %t", i+1,
+				testCase.prefix, testCase.token, testCase.maxBuckets, testCase.paginated,
+				prefix, token, maxBuckets, paginated)
+		}
+	}
+}
+
 // Validates extracting information for object resources.
 func TestGetObjectsResources(t *testing.T) {
 	testCases := []struct {
@@ -40,6 +40,7 @@ const (
 	maxDeleteList     = 10000                                          // Limit number of objects deleted in a delete call.
 	maxUploadsList    = 10000                                          // Limit number of uploads in a listUploadsResponse.
 	maxPartsList      = 10000                                          // Limit number of parts in a listPartsResponse.
+	maxBucketList     = 10000                                          // Limit number of buckets in a paginated listBucketsResponse.
 )
 
 // LocationResponse - format for location response.
@@ -217,6 +218,13 @@ type ListBucketsResponse struct {
 	Buckets struct {
 		Buckets []Bucket `xml:"Bucket"`
 	} // Buckets are nested
+
+	// When the list is truncated, the token to send as continuation-token
+	// to list the next buckets, only set for paginated requests.
+	ContinuationToken string `xml:"ContinuationToken,omitempty"`
+
+	// Prefix the bucket names were filtered with.
+	Prefix string `xml:"Prefix,omitempty"`
 }
 
 // Upload container for in progress multipart upload
@@ -413,7 +421,7 @@ func getObjectLocation(r *http.Request, domains []string, bucket, object string)
 
 // generates ListBucketsResponse from array of BucketInfo which can be
 // serialized to match XML and JSON API spec output.
-func generateListBucketsResponse(buckets []BucketInfo) ListBucketsResponse {
+func generateListBucketsResponse(buckets []BucketInfo, prefix, nextToken string) ListBucketsResponse {
 	listbuckets := make([]Bucket, 0, len(buckets))
 	var data = ListBucketsResponse{}
 	var owner = Owner{
@@ -430,6 +438,8 @@ func generateListBucketsResponse(buckets []BucketInfo) ListBucketsResponse {
 
 	data.Owner = owner
 	data.Buckets.Buckets = listbuckets
+	data.Prefix = prefix
+	data.ContinuationToken = nextToken
 
 	return data
 }
@@ -78,8 +78,18 @@ func isRequestPostPolicySignatureV4(r *http.Request) bool {
 
 // Verify if the request has AWS Streaming Signature Version '4'. This is only valid for 'PUT' operation.
 func isRequestSignStreamingV4(r *http.Request) bool {
-	return r.Header.Get(xhttp.AmzContentSha256) == streamingContentSHA256 &&
-		r.Method == http.MethodPut
+	switch r.Header.Get(xhttp.AmzContentSha256) {
+	case streamingContentSHA256, streamingContentSHA256Trailer:
+		return r.Method == http.MethodPut
+	}
+	return false
+}
+
+// Verify if the request has AWS Signature Version '4' with an unsigned
+// 'aws-chunked' payload and trailing headers. This is only valid for 'PUT' operation.
+func isRequestUnsignedTrailerV4(r *http.Request) bool {
+	return r.Header.Get(xhttp.AmzContentSha256) == unsignedPayloadTrailer &&
+		r.Method == http.MethodPut && isRequestSignatureV4(r)
 }
 
 // Authorization type.
@@ -97,6 +107,7 @@ const (
 	authTypeSignedV2
 	authTypeJWT
 	authTypeSTS
+	authTypeStreamingUnsignedTrailer
 )
 
 // Get request authentication type.
@@ -107,6 +118,8 @@ func getRequestAuthType(r *http.Request) authType {
 		return authTypePresignedV2
 	} else if isRequestSignStreamingV4(r) {
 		return authTypeStreamingSigned
+	} else if isRequestUnsignedTrailerV4(r) {
+		return authTypeStreamingUnsignedTrailer
 	} else if isRequestSignatureV4(r) {
 		return authTypeSigned
 	} else if isRequestPresignedSignatureV4(r) {
@@ -150,6 +163,8 @@ func validateAdminSignature(ctx context.Context, r *http.Request, region string)
 		return cred, nil, owner, s3Err
 	}
 
+	logger.GetReqInfo(ctx).AccessKey = cred.AccessKey
+	tagBreakGlass(ctx, cred.AccessKey)
 	return cred, claims, owner, ErrNone
 }
 
@@ -282,8 +297,10 @@ func checkRequestAuthType(ctx context.Context, r *http.Request, action policy.Ac
 // returns APIErrorCode if any to be replied to the client.
 // Additionally returns the accessKey used in the request, and if this request is by an admin.
 func checkRequestAuthTypeCredential(ctx context.Context, r *http.Request, action policy.Action, bucketName, objectName string) (cred auth.Credentials, owner bool, s3Err APIErrorCode) {
+	defer logger.AddRequestTiming(ctx, logger.TimingAuth, time.Now())
+
 	switch getRequestAuthType(r) {
-	case authTypeUnknown, authTypeStreamingSigned:
+	case authTypeUnknown, authTypeStreamingSigned, authTypeStreamingUnsignedTrailer:
 		return cred, owner, ErrSignatureVersionNotSupported
 	case authTypePresignedV2, authTypeSignedV2:
 		if s3Err = isReqAuthenticatedV2(r); s3Err != ErrNone {
@@ -335,15 +352,24 @@ func checkRequestAuthTypeCredential(ctx context.Context, r *http.Request, action
 	}
 	if cred.AccessKey != "" {
 		logger.GetReqInfo(ctx).AccessKey = cred.AccessKey
+		tagBreakGlass(ctx, cred.AccessKey)
 	}
 
+	// Tags of the existing object, fetched only for the policies with
+	// s3:ExistingObjectTag conditions.
+	var existingTags map[string]string
+
 	if action != policy.ListAllMyBucketsAction && cred.AccessKey == "" {
+		if globalPolicySys.RefersExistingObjectTag(bucketName) {
+			existingTags = getExistingObjectTags(ctx, r, action, bucketName, objectName)
+		}
+
 		// Anonymous checks are not meant for ListBuckets action
 		if globalPolicySys.IsAllowed(policy.Args{
 			AccountName:     cred.AccessKey,
 			Action:          action,
 			BucketName:      bucketName,
-			ConditionValues: getConditionValues(r, locationConstraint, "", nil),
+			ConditionValues: setExistingObjectTags(getConditionValues(r, locationConstraint, "", nil), existingTags),
 			IsOwner:         false,
 			ObjectName:      objectName,
 		}) {
@@ -370,12 +396,16 @@ func checkRequestAuthTypeCredential(ctx context.Context, r *http.Request, action
 		return cred, owner, ErrAccessDenied
 	}
 
+	if (!owner || globalPolicyOPA != nil) && globalIAMSys.RefersExistingObjectTag(cred.AccessKey, cred.Groups, claims) {
+		existingTags = getExistingObjectTags(ctx, r, action, bucketName, objectName)
+	}
+
 	if globalIAMSys.IsAllowed(iampolicy.Args{
 		AccountName:     cred.AccessKey,
 		Groups:          cred.Groups,
 		Action:          iampolicy.Action(action),
 		BucketName:      bucketName,
-		ConditionValues: getConditionValues(r, "", cred.AccessKey, claims),
+		ConditionValues: setExistingObjectTags(getConditionValues(r, "", cred.AccessKey, claims), existingTags),
 		ObjectName:      objectName,
 		IsOwner:         owner,
 		Claims:          claims,
@@ -465,13 +495,14 @@ func isReqAuthenticated(ctx context.Context, r *http.Request, region string, sty
 
 // List of all support S3 auth types.
 var supportedS3AuthTypes = map[authType]struct{}{
-	authTypeAnonymous:       {},
-	authTypePresigned:       {},
-	authTypePresignedV2:     {},
-	authTypeSigned:          {},
-	authTypeSignedV2:        {},
-	authTypePostPolicy:      {},
-	authTypeStreamingSigned: {},
+	authTypeAnonymous:                {},
+	authTypePresigned:                {},
+	authTypePresignedV2:              {},
+	authTypeSigned:                   {},
+	authTypeSignedV2:                 {},
+	authTypePostPolicy:               {},
+	authTypeStreamingSigned:          {},
+	authTypeStreamingUnsignedTrailer: {},
 }
 
 // Validate if the authType is valid and supported.
@@ -512,7 +543,7 @@ func validateSignature(atype authType, r *http.Request) (auth.Credentials, bool,
 	var owner bool
 	var s3Err APIErrorCode
 	switch atype {
-	case authTypeUnknown, authTypeStreamingSigned:
+	case authTypeUnknown, authTypeStreamingSigned, authTypeStreamingUnsignedTrailer:
 		return cred, owner, nil, ErrSignatureVersionNotSupported
 	case authTypeSignedV2, authTypePresignedV2:
 		if s3Err = isReqAuthenticatedV2(r); s3Err != ErrNone {
@@ -615,6 +646,8 @@ func isPutRetentionAllowed(bucketName, objectName string, retDays int, retDate t
 // call verifies bucket policies and IAM policies, supports multi user
 // checks etc.
 func isPutActionAllowed(ctx context.Context, atype authType, bucketName, objectName string, r *http.Request, action iampolicy.Action) (s3Err APIErrorCode) {
+	defer logger.AddRequestTiming(ctx, logger.TimingAuth, time.Now())
+
 	var cred auth.Credentials
 	var owner bool
 	switch atype {
@@ -622,7 +655,7 @@ func isPutActionAllowed(ctx context.Context, atype authType, bucketName, objectN
 		return ErrSignatureVersionNotSupported
 	case authTypeSignedV2, authTypePresignedV2:
 		cred, owner, s3Err = getReqAccessKeyV2(r)
-	case authTypeStreamingSigned, authTypePresigned, authTypeSigned:
+	case authTypeStreamingSigned, authTypeStreamingUnsignedTrailer, authTypePresigned, authTypeSigned:
 		region := globalServerRegion
 		cred, owner, s3Err = getReqAccessKeyV4(r, region, serviceS3)
 	}
@@ -637,6 +670,7 @@ func isPutActionAllowed(ctx context.Context, atype authType, bucketName, objectN
 
 	if cred.AccessKey != "" {
 		logger.GetReqInfo(ctx).AccessKey = cred.AccessKey
+		tagBreakGlass(ctx, cred.AccessKey)
 	}
 
 	// Do not check for PutObjectRetentionAction permission,
@@ -115,6 +115,25 @@ func TestGetRequestAuthType(t *testing.T) {
 			},
 			authT: authTypePostPolicy,
 		},
+		// Test case - 6
+		// Check for unsigned payload with trailing headers.
+		{
+			req: &http.Request{
+				URL: &url.URL{
+					Host:   "127.0.0.1:9000",
+					Scheme: httpScheme,
+					Path:   SlashSeparator,
+				},
+				Header: http.Header{
+					"Authorization":        []string{"AWS4-HMAC-SHA256 <cred_string>"},
+					"X-Amz-Content-Sha256": []string{unsignedPayloadTrailer},
+					"X-Amz-Trailer":        []string{"x-amz-checksum-crc32"},
+					"Content-Encoding":     []string{streamingContentEncoding},
+				},
+				Method: http.MethodPut,
+			},
+			authT: authTypeStreamingUnsignedTrailer,
+		},
 	}
 
 	// .. Tests all request auth type.
@@ -82,7 +82,7 @@ func waitForLowHTTPReq(maxIO int, maxWait time.Duration) {
 				tmpMaxWait = tmpMaxWait - waitTick
 			}
 			if tmpMaxWait <= 0 {
-				if intDataUpdateTracker.debug {
+				if debugLogEnabled(debugLogHeal) {
 					logger.Info("waitForLowHTTPReq: waited max %s, resuming", maxWait)
 				}
 				break
@@ -0,0 +1,89 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	xhttp "github.com/minio/minio/cmd/http"
+	objectlock "github.com/minio/minio/pkg/bucket/object/lock"
+	"github.com/minio/minio/pkg/bucket/replication"
+	"github.com/minio/minio/pkg/event"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// batchJobLegalHold - applies or removes legal hold on object versions.
+type batchJobLegalHold struct {
+	status objectlock.LegalHoldStatus
+}
+
+func newBatchJobLegalHold(req madmin.BatchJobRequest) (batchJobProcessor, error) {
+	if req.LegalHold == nil {
+		return nil, batchJobInvalidArgument("legal hold options are required")
+	}
+	status := objectlock.LegalHoldStatus(strings.ToUpper(req.LegalHold.Status))
+	if !status.Valid() {
+		return nil, batchJobInvalidArgument("invalid legal hold status '%s'", req.LegalHold.Status)
+	}
+	if rcfg, _ := globalBucketObjectLockSys.Get(req.Bucket); !rcfg.LockEnabled {
+		return nil, batchJobInvalidArgument("object lock is not enabled on bucket '%s'", req.Bucket)
+	}
+	return batchJobLegalHold{status: status}, nil
+}
+
+// Legal hold cannot be set on delete markers.
+func (b batchJobLegalHold) skip(obj ObjectInfo) bool {
+	return obj.DeleteMarker
+}
+
+func (b batchJobLegalHold) process(ctx context.Context, objAPI ObjectLayer, obj ObjectInfo) error {
+	// Listing does not return all of the object metadata,
+	// which PutObjectMetadata replaces as a whole.
+	objInfo, err := objAPI.GetObjectInfo(ctx, obj.Bucket, obj.Name, ObjectOptions{VersionID: obj.VersionID})
+	if err != nil {
+		return err
+	}
+
+	objInfo.UserDefined[strings.ToLower(xhttp.AmzObjectLockLegalHold)] = string(b.status)
+	replicate, sync := mustReplicater(ctx, objInfo.Bucket, objInfo.Name, objInfo.UserDefined, "")
+	if replicate {
+		objInfo.UserDefined[xhttp.AmzBucketReplicationStatus] = replication.Pending.String()
+	}
+	popts := ObjectOptions{
+		VersionID:   objInfo.VersionID,
+		UserDefined: make(map[string]string, len(objInfo.UserDefined)),
+	}
+	for k, v := range objInfo.UserDefined {
+		popts.UserDefined[k] = v
+	}
+	if _, err = objAPI.PutObjectMetadata(ctx, objInfo.Bucket, objInfo.Name, popts); err != nil {
+		return err
+	}
+	if replicate {
+		scheduleReplication(ctx, objInfo.Clone(), objAPI, sync, replication.MetadataReplicationType)
+	}
+
+	sendEvent(eventArgs{
+		EventName:  event.ObjectCreatedPutLegalHold,
+		BucketName: objInfo.Bucket,
+		Object:     objInfo,
+		Host:       "Internal: [Batch-Job]",
+	})
+	return nil
+}
@@ -0,0 +1,347 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	miniogo "github.com/minio/minio-go/v7"
+	miniogolifecycle "github.com/minio/minio-go/v7/pkg/lifecycle"
+	miniogonotification "github.com/minio/minio-go/v7/pkg/notification"
+	miniogosse "github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/minio/minio/cmd/crypto"
+	"github.com/minio/minio/cmd/logger"
+	objectlock "github.com/minio/minio/pkg/bucket/object/lock"
+	"github.com/minio/minio/pkg/bucket/policy"
+	"github.com/minio/minio/pkg/bucket/replication"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// Maximum number of passes over the object versions written during
+// a migration before a cutover gives up.
+const batchJobMigrateCutoverPasses = 10
+
+// batchJobMigrate - copies the configuration and the object versions
+// of a bucket to a remote target.
+type batchJobMigrate struct {
+	bucket  string
+	filter  madmin.BatchJobFilter
+	workers int
+	opts    madmin.BatchJobMigrateOptions
+	tgt     *TargetClient
+}
+
+func newBatchJobMigrate(req madmin.BatchJobRequest) (batchJobProcessor, error) {
+	if req.Migrate == nil {
+		return nil, batchJobInvalidArgument("migrate options are required")
+	}
+	found := false
+	for _, t := range globalBucketTargetSys.ListTargets(GlobalContext, req.Bucket, "") {
+		if t.Arn == req.Migrate.TargetARN {
+			found = true
+			break
+		}
+	}
+	tgt := globalBucketTargetSys.GetRemoteTargetClient(GlobalContext, req.Migrate.TargetARN)
+	if !found || tgt == nil {
+		return nil, batchJobInvalidArgument("remote target '%s' is not set on bucket '%s'", req.Migrate.TargetARN, req.Bucket)
+	}
+	return &batchJobMigrate{
+		bucket:  req.Bucket,
+		filter:  req.Filter,
+		workers: req.Workers,
+		opts:    *req.Migrate,
+		tgt:     tgt,
+	}, nil
+}
+
+func (m *batchJobMigrate) skip(obj ObjectInfo) bool {
+	return false
+}
+
+// prepare copies the bucket configuration to the target. Versioning and
+// object lock are required to preserve the object versions and their
+// retention, the rest of the configuration is copied on a best effort
+// basis since it may refer to resources of this deployment, such as
+// notification targets or transition tiers.
+func (m *batchJobMigrate) prepare(ctx context.Context, objAPI ObjectLayer) error {
+	meta, err := globalBucketMetadataSys.GetConfig(m.bucket)
+	if err != nil && err != errConfigNotFound {
+		return err
+	}
+
+	// Excluded prefixes are not supported by S3 and are not copied.
+	switch {
+	case globalBucketVersioningSys.Enabled(m.bucket):
+		err = m.tgt.EnableVersioning(ctx, m.tgt.bucket)
+	case globalBucketVersioningSys.Suspended(m.bucket):
+		err = m.tgt.SuspendVersioning(ctx, m.tgt.bucket)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to copy the versioning configuration of bucket %s: %w", m.bucket, err)
+	}
+
+	if len(meta.ObjectLockConfigXML) > 0 {
+		if err = m.migrateObjectLockConfig(ctx, meta.ObjectLockConfigXML); err != nil {
+			return fmt.Errorf("unable to copy the object lock configuration of bucket %s: %w", m.bucket, err)
+		}
+	}
+
+	if len(meta.PolicyConfigJSON) > 0 {
+		policyData := migrateBucketPolicy(meta.PolicyConfigJSON, m.bucket, m.tgt.bucket)
+		logger.LogIf(ctx, m.migrateConfig("policy", m.tgt.SetBucketPolicy(ctx, m.tgt.bucket, policyData)))
+	}
+	if len(meta.LifecycleConfigXML) > 0 {
+		var cfg miniogolifecycle.Configuration
+		if err = xml.Unmarshal(meta.LifecycleConfigXML, &cfg); err == nil {
+			err = m.tgt.SetBucketLifecycle(ctx, m.tgt.bucket, &cfg)
+		}
+		logger.LogIf(ctx, m.migrateConfig("lifecycle", err))
+	}
+	if len(meta.EncryptionConfigXML) > 0 {
+		var cfg miniogosse.Configuration
+		if err = xml.Unmarshal(meta.EncryptionConfigXML, &cfg); err == nil {
+			err = m.tgt.SetBucketEncryption(ctx, m.tgt.bucket, &cfg)
+		}
+		logger.LogIf(ctx, m.migrateConfig("encryption", err))
+	}
+	if len(meta.TaggingConfigXML) > 0 {
+		t, err := tags.ParseBucketXML(bytes.NewReader(meta.TaggingConfigXML))
+		if err == nil {
+			err = m.tgt.SetBucketTagging(ctx, m.tgt.bucket, t)
+		}
+		logger.LogIf(ctx, m.migrateConfig("tagging", err))
+	}
+	if len(meta.NotificationConfigXML) > 0 {
+		var cfg miniogonotification.Configuration
+		if err = xml.Unmarshal(meta.NotificationConfigXML, &cfg); err == nil {
+			err = m.tgt.SetBucketNotification(ctx, m.tgt.bucket, cfg)
+		}
+		logger.LogIf(ctx, m.migrateConfig("notification", err))
+	}
+	return nil
+}
+
+func (m *batchJobMigrate) migrateConfig(config string, err error) error {
+	if err != nil {
+		return fmt.Errorf("unable to copy the %s configuration of bucket %s to %s: %w", config, m.bucket, m.tgt.bucket, err)
+	}
+	return nil
+}
+
+func (m *batchJobMigrate) migrateObjectLockConfig(ctx context.Context, data []byte) error {
+	cfg, err := objectlock.ParseObjectLockConfig(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	// Object lock can only be enabled when a bucket is created.
+	if _, _, _, _, err = m.tgt.GetObjectLockConfig(ctx, m.tgt.bucket); err != nil {
+		return fmt.Errorf("object lock is not enabled on bucket %s: %w", m.tgt.bucket, err)
+	}
+	if cfg.Rule == nil {
+		return nil
+	}
+
+	mode := miniogo.RetentionMode(cfg.Rule.DefaultRetention.Mode)
+	var (
+		validity uint
+		unit     miniogo.ValidityUnit
+	)
+	if cfg.Rule.DefaultRetention.Days != nil {
+		validity, unit = uint(*cfg.Rule.DefaultRetention.Days), miniogo.Days
+	} else {
+		validity, unit = uint(*cfg.Rule.DefaultRetention.Years), miniogo.Years
+	}
+	return m.tgt.SetObjectLockConfig(ctx, m.tgt.bucket, &mode, &validity, &unit)
+}
+
+// migrateBucketPolicy returns the bucket policy data of bucket srcBucket
+// with its resources changed to refer to dstBucket.
+func migrateBucketPolicy(data []byte, srcBucket, dstBucket string) string {
+	if srcBucket == dstBucket {
+		return string(data)
+	}
+	// Bucket policies can only refer to their own bucket.
+	return strings.ReplaceAll(string(data), policy.ResourceARNPrefix+srcBucket, policy.ResourceARNPrefix+dstBucket)
+}
+
+func (m *batchJobMigrate) process(ctx context.Context, objAPI ObjectLayer, obj ObjectInfo) error {
+	if obj.DeleteMarker {
+		versionID := obj.VersionID
+		if versionID == nullVersionID {
+			versionID = ""
+		}
+		return m.tgt.RemoveObject(ctx, m.tgt.bucket, obj.Name, miniogo.RemoveObjectOptions{
+			VersionID: versionID,
+			Internal: miniogo.AdvancedRemoveOptions{
+				ReplicationDeleteMarker: true,
+				ReplicationMTime:        obj.ModTime,
+			},
+		})
+	}
+
+	// The key of SSE-C encrypted objects is only known to the client.
+	if crypto.SSEC.IsEncrypted(obj.UserDefined) {
+		return fmt.Errorf("%w: object is encrypted with a client provided key", errBatchJobSkipped)
+	}
+
+	gr, err := objAPI.GetObjectNInfo(ctx, obj.Bucket, obj.Name, nil, http.Header{}, readLock, ObjectOptions{
+		VersionID: obj.VersionID,
+	})
+	if err != nil {
+		if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+			return fmt.Errorf("%w: object version no longer exists", errBatchJobSkipped)
+		}
+		return err
+	}
+	defer gr.Close()
+
+	objInfo := gr.ObjInfo
+	size, err := objInfo.GetActualSize()
+	if err != nil {
+		return err
+	}
+
+	// The ETag of encrypted objects is sealed with the object key,
+	// the target stores and reports the unencrypted one.
+	srcInfo := objInfo
+	srcInfo.ETag = objInfo.GetActualETag(nil)
+
+	// Versions copied by an earlier run are not copied again.
+	oi, err := m.tgt.StatObject(ctx, m.tgt.bucket, objInfo.Name, miniogo.StatObjectOptions{
+		VersionID: objInfo.VersionID,
+		Internal: miniogo.AdvancedGetOptions{
+			ReplicationProxyRequest: "false",
+		},
+	})
+	if err == nil && getReplicationAction(srcInfo, oi) == replicateNone {
+		return fmt.Errorf("%w: object version already exists on the target", errBatchJobSkipped)
+	}
+
+	putOpts, err := putReplicationOpts(ctx, replication.Destination{Bucket: m.tgt.bucket}, srcInfo)
+	if err != nil {
+		return err
+	}
+	// Migrated objects are not replicas, the target holds
+	// the primary copy once the migration is done.
+	putOpts.Internal.ReplicationStatus = ""
+	putOpts.Internal.ReplicationRequest = false
+
+	// use core client to avoid doing multipart on PUT
+	c := &miniogo.Core{Client: m.tgt.Client}
+	if _, err = c.PutObject(ctx, m.tgt.bucket, objInfo.Name, gr, size, "", "", putOpts); err != nil {
+		return err
+	}
+
+	if m.opts.Verify {
+		return m.verify(ctx, srcInfo, size)
+	}
+	return nil
+}
+
+// verify compares a copied object version with the one on the target,
+// objInfo must carry the unencrypted ETag of the source version.
+func (m *batchJobMigrate) verify(ctx context.Context, objInfo ObjectInfo, size int64) error {
+	oi, err := m.tgt.StatObject(ctx, m.tgt.bucket, objInfo.Name, miniogo.StatObjectOptions{
+		VersionID: objInfo.VersionID,
+		Internal: miniogo.AdvancedGetOptions{
+			ReplicationProxyRequest: "false",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("verification failed: %w", err)
+	}
+	if oi.Size != size || oi.ETag != objInfo.ETag {
+		return fmt.Errorf("verification failed: expected size %d and ETag %s, target has size %d and ETag %s",
+			size, objInfo.ETag, oi.Size, oi.ETag)
+	}
+	return nil
+}
+
+// finish performs the cutover if requested: the bucket configuration
+// is copied again, then the object versions written since the previous
+// pass started are copied until a pass finds none.
+//
+// The cutover does not block writes to the source bucket, applications
+// must be stopped or pointed at the target beforehand. Object versions
+// deleted from the source after they were copied are not deleted from
+// the target either, only the versions and delete markers present on
+// the source are migrated.
+func (m *batchJobMigrate) finish(ctx context.Context, objAPI ObjectLayer, job *batchJob) error {
+	if !m.opts.Cutover {
+		return nil
+	}
+	status := job.getStatus()
+	if status.Failed > 0 {
+		return fmt.Errorf("cutover aborted, %d object versions failed to migrate", status.Failed)
+	}
+	if err := m.prepare(ctx, objAPI); err != nil {
+		return err
+	}
+
+	since := status.Started
+	for pass := 0; pass < batchJobMigrateCutoverPasses; pass++ {
+		if checkConfig(ctx, objAPI, batchJobCancelPath(status.ID)) != errConfigNotFound {
+			return context.Canceled
+		}
+
+		passStarted := UTCNow()
+		objs, scanned, err := m.listModifiedSince(ctx, objAPI, since)
+		if err != nil {
+			return err
+		}
+		if len(objs) == 0 {
+			return nil
+		}
+
+		results := processBatchJobObjects(ctx, objAPI, m, objs, m.workers)
+		logger.LogIf(ctx, saveBatchJobManifest(ctx, objAPI, status.ID, objs, results))
+		snapshot := job.addResults(scanned, objs, results)
+		logger.LogIf(ctx, saveBatchJobStatus(ctx, objAPI, snapshot))
+		if snapshot.Failed > 0 {
+			return fmt.Errorf("cutover aborted, %d object versions failed to migrate", snapshot.Failed)
+		}
+		since = passStarted
+	}
+	return fmt.Errorf("cutover aborted after %d passes, bucket %s is still being written to",
+		batchJobMigrateCutoverPasses, m.bucket)
+}
+
+// listModifiedSince returns the object versions matching the job
+// filter which were written at or after since.
+func (m *batchJobMigrate) listModifiedSince(ctx context.Context, objAPI ObjectLayer, since time.Time) (objs []ObjectInfo, scanned uint64, err error) {
+	objInfoCh := make(chan ObjectInfo)
+	if err = objAPI.Walk(ctx, m.bucket, m.filter.Prefix, objInfoCh, ObjectOptions{WalkVersions: true}); err != nil {
+		return nil, 0, err
+	}
+	now := UTCNow()
+	for obj := range objInfoCh {
+		scanned++
+		if !obj.ModTime.Before(since) && batchJobFilterMatch(m.filter, obj, now) {
+			objs = append(objs, obj)
+		}
+	}
+	return objs, scanned, ctx.Err()
+}
@@ -0,0 +1,133 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/minio/pkg/bucket/lifecycle"
+	"github.com/minio/minio/pkg/event"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// batchJobPurge - permanently removes object versions and delete markers.
+type batchJobPurge struct {
+	lockEnabled bool
+}
+
+func newBatchJobPurge(req madmin.BatchJobRequest) (batchJobProcessor, error) {
+	rcfg, _ := globalBucketObjectLockSys.Get(req.Bucket)
+	return batchJobPurge{lockEnabled: rcfg.LockEnabled}, nil
+}
+
+func (b batchJobPurge) skip(obj ObjectInfo) bool {
+	return false
+}
+
+func (b batchJobPurge) locked(ctx context.Context, obj ObjectInfo) error {
+	if b.lockEnabled && !obj.DeleteMarker && enforceRetentionForDeletion(ctx, obj) {
+		return fmt.Errorf("%w: object version is locked", errBatchJobSkipped)
+	}
+	return nil
+}
+
+func batchJobPurgeErr(err error) error {
+	if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+		return fmt.Errorf("%w: object version no longer exists", errBatchJobSkipped)
+	}
+	return err
+}
+
+func (b batchJobPurge) process(ctx context.Context, objAPI ObjectLayer, obj ObjectInfo) error {
+	if err := b.locked(ctx, obj); err != nil {
+		return err
+	}
+
+	// Deleting an explicit version never creates a delete marker.
+	objInfo, err := objAPI.DeleteObject(ctx, obj.Bucket, obj.Name, ObjectOptions{
+		VersionID: obj.VersionID,
+	})
+	if err != nil {
+		return batchJobPurgeErr(err)
+	}
+
+	if objInfo.Name == "" {
+		objInfo = obj
+	}
+	b.purged(ctx, objAPI, obj, objInfo)
+	return nil
+}
+
+// processBulk - removes the object versions with bulk deletes, which
+// remove the versions of an object with a single metadata update.
+func (b batchJobPurge) processBulk(ctx context.Context, objAPI ObjectLayer, objs []ObjectInfo) []error {
+	results := make([]error, len(objs))
+
+	var (
+		toDelete []ObjectToDelete
+		indexes  []int
+	)
+	for i, obj := range objs {
+		if results[i] = b.locked(ctx, obj); results[i] != nil {
+			continue
+		}
+		toDelete = append(toDelete, ObjectToDelete{
+			ObjectName: obj.Name,
+			VersionID:  obj.VersionID,
+		})
+		indexes = append(indexes, i)
+	}
+	if len(toDelete) == 0 {
+		return results
+	}
+
+	// All objects of a batch job are in the same bucket,
+	// deleting explicit versions never creates delete markers.
+	_, errs := objAPI.DeleteObjects(ctx, objs[0].Bucket, toDelete, ObjectOptions{})
+	for i, idx := range indexes {
+		if errs[i] != nil {
+			results[idx] = batchJobPurgeErr(errs[i])
+			continue
+		}
+		b.purged(ctx, objAPI, objs[idx], objs[idx])
+	}
+	return results
+}
+
+// purged - removes the remote copy of a transitioned object
+// version which was purged and sends the event of the removal.
+func (b batchJobPurge) purged(ctx context.Context, objAPI ObjectLayer, obj, objInfo ObjectInfo) {
+	if obj.TransitionStatus == lifecycle.TransitionComplete {
+		deleteTransitionedObject(ctx, objAPI, obj.Bucket, obj.Name, lifecycle.ObjectOpts{
+			Name:             obj.Name,
+			UserTags:         obj.UserTags,
+			VersionID:        obj.VersionID,
+			DeleteMarker:     obj.DeleteMarker,
+			TransitionStatus: obj.TransitionStatus,
+			IsLatest:         obj.IsLatest,
+		}, false, true)
+	}
+
+	sendEvent(eventArgs{
+		EventName:  event.ObjectRemovedDelete,
+		BucketName: obj.Bucket,
+		Object:     objInfo,
+		Host:       "Internal: [Batch-Job]",
+	})
+}
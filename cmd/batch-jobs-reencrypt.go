@@ -0,0 +1,106 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/minio/cmd/crypto"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// batchJobReencrypt - seals the object keys of SSE-S3 object
+// versions again with a data key of the current KMS key.
+type batchJobReencrypt struct {
+	keyID string
+}
+
+func newBatchJobReencrypt(req madmin.BatchJobRequest) (batchJobProcessor, error) {
+	if GlobalKMS == nil {
+		return nil, batchJobInvalidArgument("no KMS is configured")
+	}
+	var keyID string
+	if req.Reencrypt != nil {
+		keyID = req.Reencrypt.KeyID
+	}
+	if keyID == "" {
+		stat, err := GlobalKMS.Stat()
+		if err != nil {
+			return nil, err
+		}
+		keyID = stat.DefaultKey
+	}
+	if keyID == "" {
+		return nil, batchJobInvalidArgument("the KMS has no default key, a key ID is required")
+	}
+	return batchJobReencrypt{keyID: keyID}, nil
+}
+
+func (b batchJobReencrypt) skip(obj ObjectInfo) bool {
+	return obj.DeleteMarker
+}
+
+func (b batchJobReencrypt) process(ctx context.Context, objAPI ObjectLayer, obj ObjectInfo) error {
+	srcOpts := ObjectOptions{VersionID: obj.VersionID}
+	objInfo, err := objAPI.GetObjectInfo(ctx, obj.Bucket, obj.Name, srcOpts)
+	if err != nil {
+		if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+			return fmt.Errorf("%w: object version no longer exists", errBatchJobSkipped)
+		}
+		return err
+	}
+
+	// SSE-C keys are only known to clients, and SSE-KMS
+	// object keys are sealed with the key they requested.
+	switch kind, _ := crypto.IsEncrypted(objInfo.UserDefined); kind {
+	case crypto.S3:
+	case nil:
+		return fmt.Errorf("%w: object version is not encrypted", errBatchJobSkipped)
+	default:
+		return fmt.Errorf("%w: object version is encrypted with %s", errBatchJobSkipped, kind)
+	}
+
+	keyID, _, _, err := crypto.S3.ParseMetadata(objInfo.UserDefined)
+	if err != nil {
+		return err
+	}
+	if keyID == b.keyID {
+		// The old KMS may have used the same key ID.
+		if _, err = crypto.S3.UnsealObjectKey(GlobalKMS, objInfo.UserDefined, obj.Bucket, obj.Name); err == nil {
+			return fmt.Errorf("%w: object version is already encrypted with key '%s'", errBatchJobSkipped, b.keyID)
+		}
+	}
+
+	metadata := make(map[string]string, len(objInfo.UserDefined))
+	for k, v := range objInfo.UserDefined {
+		metadata[k] = v
+	}
+	if err = resealS3ObjectKey(b.keyID, obj.Bucket, obj.Name, metadata); err != nil {
+		return err
+	}
+
+	// Copying the version onto itself with the same version
+	// ID only updates its metadata in place, as for the key
+	// rotations done through CopyObject.
+	objInfo.UserDefined = metadata
+	objInfo.metadataOnly = true
+	objInfo.keyRotation = true
+	_, err = objAPI.CopyObject(ctx, obj.Bucket, obj.Name, obj.Bucket, obj.Name, objInfo, srcOpts, srcOpts)
+	return err
+}
@@ -0,0 +1,226 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	miniogo "github.com/minio/minio-go/v7"
+	"github.com/minio/minio/cmd/crypto"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/bucket/replication"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// batchJobReplicationAudit - compares object versions and delete
+// markers with the replication target of their bucket.
+type batchJobReplicationAudit struct {
+	bucket string
+	filter madmin.BatchJobFilter
+	opts   madmin.BatchJobReplicationAuditOptions
+	cfg    *replication.Config
+	tgt    *TargetClient
+}
+
+func newBatchJobReplicationAudit(req madmin.BatchJobRequest) (batchJobProcessor, error) {
+	var opts madmin.BatchJobReplicationAuditOptions
+	if req.ReplicationAudit != nil {
+		opts = *req.ReplicationAudit
+	}
+	cfg, err := getReplicationConfig(GlobalContext, req.Bucket)
+	if err != nil || cfg == nil {
+		return nil, batchJobInvalidArgument("replication is not configured on bucket '%s'", req.Bucket)
+	}
+	tgt := globalBucketTargetSys.GetRemoteTargetClient(GlobalContext, cfg.RoleArn)
+	if tgt == nil {
+		return nil, batchJobInvalidArgument("replication target '%s' of bucket '%s' is not available", cfg.RoleArn, req.Bucket)
+	}
+	return &batchJobReplicationAudit{
+		bucket: req.Bucket,
+		filter: req.Filter,
+		opts:   opts,
+		cfg:    cfg,
+		tgt:    tgt,
+	}, nil
+}
+
+// Replicas and versions which the replication
+// configuration excludes are not audited.
+func (a *batchJobReplicationAudit) skip(obj ObjectInfo) bool {
+	if obj.ReplicationStatus == replication.Replica || !obj.VersionPurgeStatus.Empty() {
+		return true
+	}
+	opts := replication.ObjectOpts{
+		Name:         obj.Name,
+		UserTags:     obj.UserTags,
+		VersionID:    obj.VersionID,
+		DeleteMarker: obj.DeleteMarker,
+		SSEC:         crypto.SSEC.IsEncrypted(obj.UserDefined),
+		OpType:       replication.ObjectReplicationType,
+	}
+	if obj.DeleteMarker {
+		// Delete markers are replicated as unversioned deletes.
+		opts.VersionID = ""
+		opts.OpType = replication.DeleteReplicationType
+	}
+	return !a.cfg.Replicate(opts)
+}
+
+func (a *batchJobReplicationAudit) process(ctx context.Context, objAPI ObjectLayer, obj ObjectInfo) error {
+	objInfo := obj
+	if !obj.DeleteMarker {
+		// Listing does not return all of the object metadata.
+		var err error
+		objInfo, err = objAPI.GetObjectInfo(ctx, obj.Bucket, obj.Name, ObjectOptions{VersionID: obj.VersionID})
+		if err != nil {
+			if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+				return fmt.Errorf("%w: object version no longer exists", errBatchJobSkipped)
+			}
+			return err
+		}
+	}
+
+	versionID := objInfo.VersionID
+	if versionID == nullVersionID {
+		versionID = ""
+	}
+	oi, err := a.tgt.StatObject(ctx, a.tgt.bucket, objInfo.Name, miniogo.StatObjectOptions{
+		VersionID: versionID,
+		Internal: miniogo.AdvancedGetOptions{
+			ReplicationProxyRequest: "false",
+		},
+	})
+	var drift string
+	switch {
+	case oi.IsDeleteMarker:
+		// Stat of a delete marker returns it along with an error.
+		if objInfo.DeleteMarker {
+			return nil
+		}
+		drift = "object version is a delete marker on target"
+	case err != nil:
+		if miniogo.ToErrorResponse(err).StatusCode != http.StatusNotFound {
+			return err
+		}
+		drift = "missing on target"
+	default:
+		switch getReplicationAction(objInfo, oi) {
+		case replicateNone:
+			return nil
+		case replicateMetadata:
+			drift = "metadata differs on target"
+		default:
+			drift = "content differs on target"
+		}
+	}
+
+	if a.opts.Repair {
+		a.repair(objInfo)
+		drift += ", repair queued"
+	}
+	return fmt.Errorf("%w: %s", errBatchJobDrift, drift)
+}
+
+// repair queues the replication of objInfo as the scanner does for
+// failed replications, the queues are not tied to the job context
+// since they are shut down along with the context they are given.
+func (a *batchJobReplicationAudit) repair(objInfo ObjectInfo) {
+	if objInfo.DeleteMarker {
+		globalReplicationPool.queueReplicaDeleteTask(GlobalContext, DeletedObjectVersionInfo{
+			DeletedObject: DeletedObject{
+				ObjectName:                    objInfo.Name,
+				DeleteMarkerVersionID:         objInfo.VersionID,
+				DeleteMarkerReplicationStatus: string(objInfo.ReplicationStatus),
+				DeleteMarkerMTime:             DeleteMarkerMTime{objInfo.ModTime},
+				DeleteMarker:                  true,
+			},
+			Bucket: objInfo.Bucket,
+		})
+		return
+	}
+	globalReplicationPool.queueReplicaTask(GlobalContext, ReplicateObjectInfo{
+		ObjectInfo: objInfo,
+		OpType:     replication.HealReplicationType,
+	})
+}
+
+// finish reports the object versions of the target which do not
+// exist in the bucket. Tags are not returned by listings, so only
+// the prefix and age filters apply to them.
+func (a *batchJobReplicationAudit) finish(ctx context.Context, objAPI ObjectLayer, job *batchJob) error {
+	status := job.getStatus()
+	filter := a.filter
+	filter.Tags = nil
+
+	var (
+		extra   []ObjectInfo
+		results []error
+		scanned uint64
+	)
+	flush := func() {
+		logger.LogIf(ctx, saveBatchJobManifest(ctx, objAPI, status.ID, extra, results))
+		logger.LogIf(ctx, saveBatchJobStatus(ctx, objAPI, job.addResults(scanned, extra, results)))
+		extra, results, scanned = nil, nil, 0
+	}
+
+	now := UTCNow()
+	for oi := range a.tgt.ListObjects(ctx, a.tgt.bucket, miniogo.ListObjectsOptions{
+		WithVersions: true,
+		Prefix:       filter.Prefix,
+		Recursive:    true,
+	}) {
+		if oi.Err != nil {
+			return oi.Err
+		}
+		versionID := oi.VersionID
+		if versionID == nullVersionID {
+			versionID = ""
+		}
+		obj := ObjectInfo{
+			Bucket:       a.bucket,
+			Name:         oi.Key,
+			VersionID:    versionID,
+			ModTime:      oi.LastModified,
+			DeleteMarker: oi.IsDeleteMarker,
+		}
+		if !batchJobFilterMatch(filter, obj, now) {
+			continue
+		}
+		scanned++
+
+		_, err := objAPI.GetObjectInfo(ctx, a.bucket, obj.Name, ObjectOptions{VersionID: versionID})
+		switch err.(type) {
+		case nil, MethodNotAllowed:
+			// Delete markers are found, but cannot be read.
+		case ObjectNotFound, VersionNotFound:
+			extra = append(extra, obj)
+			results = append(results, fmt.Errorf("%w: extra on target", errBatchJobDrift))
+		default:
+			return err
+		}
+		if len(extra) == batchJobBulkSize {
+			flush()
+		}
+	}
+	if scanned > 0 {
+		flush()
+	}
+	return nil
+}
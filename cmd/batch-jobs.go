@@ -0,0 +1,711 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// Batch job status, manifests and cancel markers are
+	// saved under this prefix of the meta bucket.
+	batchJobsPrefix         = "batch-jobs"
+	batchJobsStatusPrefix   = batchJobsPrefix + "/status"
+	batchJobsManifestPrefix = batchJobsPrefix + "/manifest"
+	batchJobsCancelPrefix   = batchJobsPrefix + "/cancel"
+
+	// Number of matching object versions processed between
+	// two checkpoints of a batch job.
+	batchJobCheckpointSize = 1000
+
+	// Maximum time between two checkpoints of a batch job,
+	// progress is saved and cancellation is checked at
+	// least this often even if few objects match.
+	batchJobCheckpointInterval = 10 * time.Second
+
+	batchJobDefaultWorkers = 8
+	batchJobMaxWorkers     = 64
+
+	// Number of object versions handed at once to the
+	// workers of processors which support bulk operations.
+	batchJobBulkSize = 100
+
+	// Shortest interval between the runs of a periodic job,
+	// and how often the periodic jobs due are started.
+	batchJobMinInterval      = time.Hour
+	batchJobScheduleInterval = time.Minute
+)
+
+var (
+	errBatchJobNotFound = AdminError{
+		Code:       "XMinioBatchJobNotFound",
+		Message:    "The specified batch job does not exist",
+		StatusCode: http.StatusNotFound,
+	}
+	errBatchJobRunning = AdminError{
+		Code:       "XMinioBatchJobRunning",
+		Message:    "The specified batch job is still running",
+		StatusCode: http.StatusConflict,
+	}
+)
+
+// errBatchJobSkipped - returned, possibly wrapped with the reason, by
+// processors for object versions they deliberately leave untouched.
+var errBatchJobSkipped = errors.New("skipped")
+
+// errBatchJobDrift - returned, wrapped with the difference found, by
+// processors for object versions which differ from their replicas.
+var errBatchJobDrift = errors.New("drift")
+
+func batchJobInvalidArgument(format string, args ...interface{}) error {
+	return AdminError{
+		Code:       "XMinioBatchJobInvalidArgument",
+		Message:    fmt.Sprintf(format, args...),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+func batchJobStatusPath(id string) string {
+	return path.Join(batchJobsStatusPrefix, id+".json")
+}
+
+func batchJobManifestPath(id string) string {
+	return path.Join(batchJobsManifestPrefix, id) + SlashSeparator
+}
+
+func batchJobCancelPath(id string) string {
+	return path.Join(batchJobsCancelPrefix, id)
+}
+
+// batchJobProcessor - applies the operation of a batch job to
+// the object versions matching the job filter.
+type batchJobProcessor interface {
+	// skip returns true for object versions which match the job
+	// filter but which the job operation does not apply to.
+	skip(obj ObjectInfo) bool
+
+	process(ctx context.Context, objAPI ObjectLayer, obj ObjectInfo) error
+}
+
+// batchJobBulkProcessor - implemented by processors which apply their
+// operation to many object versions at once more efficiently, e.g.
+// bulk deletes. The results are returned in the order of objs.
+type batchJobBulkProcessor interface {
+	processBulk(ctx context.Context, objAPI ObjectLayer, objs []ObjectInfo) []error
+}
+
+// batchJobPreparer - implemented by processors which have work to
+// do before the object versions are processed, prepare is called
+// again when the job is resumed.
+type batchJobPreparer interface {
+	prepare(ctx context.Context, objAPI ObjectLayer) error
+}
+
+// batchJobFinisher - implemented by processors which have work to
+// do once all matching object versions were processed.
+type batchJobFinisher interface {
+	finish(ctx context.Context, objAPI ObjectLayer, job *batchJob) error
+}
+
+// newBatchJobProcessor validates req and returns the processor
+// for its job type.
+func newBatchJobProcessor(req madmin.BatchJobRequest) (batchJobProcessor, error) {
+	switch req.Type {
+	case madmin.BatchJobLegalHold:
+		return newBatchJobLegalHold(req)
+	case madmin.BatchJobPurge:
+		return newBatchJobPurge(req)
+	case madmin.BatchJobMigrate:
+		return newBatchJobMigrate(req)
+	case madmin.BatchJobReencrypt:
+		return newBatchJobReencrypt(req)
+	case madmin.BatchJobReplicationAudit:
+		return newBatchJobReplicationAudit(req)
+	}
+	return nil, batchJobInvalidArgument("unsupported batch job type '%s'", req.Type)
+}
+
+// batchJobFilterMatch returns true if obj matches filter at now.
+func batchJobFilterMatch(filter madmin.BatchJobFilter, obj ObjectInfo, now time.Time) bool {
+	if !strings.HasPrefix(obj.Name, filter.Prefix) {
+		return false
+	}
+	if filter.OlderThan > 0 && obj.ModTime.After(now.Add(-filter.OlderThan)) {
+		return false
+	}
+	if filter.NewerThan > 0 && obj.ModTime.Before(now.Add(-filter.NewerThan)) {
+		return false
+	}
+	if len(filter.Tags) > 0 {
+		if obj.UserTags == "" {
+			return false
+		}
+		t, err := tags.ParseObjectTags(obj.UserTags)
+		if err != nil {
+			return false
+		}
+		objTags := t.ToMap()
+		for k, v := range filter.Tags {
+			if objTags[k] != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// batchJob - a batch job running on this node.
+type batchJob struct {
+	mu     sync.Mutex
+	status madmin.BatchJobStatus
+	cancel context.CancelFunc
+}
+
+func (j *batchJob) getStatus() madmin.BatchJobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status
+}
+
+// addResults accounts for a processed batch and returns the
+// updated status.
+func (j *batchJob) addResults(scanned uint64, batch []ObjectInfo, results []error) madmin.BatchJobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.status.Scanned += scanned
+	j.status.Matched += uint64(len(batch))
+	for _, err := range results {
+		switch {
+		case err == nil:
+			j.status.Succeeded++
+		case errors.Is(err, errBatchJobSkipped):
+			j.status.Skipped++
+		case errors.Is(err, errBatchJobDrift):
+			j.status.Drift++
+		default:
+			j.status.Failed++
+		}
+	}
+	j.status.LastUpdate = UTCNow()
+	return j.status
+}
+
+// BatchJobsSys - runs batch jobs and keeps track of the
+// ones running on this node.
+type BatchJobsSys struct {
+	mu   sync.Mutex
+	jobs map[string]*batchJob
+}
+
+// NewBatchJobsSys - creates a new batch jobs system.
+func NewBatchJobsSys() *BatchJobsSys {
+	return &BatchJobsSys{
+		jobs: make(map[string]*batchJob),
+	}
+}
+
+// initBatchJobs resumes the batch jobs which were running
+// on this node when it was last stopped, and starts the
+// periodic jobs of this node when they are due.
+func initBatchJobs(ctx context.Context, objAPI ObjectLayer) {
+	go func() {
+		globalBatchJobsSys.resume(ctx, objAPI)
+
+		ticker := time.NewTicker(batchJobScheduleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				globalBatchJobsSys.startScheduled(ctx, objAPI)
+			}
+		}
+	}()
+}
+
+func (sys *BatchJobsSys) resume(ctx context.Context, objAPI ObjectLayer) {
+	jobs, err := sys.List(ctx, objAPI)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	for _, status := range jobs {
+		if status.State != madmin.BatchJobRunning || status.Node != globalLocalNodeName {
+			continue
+		}
+		proc, err := newBatchJobProcessor(status.Request)
+		if err != nil {
+			logger.LogIf(ctx, err)
+			continue
+		}
+		sys.run(ctx, objAPI, status, proc)
+	}
+}
+
+// startScheduled starts again the periodic jobs of this node whose
+// next run is due, the schedule moves on to the new job.
+func (sys *BatchJobsSys) startScheduled(ctx context.Context, objAPI ObjectLayer) {
+	jobs, err := sys.List(ctx, objAPI)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	now := UTCNow()
+	for _, status := range jobs {
+		if status.NextRun.IsZero() || status.NextRun.After(now) || status.Node != globalLocalNodeName {
+			continue
+		}
+		status.NextRun = time.Time{}
+		if err = saveBatchJobStatus(ctx, objAPI, status); err != nil {
+			logger.LogIf(ctx, err)
+			continue
+		}
+		if _, err = sys.Start(ctx, objAPI, status.Request); err != nil {
+			logger.LogIf(ctx, fmt.Errorf("unable to start the next run of batch job %s: %w", status.ID, err))
+		}
+	}
+}
+
+// Start validates req and starts a new batch job on this node.
+func (sys *BatchJobsSys) Start(ctx context.Context, objAPI ObjectLayer, req madmin.BatchJobRequest) (madmin.BatchJobStatus, error) {
+	if req.Workers < 0 || req.Workers > batchJobMaxWorkers {
+		return madmin.BatchJobStatus{}, batchJobInvalidArgument("workers must be between 1 and %d", batchJobMaxWorkers)
+	}
+	if req.Workers == 0 {
+		req.Workers = batchJobDefaultWorkers
+	}
+	if req.Filter.OlderThan < 0 || req.Filter.NewerThan < 0 {
+		return madmin.BatchJobStatus{}, batchJobInvalidArgument("age filters cannot be negative")
+	}
+	if req.Interval != 0 && req.Interval < batchJobMinInterval {
+		return madmin.BatchJobStatus{}, batchJobInvalidArgument("interval must be at least %s", batchJobMinInterval)
+	}
+	proc, err := newBatchJobProcessor(req)
+	if err != nil {
+		return madmin.BatchJobStatus{}, err
+	}
+
+	now := UTCNow()
+	status := madmin.BatchJobStatus{
+		ID:         mustGetUUID(),
+		Request:    req,
+		Node:       globalLocalNodeName,
+		State:      madmin.BatchJobRunning,
+		Started:    now,
+		LastUpdate: now,
+	}
+	if err = saveBatchJobStatus(ctx, objAPI, status); err != nil {
+		return status, err
+	}
+
+	// Jobs outlive the request which started them.
+	sys.run(GlobalContext, objAPI, status, proc)
+	return status, nil
+}
+
+func (sys *BatchJobsSys) run(ctx context.Context, objAPI ObjectLayer, status madmin.BatchJobStatus, proc batchJobProcessor) {
+	ctx, cancel := context.WithCancel(ctx)
+	job := &batchJob{status: status, cancel: cancel}
+
+	sys.mu.Lock()
+	sys.jobs[status.ID] = job
+	sys.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		runBatchJob(ctx, objAPI, job, proc)
+
+		sys.mu.Lock()
+		delete(sys.jobs, status.ID)
+		sys.mu.Unlock()
+	}()
+}
+
+// runBatchJob walks all object versions of the job bucket and applies
+// proc to the matching ones in batches, checkpointing the progress and
+// the result manifest after every batch.
+func runBatchJob(ctx context.Context, objAPI ObjectLayer, job *batchJob, proc batchJobProcessor) {
+	status := job.getStatus()
+	req := status.Request
+
+	if p, ok := proc.(batchJobPreparer); ok {
+		if err := p.prepare(ctx, objAPI); err != nil {
+			finishBatchJob(ctx, objAPI, job, err)
+			return
+		}
+	}
+
+	walkCtx, walkCancel := context.WithCancel(ctx)
+	defer walkCancel()
+
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(walkCtx, req.Bucket, req.Filter.Prefix, objInfoCh, ObjectOptions{WalkVersions: true}); err != nil {
+		finishBatchJob(ctx, objAPI, job, err)
+		return
+	}
+	defer func() {
+		// Unblock the walker if the job stopped early.
+		walkCancel()
+		for range objInfoCh {
+		}
+	}()
+
+	var (
+		batch      = make([]ObjectInfo, 0, batchJobCheckpointSize)
+		scanned    uint64
+		lastUpdate = UTCNow()
+	)
+
+	checkpoint := func() bool {
+		results := processBatchJobObjects(ctx, objAPI, proc, batch, req.Workers)
+		if err := saveBatchJobManifest(ctx, objAPI, status.ID, batch, results); err != nil {
+			logger.LogIf(ctx, err)
+		}
+
+		if len(batch) > 0 {
+			job.mu.Lock()
+			job.status.Checkpoint = batch[len(batch)-1].Name
+			job.mu.Unlock()
+		}
+		snapshot := job.addResults(scanned, batch, results)
+
+		logger.LogIf(ctx, saveBatchJobStatus(ctx, objAPI, snapshot))
+
+		batch = batch[:0]
+		scanned = 0
+		lastUpdate = UTCNow()
+
+		if ctx.Err() != nil {
+			return false
+		}
+		// A job can be canceled through any node.
+		return checkConfig(ctx, objAPI, batchJobCancelPath(status.ID)) == errConfigNotFound
+	}
+
+	for obj := range objInfoCh {
+		if ctx.Err() != nil {
+			finishBatchJob(ctx, objAPI, job, context.Canceled)
+			return
+		}
+		// Versions of the checkpoint object are processed again
+		// when resuming since the last batch may have ended in
+		// the middle of them.
+		if status.Checkpoint != "" && obj.Name < status.Checkpoint {
+			continue
+		}
+		scanned++
+		if batchJobFilterMatch(req.Filter, obj, UTCNow()) && !proc.skip(obj) {
+			batch = append(batch, obj)
+		}
+		if len(batch) == batchJobCheckpointSize || UTCNow().Sub(lastUpdate) > batchJobCheckpointInterval {
+			if !checkpoint() {
+				finishBatchJob(ctx, objAPI, job, context.Canceled)
+				return
+			}
+		}
+	}
+
+	if !checkpoint() {
+		finishBatchJob(ctx, objAPI, job, context.Canceled)
+		return
+	}
+	if f, ok := proc.(batchJobFinisher); ok {
+		if err := f.finish(ctx, objAPI, job); err != nil {
+			finishBatchJob(ctx, objAPI, job, err)
+			return
+		}
+	}
+	finishBatchJob(ctx, objAPI, job, nil)
+}
+
+// processBatchJobObjects applies proc to objs using the given
+// number of workers and returns the result of each object.
+func processBatchJobObjects(ctx context.Context, objAPI ObjectLayer, proc batchJobProcessor, objs []ObjectInfo, workers int) []error {
+	results := make([]error, len(objs))
+	if len(objs) == 0 {
+		return results
+	}
+
+	if bp, ok := proc.(batchJobBulkProcessor); ok {
+		processBatchJobBulk(ctx, objAPI, bp, objs, workers, results)
+		return results
+	}
+
+	idxCh := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range idxCh {
+				if err := ctx.Err(); err != nil {
+					results[idx] = err
+					continue
+				}
+				results[idx] = proc.process(ctx, objAPI, objs[idx])
+			}
+		}()
+	}
+	for idx := range objs {
+		idxCh <- idx
+	}
+	close(idxCh)
+	wg.Wait()
+	return results
+}
+
+// processBatchJobBulk hands objs to the workers in chunks of
+// batchJobBulkSize and saves the result of each object in results.
+func processBatchJobBulk(ctx context.Context, objAPI ObjectLayer, proc batchJobBulkProcessor, objs []ObjectInfo, workers int, results []error) {
+	startCh := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for start := range startCh {
+				end := start + batchJobBulkSize
+				if end > len(objs) {
+					end = len(objs)
+				}
+				if err := ctx.Err(); err != nil {
+					for idx := start; idx < end; idx++ {
+						results[idx] = err
+					}
+					continue
+				}
+				copy(results[start:end], proc.processBulk(ctx, objAPI, objs[start:end]))
+			}
+		}()
+	}
+	for start := 0; start < len(objs); start += batchJobBulkSize {
+		startCh <- start
+	}
+	close(startCh)
+	wg.Wait()
+}
+
+func finishBatchJob(ctx context.Context, objAPI ObjectLayer, job *batchJob, err error) {
+	job.mu.Lock()
+	switch {
+	case err == nil:
+		job.status.State = madmin.BatchJobCompleted
+	case err == context.Canceled:
+		job.status.State = madmin.BatchJobCanceled
+	default:
+		job.status.State = madmin.BatchJobFailed
+		job.status.Error = err.Error()
+	}
+	job.status.LastUpdate = UTCNow()
+	if interval := job.status.Request.Interval; interval > 0 && job.status.State != madmin.BatchJobCanceled {
+		job.status.NextRun = job.status.Started.Add(interval)
+	}
+	status := job.status
+	job.mu.Unlock()
+
+	// The job context may be canceled already, the final
+	// state must be saved regardless.
+	logger.LogIf(ctx, saveBatchJobStatus(GlobalContext, objAPI, status))
+	if status.State == madmin.BatchJobCanceled {
+		if err := deleteConfig(GlobalContext, objAPI, batchJobCancelPath(status.ID)); err != nil && err != errConfigNotFound {
+			logger.LogIf(ctx, err)
+		}
+	}
+}
+
+// Status returns the status of the batch job id, the in-memory
+// status is preferred if the job is running on this node.
+func (sys *BatchJobsSys) Status(ctx context.Context, objAPI ObjectLayer, id string) (madmin.BatchJobStatus, error) {
+	sys.mu.Lock()
+	job, ok := sys.jobs[id]
+	sys.mu.Unlock()
+	if ok {
+		return job.getStatus(), nil
+	}
+	return loadBatchJobStatus(ctx, objAPI, id)
+}
+
+// List returns the status of all batch jobs ordered by start time.
+func (sys *BatchJobsSys) List(ctx context.Context, objAPI ObjectLayer) ([]madmin.BatchJobStatus, error) {
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(ctx, minioMetaBucket, batchJobsStatusPrefix+SlashSeparator, objInfoCh, ObjectOptions{}); err != nil {
+		return nil, err
+	}
+
+	var jobs []madmin.BatchJobStatus
+	for obj := range objInfoCh {
+		id := strings.TrimSuffix(path.Base(obj.Name), ".json")
+		status, err := sys.Status(ctx, objAPI, id)
+		if err != nil {
+			logger.LogIf(ctx, err)
+			continue
+		}
+		jobs = append(jobs, status)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].Started.Before(jobs[j].Started)
+	})
+	return jobs, nil
+}
+
+// Cancel cancels the batch job id, jobs running on other nodes
+// stop at their next checkpoint.
+func (sys *BatchJobsSys) Cancel(ctx context.Context, objAPI ObjectLayer, id string) error {
+	sys.mu.Lock()
+	job, ok := sys.jobs[id]
+	sys.mu.Unlock()
+	if ok {
+		job.cancel()
+		return nil
+	}
+
+	status, err := loadBatchJobStatus(ctx, objAPI, id)
+	if err != nil {
+		return err
+	}
+	if status.State != madmin.BatchJobRunning {
+		// Stop the schedule of a periodic job.
+		if !status.NextRun.IsZero() {
+			status.NextRun = time.Time{}
+			return saveBatchJobStatus(ctx, objAPI, status)
+		}
+		return nil
+	}
+	return saveConfig(ctx, objAPI, batchJobCancelPath(id), []byte(UTCNow().Format(time.RFC3339)))
+}
+
+// Remove deletes the status and the manifest of the batch job id.
+func (sys *BatchJobsSys) Remove(ctx context.Context, objAPI ObjectLayer, id string) error {
+	status, err := sys.Status(ctx, objAPI, id)
+	if err != nil {
+		return err
+	}
+	if status.State == madmin.BatchJobRunning {
+		return errBatchJobRunning
+	}
+
+	objInfoCh := make(chan ObjectInfo)
+	if err = objAPI.Walk(ctx, minioMetaBucket, batchJobManifestPath(id), objInfoCh, ObjectOptions{}); err != nil {
+		return err
+	}
+	for obj := range objInfoCh {
+		if err := deleteConfig(ctx, objAPI, obj.Name); err != nil && err != errConfigNotFound {
+			logger.LogIf(ctx, err)
+		}
+	}
+	return deleteConfig(ctx, objAPI, batchJobStatusPath(id))
+}
+
+// WriteManifest writes the result manifest of the batch job id to w.
+func (sys *BatchJobsSys) WriteManifest(ctx context.Context, objAPI ObjectLayer, id string, w io.Writer) error {
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(ctx, minioMetaBucket, batchJobManifestPath(id), objInfoCh, ObjectOptions{}); err != nil {
+		return err
+	}
+	defer func() {
+		for range objInfoCh {
+		}
+	}()
+
+	for obj := range objInfoCh {
+		data, err := readConfig(ctx, objAPI, obj.Name)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadBatchJobStatus(ctx context.Context, objAPI ObjectLayer, id string) (status madmin.BatchJobStatus, err error) {
+	// Job IDs are used in object names, reject anything else.
+	if _, err = uuid.Parse(id); err != nil {
+		return status, errBatchJobNotFound
+	}
+	data, err := readConfig(ctx, objAPI, batchJobStatusPath(id))
+	if err != nil {
+		if err == errConfigNotFound {
+			err = errBatchJobNotFound
+		}
+		return status, err
+	}
+	err = json.Unmarshal(data, &status)
+	return status, err
+}
+
+func saveBatchJobStatus(ctx context.Context, objAPI ObjectLayer, status madmin.BatchJobStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return saveConfig(ctx, objAPI, batchJobStatusPath(status.ID), data)
+}
+
+// saveBatchJobManifest saves the results of one batch as a new part
+// of the job manifest, parts are named so they list in the order
+// they were written.
+func saveBatchJobManifest(ctx context.Context, objAPI ObjectLayer, id string, objs []ObjectInfo, results []error) error {
+	if len(objs) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	for i, obj := range objs {
+		result, errMsg := "ok", ""
+		switch {
+		case results[i] == nil:
+		case errors.Is(results[i], errBatchJobSkipped):
+			result, errMsg = "skipped", results[i].Error()
+		case errors.Is(results[i], errBatchJobDrift):
+			result, errMsg = "drift", results[i].Error()
+		default:
+			result, errMsg = "failed", results[i].Error()
+		}
+		if err := cw.Write([]string{obj.Name, obj.VersionID, result, errMsg}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+
+	part := fmt.Sprintf("%020d.csv", UTCNow().UnixNano())
+	return saveConfig(ctx, objAPI, batchJobManifestPath(id)+part, buf.Bytes())
+}
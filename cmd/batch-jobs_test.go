@@ -0,0 +1,227 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	objectlock "github.com/minio/minio/pkg/bucket/object/lock"
+	"github.com/minio/minio/pkg/bucket/replication"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestBatchJobFilterMatch(t *testing.T) {
+	now := time.Now()
+	obj := ObjectInfo{
+		Name:     "case-42/emails/1.eml",
+		ModTime:  now.Add(-48 * time.Hour),
+		UserTags: "matter=42&custodian=alice",
+	}
+
+	testCases := []struct {
+		filter madmin.BatchJobFilter
+		match  bool
+	}{
+		{madmin.BatchJobFilter{}, true},
+		{madmin.BatchJobFilter{Prefix: "case-42/"}, true},
+		{madmin.BatchJobFilter{Prefix: "case-43/"}, false},
+		{madmin.BatchJobFilter{Tags: map[string]string{"matter": "42"}}, true},
+		{madmin.BatchJobFilter{Tags: map[string]string{"matter": "42", "custodian": "alice"}}, true},
+		{madmin.BatchJobFilter{Tags: map[string]string{"matter": "43"}}, false},
+		{madmin.BatchJobFilter{Tags: map[string]string{"owner": "bob"}}, false},
+		{madmin.BatchJobFilter{OlderThan: 24 * time.Hour}, true},
+		{madmin.BatchJobFilter{OlderThan: 72 * time.Hour}, false},
+		{madmin.BatchJobFilter{NewerThan: 72 * time.Hour}, true},
+		{madmin.BatchJobFilter{NewerThan: 24 * time.Hour}, false},
+	}
+
+	for i, testCase := range testCases {
+		if got := batchJobFilterMatch(testCase.filter, obj, now); got != testCase.match {
+			t.Errorf("Test %d: expected match %v, got %v", i+1, testCase.match, got)
+		}
+	}
+
+	untagged := ObjectInfo{Name: obj.Name, ModTime: obj.ModTime}
+	if batchJobFilterMatch(madmin.BatchJobFilter{Tags: map[string]string{"matter": "42"}}, untagged, now) {
+		t.Error("expected untagged object not to match a tag filter")
+	}
+}
+
+type testBatchJobProcessor struct {
+	failed string
+}
+
+func (p testBatchJobProcessor) skip(obj ObjectInfo) bool {
+	return obj.DeleteMarker
+}
+
+func (p testBatchJobProcessor) process(ctx context.Context, objAPI ObjectLayer, obj ObjectInfo) error {
+	if obj.Name == p.failed {
+		return errors.New("failed")
+	}
+	return nil
+}
+
+func TestProcessBatchJobObjects(t *testing.T) {
+	objs := []ObjectInfo{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}}
+	proc := testBatchJobProcessor{failed: "c"}
+
+	results := processBatchJobObjects(context.Background(), nil, proc, objs, 3)
+	if len(results) != len(objs) {
+		t.Fatalf("expected %d results, got %d", len(objs), len(results))
+	}
+	for i, err := range results {
+		if (err != nil) != (objs[i].Name == "c") {
+			t.Errorf("unexpected result for %s: %v", objs[i].Name, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, err := range processBatchJobObjects(ctx, nil, proc, objs, 2) {
+		if err != context.Canceled {
+			t.Errorf("expected %v, got %v", context.Canceled, err)
+		}
+	}
+}
+
+type testBatchJobBulkProcessor struct {
+	testBatchJobProcessor
+	maxChunk *int32
+}
+
+func (p testBatchJobBulkProcessor) processBulk(ctx context.Context, objAPI ObjectLayer, objs []ObjectInfo) []error {
+	for {
+		max := atomic.LoadInt32(p.maxChunk)
+		if int32(len(objs)) <= max || atomic.CompareAndSwapInt32(p.maxChunk, max, int32(len(objs))) {
+			break
+		}
+	}
+	results := make([]error, len(objs))
+	for i, obj := range objs {
+		results[i] = p.process(ctx, objAPI, obj)
+	}
+	return results
+}
+
+func TestProcessBatchJobObjectsBulk(t *testing.T) {
+	objs := make([]ObjectInfo, 2*batchJobBulkSize+50)
+	for i := range objs {
+		objs[i].Name = fmt.Sprintf("object-%03d", i)
+	}
+	var maxChunk int32
+	proc := testBatchJobBulkProcessor{
+		testBatchJobProcessor: testBatchJobProcessor{failed: objs[batchJobBulkSize+1].Name},
+		maxChunk:              &maxChunk,
+	}
+
+	results := processBatchJobObjects(context.Background(), nil, proc, objs, 2)
+	if len(results) != len(objs) {
+		t.Fatalf("expected %d results, got %d", len(objs), len(results))
+	}
+	for i, err := range results {
+		if (err != nil) != (i == batchJobBulkSize+1) {
+			t.Errorf("unexpected result for %s: %v", objs[i].Name, err)
+		}
+	}
+	if maxChunk != batchJobBulkSize {
+		t.Errorf("expected chunks of at most %d objects, got %d", batchJobBulkSize, maxChunk)
+	}
+}
+
+func TestBatchJobPurgeSkipsLockedVersions(t *testing.T) {
+	obj := ObjectInfo{
+		Bucket:    "bucket",
+		Name:      "object",
+		VersionID: "7e2a6c4b-8d0c-4b5b-9a3e-3f1b0b7a1d2c",
+		UserDefined: map[string]string{
+			objectlock.AmzObjectLockLegalHold: string(objectlock.LegalHoldOn),
+		},
+	}
+
+	err := batchJobPurge{lockEnabled: true}.process(context.Background(), nil, obj)
+	if !errors.Is(err, errBatchJobSkipped) {
+		t.Fatalf("expected locked version to be skipped, got %v", err)
+	}
+}
+
+func TestMigrateBucketPolicy(t *testing.T) {
+	data := []byte(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/public/*"]}]}`)
+
+	if got := migrateBucketPolicy(data, "photos", "photos"); got != string(data) {
+		t.Errorf("expected policy to be unchanged, got %s", got)
+	}
+	expected := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::archive-photos/public/*"]}]}`
+	if got := migrateBucketPolicy(data, "photos", "archive-photos"); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestBatchJobAddResults(t *testing.T) {
+	job := &batchJob{}
+	batch := []ObjectInfo{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}}
+	results := []error{
+		nil,
+		fmt.Errorf("%w: object version no longer exists", errBatchJobSkipped),
+		fmt.Errorf("%w: missing on target", errBatchJobDrift),
+		errors.New("disk not found"),
+	}
+
+	status := job.addResults(10, batch, results)
+	if status.Scanned != 10 || status.Matched != 4 {
+		t.Errorf("expected 10 scanned and 4 matched, got %d and %d", status.Scanned, status.Matched)
+	}
+	if status.Succeeded != 1 || status.Skipped != 1 || status.Drift != 1 || status.Failed != 1 {
+		t.Errorf("expected one version of each result, got %+v", status)
+	}
+}
+
+func TestBatchJobReplicationAuditSkip(t *testing.T) {
+	a := &batchJobReplicationAudit{
+		cfg: &replication.Config{
+			Rules: []replication.Rule{{
+				Status: replication.Enabled,
+				Filter: replication.Filter{Prefix: "logs/"},
+				DeleteMarkerReplication: replication.DeleteMarkerReplication{
+					Status: replication.Disabled,
+				},
+			}},
+		},
+	}
+
+	testCases := []struct {
+		obj  ObjectInfo
+		skip bool
+	}{
+		{ObjectInfo{Name: "logs/1.log"}, false},
+		{ObjectInfo{Name: "tmp/1.log"}, true},
+		{ObjectInfo{Name: "logs/1.log", ReplicationStatus: replication.Replica}, true},
+		{ObjectInfo{Name: "logs/1.log", DeleteMarker: true}, true},
+	}
+
+	for i, testCase := range testCases {
+		if got := a.skip(testCase.obj); got != testCase.skip {
+			t.Errorf("Test %d: expected skip %v, got %v", i+1, testCase.skip, got)
+		}
+	}
+}
@@ -161,6 +161,9 @@ func (b *streamingBitrotReader) ReadAt(buf []byte, offset int64) (int, error) {
 	if !bytes.Equal(b.h.Sum(nil), b.hashBytes) {
 		logger.LogIf(GlobalContext, fmt.Errorf("Disk: %s  -> %s/%s - content hash does not match - expected %s, got %s",
 			b.disk, b.volume, b.filePath, hex.EncodeToString(b.hashBytes), hex.EncodeToString(b.h.Sum(nil))))
+		if disk, ok := b.disk.(*xlStorageDiskIDCheck); ok {
+			disk.stats.addBitrot()
+		}
 		return 0, errFileCorrupt
 	}
 	b.currOffset += int64(len(buf))
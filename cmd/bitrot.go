@@ -24,9 +24,14 @@ import (
 	"fmt"
 	"hash"
 	"io"
+	"runtime"
+	"sync"
+	"sync/atomic"
 
 	"github.com/minio/highwayhash"
+	"github.com/minio/minio/cmd/config/storageclass"
 	"github.com/minio/minio/cmd/logger"
+	"github.com/zeebo/blake3"
 	"golang.org/x/crypto/blake2b"
 )
 
@@ -38,6 +43,7 @@ var bitrotAlgorithms = map[BitrotAlgorithm]string{
 	BLAKE2b512:      "blake2b",
 	HighwayHash256:  "highwayhash256",
 	HighwayHash256S: "highwayhash256S",
+	BLAKE3256S:      "blake3256S",
 }
 
 // New returns a new hash.Hash calculating the given bitrot algorithm.
@@ -54,6 +60,8 @@ func (a BitrotAlgorithm) New() hash.Hash {
 	case HighwayHash256S:
 		hh, _ := highwayhash.New(magicHighwayHash256Key) // New will never return error since key is 256 bit
 		return hh
+	case BLAKE3256S:
+		return blake3.New()
 	default:
 		logger.CriticalIf(GlobalContext, errors.New("Unsupported bitrot algorithm"))
 		return nil
@@ -76,6 +84,21 @@ func (a BitrotAlgorithm) String() string {
 	return name
 }
 
+// streaming reports whether the checksums of the given algorithm
+// are stored interleaved with the data of each shard.
+func (a BitrotAlgorithm) streaming() bool {
+	return a == HighwayHash256S || a == BLAKE3256S
+}
+
+// configuredBitrotAlgorithm returns the bitrot algorithm
+// for new objects, as set in the storage class config.
+func configuredBitrotAlgorithm() BitrotAlgorithm {
+	if globalStorageClass.GetBitrot() == storageclass.BitrotBLAKE3 {
+		return BLAKE3256S
+	}
+	return DefaultBitrotAlgorithm
+}
+
 // NewBitrotVerifier returns a new BitrotVerifier implementing the given algorithm.
 func NewBitrotVerifier(algorithm BitrotAlgorithm, checksum []byte) *BitrotVerifier {
 	return &BitrotVerifier{algorithm, checksum}
@@ -100,14 +123,14 @@ func BitrotAlgorithmFromString(s string) (a BitrotAlgorithm) {
 }
 
 func newBitrotWriter(disk StorageAPI, volume, filePath string, length int64, algo BitrotAlgorithm, shardSize int64, heal bool) io.Writer {
-	if algo == HighwayHash256S {
+	if algo.streaming() {
 		return newStreamingBitrotWriter(disk, volume, filePath, length, algo, shardSize, heal)
 	}
 	return newWholeBitrotWriter(disk, volume, filePath, algo, shardSize)
 }
 
 func newBitrotReader(disk StorageAPI, data []byte, bucket string, filePath string, tillOffset int64, algo BitrotAlgorithm, sum []byte, shardSize int64) io.ReaderAt {
-	if algo == HighwayHash256S {
+	if algo.streaming() {
 		return newStreamingBitrotReader(disk, data, bucket, filePath, tillOffset, algo, shardSize)
 	}
 	return newWholeBitrotReader(disk, bucket, filePath, algo, tillOffset, sum)
@@ -141,7 +164,7 @@ func bitrotWriterSum(w io.Writer) []byte {
 
 // Returns the size of the file with bitrot protection
 func bitrotShardFileSize(size int64, shardSize int64, algo BitrotAlgorithm) int64 {
-	if algo != HighwayHash256S {
+	if !algo.streaming() {
 		return size
 	}
 	return ceilFrac(size, shardSize)*int64(algo.New().Size()) + size
@@ -149,7 +172,7 @@ func bitrotShardFileSize(size int64, shardSize int64, algo BitrotAlgorithm) int6
 
 // bitrotVerify a single stream of data.
 func bitrotVerify(r io.Reader, wantSize, partSize int64, algo BitrotAlgorithm, want []byte, shardSize int64) error {
-	if algo != HighwayHash256S {
+	if !algo.streaming() {
 		h := algo.New()
 		if n, err := io.Copy(h, r); err != nil || n != wantSize {
 			// Premature failure in reading the object, file is corrupt.
@@ -163,7 +186,6 @@ func bitrotVerify(r io.Reader, wantSize, partSize int64, algo BitrotAlgorithm, w
 
 	h := algo.New()
 	hashBuf := make([]byte, h.Size())
-	buf := make([]byte, shardSize)
 	left := wantSize
 
 	// Calculate the size of the bitrot file and compare
@@ -172,6 +194,13 @@ func bitrotVerify(r io.Reader, wantSize, partSize int64, algo BitrotAlgorithm, w
 		return errFileCorrupt
 	}
 
+	// Each shard has its own checksum, so
+	// verify them in parallel when there are many.
+	if workers := runtime.GOMAXPROCS(0); workers > 1 && ceilFrac(partSize, shardSize) > 1 {
+		return bitrotVerifyShards(r, left, algo, shardSize, workers)
+	}
+
+	buf := make([]byte, shardSize)
 	for left > 0 {
 		// Read expected hash...
 		h.Reset()
@@ -198,6 +227,67 @@ func bitrotVerify(r io.Reader, wantSize, partSize int64, algo BitrotAlgorithm, w
 	return nil
 }
 
+// bitrotVerifyShards verifies the checksums of a streaming bitrot file
+// using several goroutines, while the file is read sequentially.
+func bitrotVerifyShards(r io.Reader, left int64, algo BitrotAlgorithm, shardSize int64, workers int) error {
+	hashSize := int64(algo.New().Size())
+
+	// Buffers holding the expected hash followed by the shard,
+	// reused once the shard is verified.
+	free := make(chan []byte, 2*workers)
+	for i := 0; i < cap(free); i++ {
+		free <- make([]byte, hashSize+shardSize)
+	}
+	shards := make(chan []byte, workers)
+
+	var corrupt int32
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h := algo.New()
+			for buf := range shards {
+				h.Reset()
+				h.Write(buf[hashSize:])
+				if !bytes.Equal(h.Sum(nil), buf[:hashSize]) {
+					atomic.StoreInt32(&corrupt, 1)
+				}
+				free <- buf[:cap(buf)]
+			}
+		}()
+	}
+
+	var err error
+	for left > 0 && atomic.LoadInt32(&corrupt) == 0 {
+		buf := <-free
+		// Read expected hash...
+		if _, err = io.ReadFull(r, buf[:hashSize]); err != nil {
+			break
+		}
+		left -= hashSize
+		if left < shardSize {
+			shardSize = left
+		}
+		if _, err = io.ReadFull(r, buf[hashSize:hashSize+shardSize]); err != nil {
+			break
+		}
+		left -= shardSize
+		shards <- buf[:hashSize+shardSize]
+	}
+	close(shards)
+	wg.Wait()
+
+	if err != nil {
+		// Read's failed for object with right size, file is corrupt.
+		return err
+	}
+	if atomic.LoadInt32(&corrupt) == 1 {
+		return errFileCorrupt
+	}
+	return nil
+}
+
 // bitrotSelfTest performs a self-test to ensure that bitrot
 // algorithms compute correct checksums. If any algorithm
 // produces an incorrect checksum it fails with a hard error.
@@ -210,6 +300,7 @@ func bitrotSelfTest() {
 		BLAKE2b512:      "e519b7d84b1c3c917985f544773a35cf265dcab10948be3550320d156bab612124a5ae2ae5a8c73c0eea360f68b0e28136f26e858756dbfe7375a7389f26c669",
 		HighwayHash256:  "39c0407ed3f01b18d22c85db4aeff11e060ca5f43131b0126731ca197cd42313",
 		HighwayHash256S: "39c0407ed3f01b18d22c85db4aeff11e060ca5f43131b0126731ca197cd42313",
+		BLAKE3256S:      "59d11fa729e3b687a5352c7e65dc8e60a7e28100c95da6b0a3b9100e1ac6bd52",
 	}
 	for algorithm := range bitrotAlgorithms {
 		if !algorithm.Available() {
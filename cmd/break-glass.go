@@ -0,0 +1,186 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/auth"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	breakGlassConfigFile = minioConfigPrefix + "/breakglass.json"
+
+	// How long the break-glass credential stays enabled by default,
+	// and at most, before it is sealed again.
+	breakGlassDefaultDuration = time.Hour
+	breakGlassMaxDuration     = 24 * time.Hour
+
+	// The break-glass state is reloaded this often, so that nodes
+	// which missed a change made on another node catch up with it.
+	breakGlassRefreshInterval = time.Minute
+)
+
+var errBreakGlassNotConfigured = AdminError{
+	Code:       "XMinioAdminBreakGlassNotConfigured",
+	Message:    "No break-glass credential is configured on this server",
+	StatusCode: http.StatusBadRequest,
+}
+
+// breakGlassState - the persisted state of the break-glass credential,
+// which is sealed once ExpiresAt has passed.
+type breakGlassState struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+	EnabledBy string    `json:"enabledBy"`
+}
+
+// BreakGlassSys - keeps track of the break-glass credential, an
+// emergency credential with root privileges which is only accepted
+// while an administrator has enabled it.
+type BreakGlassSys struct {
+	cred auth.Credentials
+
+	mu    sync.RWMutex
+	state breakGlassState
+}
+
+// NewBreakGlassSys - creates a new break-glass system for cred,
+// which may be empty if no break-glass credential is configured.
+func NewBreakGlassSys(cred auth.Credentials) *BreakGlassSys {
+	return &BreakGlassSys{cred: cred}
+}
+
+func loadBreakGlassState(ctx context.Context, objAPI ObjectLayer) (state breakGlassState, err error) {
+	data, err := readConfig(ctx, objAPI, breakGlassConfigFile)
+	if err != nil {
+		if err == errConfigNotFound {
+			err = nil
+		}
+		return state, err
+	}
+	err = json.Unmarshal(data, &state)
+	return state, err
+}
+
+// Load - reloads the break-glass state from the backend.
+func (sys *BreakGlassSys) Load(ctx context.Context, objAPI ObjectLayer) error {
+	state, err := loadBreakGlassState(ctx, objAPI)
+	if err != nil {
+		return err
+	}
+	sys.mu.Lock()
+	sys.state = state
+	sys.mu.Unlock()
+	return nil
+}
+
+// Status - returns the state of the break-glass credential.
+func (sys *BreakGlassSys) Status() madmin.BreakGlassStatus {
+	sys.mu.RLock()
+	defer sys.mu.RUnlock()
+
+	status := madmin.BreakGlassStatus{
+		Configured: sys.cred.IsValid(),
+		AccessKey:  sys.cred.AccessKey,
+	}
+	if status.Configured && UTCNow().Before(sys.state.ExpiresAt) {
+		status.Enabled = true
+		status.ExpiresAt = sys.state.ExpiresAt
+		status.EnabledBy = sys.state.EnabledBy
+	}
+	return status
+}
+
+// Set - enables the break-glass credential for duration on behalf of
+// enabledBy, or seals it if duration is zero.
+func (sys *BreakGlassSys) Set(ctx context.Context, objAPI ObjectLayer, enabledBy string, duration time.Duration) (madmin.BreakGlassStatus, error) {
+	if !sys.cred.IsValid() {
+		return madmin.BreakGlassStatus{}, errBreakGlassNotConfigured
+	}
+
+	var state breakGlassState
+	if duration > 0 {
+		state = breakGlassState{
+			ExpiresAt: UTCNow().Add(duration),
+			EnabledBy: enabledBy,
+		}
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return madmin.BreakGlassStatus{}, err
+	}
+	if err = saveConfig(ctx, objAPI, breakGlassConfigFile, data); err != nil {
+		return madmin.BreakGlassStatus{}, err
+	}
+
+	sys.mu.Lock()
+	sys.state = state
+	sys.mu.Unlock()
+	return sys.Status(), nil
+}
+
+// IsAccessKey - returns true if accessKey is the one of the
+// break-glass credential, whether it is enabled or not. Gateways
+// have no break-glass system.
+func (sys *BreakGlassSys) IsAccessKey(accessKey string) bool {
+	return sys != nil && sys.cred.IsValid() && sys.cred.AccessKey == accessKey
+}
+
+// GetCredentials - returns the break-glass credential if accessKey
+// is its access key and the credential is enabled.
+func (sys *BreakGlassSys) GetCredentials(accessKey string) (auth.Credentials, bool) {
+	if !sys.IsAccessKey(accessKey) {
+		return auth.Credentials{}, false
+	}
+	sys.mu.RLock()
+	defer sys.mu.RUnlock()
+	if !UTCNow().Before(sys.state.ExpiresAt) {
+		return auth.Credentials{}, false
+	}
+	return sys.cred, true
+}
+
+func initBreakGlass(ctx context.Context, objAPI ObjectLayer) {
+	logger.LogIf(ctx, globalBreakGlassSys.Load(ctx, objAPI))
+	go func() {
+		ticker := time.NewTicker(breakGlassRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				logger.LogIf(ctx, globalBreakGlassSys.Load(ctx, objAPI))
+			}
+		}
+	}()
+}
+
+// tagBreakGlass - flags the audit entry of requests authenticated
+// with the break-glass credential.
+func tagBreakGlass(ctx context.Context, accessKey string) {
+	if globalBreakGlassSys.IsAccessKey(accessKey) {
+		logger.GetReqInfo(ctx).SetTags("breakGlass", true)
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/auth"
+)
+
+func TestBreakGlassSysCredentials(t *testing.T) {
+	cred, err := auth.CreateCredentials("breakglass", "breakglass-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sys := NewBreakGlassSys(cred)
+
+	// Sealed until enabled.
+	if _, ok := sys.GetCredentials(cred.AccessKey); ok {
+		t.Fatal("Expected the break-glass credential to be sealed")
+	}
+	if status := sys.Status(); !status.Configured || status.Enabled {
+		t.Fatalf("Unexpected status of a sealed credential: %+v", status)
+	}
+
+	sys.state = breakGlassState{ExpiresAt: UTCNow().Add(time.Hour), EnabledBy: "operator"}
+	if got, ok := sys.GetCredentials(cred.AccessKey); !ok || !got.Equal(cred) {
+		t.Fatal("Expected the break-glass credential to be enabled")
+	}
+	if _, ok := sys.GetCredentials("someone-else"); ok {
+		t.Fatal("Expected other access keys to be rejected")
+	}
+	if status := sys.Status(); !status.Enabled || status.EnabledBy != "operator" {
+		t.Fatalf("Unexpected status of an enabled credential: %+v", status)
+	}
+
+	// Sealed again once expired.
+	sys.state.ExpiresAt = UTCNow().Add(-time.Second)
+	if _, ok := sys.GetCredentials(cred.AccessKey); ok {
+		t.Fatal("Expected the expired break-glass credential to be sealed")
+	}
+	if !sys.IsAccessKey(cred.AccessKey) {
+		t.Fatal("Expected the access key to be recognized while sealed")
+	}
+
+	var nilSys *BreakGlassSys
+	if nilSys.IsAccessKey(cred.AccessKey) {
+		t.Fatal("Expected no break-glass credential without a break-glass system")
+	}
+	if NewBreakGlassSys(auth.Credentials{}).IsAccessKey("") {
+		t.Fatal("Expected no break-glass credential when none is configured")
+	}
+}
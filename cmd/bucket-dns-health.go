@@ -0,0 +1,168 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/minio/cmd/config/dns"
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/cmd/logger"
+)
+
+// Maximum time allowed for a single liveness probe of a bucket DNS host.
+const bucketDNSHealthCheckTimeout = 3 * time.Second
+
+// initBucketDNSHealth starts monitoring the hosts of the bucket DNS
+// records, when the health check is enabled in the etcd configuration.
+func initBucketDNSHealth(ctx context.Context) {
+	store, ok := globalDNSConfig.(*dns.CoreDNS)
+	if !ok || !store.HealthCheck() {
+		return
+	}
+	// Check hosts twice per TTL so that clients
+	// stop resolving to an offline host quickly.
+	go monitorBucketDNSHealth(ctx, store, store.MinTTL()/2)
+}
+
+// monitorBucketDNSHealth periodically probes the liveness endpoint of
+// all hosts advertised in bucket DNS records. Records pointing at hosts
+// failing the probe are removed so that clients stop resolving buckets
+// to them, and are added back once the host is healthy again.
+func monitorBucketDNSHealth(ctx context.Context, store *dns.CoreDNS, interval time.Duration) {
+	clnt := &http.Client{Transport: NewGatewayHTTPTransport()}
+
+	t := time.NewTimer(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			for _, host := range store.Hosts() {
+				online := isBucketDNSHostOnline(ctx, clnt, host) == nil
+				if !store.SetHostOnline(host, online) {
+					continue
+				}
+				if online {
+					logger.Info("Bucket DNS host %s is back online, restoring its DNS records", host)
+					restoreBucketDNSRecords(ctx, store)
+				} else {
+					logger.Info("Bucket DNS host %s is offline, removing its DNS records", host)
+					removeBucketDNSRecords(ctx, store, host)
+				}
+			}
+			t.Reset(interval)
+		}
+	}
+}
+
+// isBucketDNSHostOnline - returns an error if the liveness check fails for host.
+func isBucketDNSHostOnline(ctx context.Context, clnt *http.Client, host string) error {
+	ctx, cancel := context.WithTimeout(ctx, bucketDNSHealthCheckTimeout)
+	defer cancel()
+
+	u := &url.URL{
+		Scheme: getURLScheme(globalIsTLS),
+		Host:   net.JoinHostPort(host, globalMinioPort),
+		Path:   pathJoin(healthCheckPathPrefix, healthCheckLivenessPath),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := clnt.Do(req)
+	if err != nil {
+		return err
+	}
+	defer xhttp.DrainBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+	return nil
+}
+
+// removeBucketDNSRecords - removes all bucket DNS records pointing at host.
+func removeBucketDNSRecords(ctx context.Context, store *dns.CoreDNS, host string) {
+	dnsBuckets, err := store.List()
+	if err != nil && !IsErrIgnored(err, dns.ErrNoEntriesFound, dns.ErrDomainMissing) {
+		logger.LogIf(ctx, err)
+		return
+	}
+	for _, records := range dnsBuckets {
+		for _, record := range records {
+			if record.Host != host {
+				continue
+			}
+			if err = store.DeleteRecord(record); err != nil {
+				logger.LogIf(ctx, fmt.Errorf("Failed to remove DNS entry for %s due to %w",
+					record.Key, err))
+			}
+		}
+	}
+}
+
+// restoreBucketDNSRecords - re-creates DNS records for all local buckets,
+// which now include hosts that came back online.
+func restoreBucketDNSRecords(ctx context.Context, store *dns.CoreDNS) {
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		return
+	}
+
+	buckets, err := objAPI.ListBuckets(ctx)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+
+	dnsBuckets, err := store.List()
+	if err != nil && !IsErrIgnored(err, dns.ErrNoEntriesFound, dns.ErrDomainMissing) {
+		logger.LogIf(ctx, err)
+		return
+	}
+
+	hosts := set.CreateStringSet(store.Hosts()...)
+	for _, bucket := range buckets {
+		if records, ok := dnsBuckets[bucket.Name]; ok && !ownsBucketDNSRecords(hosts, records) {
+			// Bucket is owned by a different deployment.
+			continue
+		}
+		if err = store.Put(bucket.Name); err != nil {
+			logger.LogIf(ctx, fmt.Errorf("Failed to update DNS entry for %s due to %w",
+				bucket.Name, err))
+		}
+	}
+}
+
+// ownsBucketDNSRecords - returns true if any of the records point at hosts.
+func ownsBucketDNSRecords(hosts set.StringSet, records []dns.SrvRecord) bool {
+	for _, record := range records {
+		if hosts.Contains(record.Host) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,294 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/minio/minio/cmd/crypto"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// bucketExporter - writes the object versions of a bucket as a tar stream.
+type bucketExporter struct {
+	objAPI  ObjectLayer
+	tw      *tar.Writer
+	info    madmin.BucketExportInfo
+	summary madmin.BucketExportSummary
+}
+
+// bucketExportConfigs - bucket configuration files included in
+// bucket exports. Replication and remote targets are left out
+// since they hold credentials and are specific to a deployment,
+// as are notification targets.
+var bucketExportConfigs = []string{
+	bucketPolicyConfig,
+	bucketLifecycleConfig,
+	bucketSSEConfig,
+	bucketTaggingConfig,
+	bucketQuotaConfigFile,
+	bucketThrottleConfigFile,
+	objectLockConfig,
+	bucketVersioningConfig,
+}
+
+// bucketExportConfigData returns the content of the configuration
+// file configFile of meta, nil if it is not set.
+func bucketExportConfigData(meta BucketMetadata, configFile string) []byte {
+	switch configFile {
+	case bucketPolicyConfig:
+		return meta.PolicyConfigJSON
+	case bucketLifecycleConfig:
+		return meta.LifecycleConfigXML
+	case bucketSSEConfig:
+		return meta.EncryptionConfigXML
+	case bucketTaggingConfig:
+		return meta.TaggingConfigXML
+	case bucketQuotaConfigFile:
+		return meta.QuotaConfigJSON
+	case bucketThrottleConfigFile:
+		return meta.ThrottleConfigJSON
+	case objectLockConfig:
+		return meta.ObjectLockConfigXML
+	case bucketVersioningConfig:
+		return meta.VersioningConfigXML
+	}
+	return nil
+}
+
+// selectExportVersions returns the versions of a single object to
+// be exported, oldest first. versions must be ordered newest first
+// as they are listed. The first returned value tells whether the
+// last version returned was the latest one at info.Before.
+func selectExportVersions(versions []ObjectInfo, info madmin.BucketExportInfo) (bool, []ObjectInfo) {
+	latest := -1
+	for i, v := range versions {
+		if !v.ModTime.After(info.Before) {
+			latest = i
+			break
+		}
+	}
+	if latest < 0 {
+		return false, nil
+	}
+
+	var selected []ObjectInfo
+	for _, v := range versions[latest:] {
+		if !info.After.IsZero() && !v.ModTime.After(info.After) {
+			break
+		}
+		selected = append(selected, v)
+		if info.LatestOnly {
+			break
+		}
+	}
+	if len(selected) == 0 || (info.LatestOnly && selected[0].DeleteMarker) {
+		return false, nil
+	}
+
+	// Oldest first, so that extracting the archive with
+	// a regular tar client leaves the latest version.
+	for i, j := 0, len(selected)-1; i < j; i, j = i+1, j-1 {
+		selected[i], selected[j] = selected[j], selected[i]
+	}
+	return true, selected
+}
+
+func (e *bucketExporter) writeJSON(name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return e.writeFile(name, data)
+}
+
+func (e *bucketExporter) writeFile(name string, data []byte) error {
+	if err := e.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(len(data)),
+		Mode:     0600,
+		ModTime:  UTCNow(),
+		Format:   tar.FormatPAX,
+	}); err != nil {
+		return err
+	}
+	_, err := e.tw.Write(data)
+	return err
+}
+
+// writeObject writes the selected versions of a single object.
+func (e *bucketExporter) writeObject(ctx context.Context, versions []ObjectInfo) error {
+	hasLatest, selected := selectExportVersions(versions, e.info)
+	if len(selected) == 0 {
+		return nil
+	}
+	e.summary.Objects++
+	for i, obj := range selected {
+		isLatest := hasLatest && i == len(selected)-1
+		if err := e.writeVersion(ctx, obj, isLatest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *bucketExporter) writeVersion(ctx context.Context, obj ObjectInfo, isLatest bool) error {
+	meta := make(map[string]string, len(obj.UserDefined))
+	for k, v := range obj.UserDefined {
+		if strings.HasPrefix(strings.ToLower(k), ReservedMetadataPrefixLower) {
+			continue
+		}
+		meta[k] = v
+	}
+	metaData, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     obj.Name,
+		Mode:     0600,
+		ModTime:  obj.ModTime,
+		Format:   tar.FormatPAX,
+		PAXRecords: map[string]string{
+			madmin.BucketExportPAXVersionID:    obj.VersionID,
+			madmin.BucketExportPAXIsLatest:     strconv.FormatBool(isLatest),
+			madmin.BucketExportPAXDeleteMarker: strconv.FormatBool(obj.DeleteMarker),
+			madmin.BucketExportPAXETag:         obj.GetActualETag(nil),
+			madmin.BucketExportPAXMetadata:     string(metaData),
+		},
+	}
+	if obj.UserTags != "" {
+		hdr.PAXRecords[madmin.BucketExportPAXTags] = obj.UserTags
+	}
+	if kind, ok := crypto.IsEncrypted(obj.UserDefined); ok {
+		sse := "unknown"
+		if kind != nil {
+			sse = kind.String()
+		}
+		hdr.PAXRecords[madmin.BucketExportPAXSSE] = sse
+	}
+
+	if obj.DeleteMarker {
+		e.summary.DeleteMarkers++
+		return e.tw.WriteHeader(hdr)
+	}
+
+	// The key of SSE-C encrypted objects is only known to the client.
+	if crypto.SSEC.IsEncrypted(obj.UserDefined) {
+		e.summary.Skipped++
+		hdr.PAXRecords[madmin.BucketExportPAXSkipped] = "object is encrypted with a client provided key"
+		return e.tw.WriteHeader(hdr)
+	}
+
+	gr, err := e.objAPI.GetObjectNInfo(ctx, obj.Bucket, obj.Name, nil, http.Header{}, readLock, ObjectOptions{
+		VersionID: obj.VersionID,
+	})
+	if err != nil {
+		if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+			// Removed after it was listed.
+			e.summary.Skipped++
+			hdr.PAXRecords[madmin.BucketExportPAXSkipped] = "object version no longer exists"
+			return e.tw.WriteHeader(hdr)
+		}
+		return err
+	}
+	defer gr.Close()
+
+	size, err := gr.ObjInfo.GetActualSize()
+	if err != nil {
+		return err
+	}
+	hdr.Size = size
+	if err = e.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err = io.CopyN(e.tw, gr, size); err != nil {
+		return err
+	}
+	e.summary.Versions++
+	e.summary.Bytes += size
+	return nil
+}
+
+// exportBucket writes an export of the bucket described by info to w
+// as a PAX tar stream: an info entry, the bucket configuration, one
+// entry per object version and a summary entry, which is only written
+// if the export is complete.
+func exportBucket(ctx context.Context, objAPI ObjectLayer, info madmin.BucketExportInfo, meta BucketMetadata, w io.Writer) error {
+	e := &bucketExporter{
+		objAPI: objAPI,
+		tw:     tar.NewWriter(w),
+		info:   info,
+	}
+	if err := e.writeJSON(madmin.BucketExportInfoFile, info); err != nil {
+		return err
+	}
+	for _, configFile := range bucketExportConfigs {
+		if data := bucketExportConfigData(meta, configFile); len(data) > 0 {
+			if err := e.writeFile(madmin.BucketExportConfigPrefix+configFile, data); err != nil {
+				return err
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(ctx, info.Bucket, info.Prefix, objInfoCh, ObjectOptions{WalkVersions: true}); err != nil {
+		return err
+	}
+	defer func() {
+		// Unblock the walker if the export stopped early.
+		cancel()
+		for range objInfoCh {
+		}
+	}()
+
+	// Versions of an object are listed together, newest first.
+	var versions []ObjectInfo
+	for obj := range objInfoCh {
+		if len(versions) > 0 && versions[0].Name != obj.Name {
+			if err := e.writeObject(ctx, versions); err != nil {
+				return err
+			}
+			versions = versions[:0]
+		}
+		versions = append(versions, obj)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := e.writeObject(ctx, versions); err != nil {
+		return err
+	}
+
+	if err := e.writeJSON(madmin.BucketExportSummaryFile, e.summary); err != nil {
+		return err
+	}
+	return e.tw.Close()
+}
@@ -0,0 +1,68 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestSelectExportVersions(t *testing.T) {
+	now := time.Now()
+	// Newest first, as listed.
+	versions := []ObjectInfo{
+		{Name: "obj", VersionID: "v4", ModTime: now.Add(-1 * time.Hour), DeleteMarker: true},
+		{Name: "obj", VersionID: "v3", ModTime: now.Add(-2 * time.Hour)},
+		{Name: "obj", VersionID: "v2", ModTime: now.Add(-3 * time.Hour)},
+		{Name: "obj", VersionID: "v1", ModTime: now.Add(-4 * time.Hour)},
+	}
+
+	testCases := []struct {
+		info      madmin.BucketExportInfo
+		hasLatest bool
+		expected  []string
+	}{
+		{madmin.BucketExportInfo{Before: now}, true, []string{"v1", "v2", "v3", "v4"}},
+		{madmin.BucketExportInfo{Before: now.Add(-90 * time.Minute)}, true, []string{"v1", "v2", "v3"}},
+		{madmin.BucketExportInfo{Before: now, After: now.Add(-150 * time.Minute)}, true, []string{"v3", "v4"}},
+		{madmin.BucketExportInfo{Before: now.Add(-5 * time.Hour)}, false, nil},
+		// The object was deleted at the snapshot time.
+		{madmin.BucketExportInfo{Before: now, LatestOnly: true}, false, nil},
+		{madmin.BucketExportInfo{Before: now.Add(-150 * time.Minute), LatestOnly: true}, true, []string{"v2"}},
+		// The latest version at the snapshot time is too old.
+		{madmin.BucketExportInfo{Before: now.Add(-150 * time.Minute), After: now.Add(-2 * time.Hour), LatestOnly: true}, false, nil},
+	}
+
+	for i, testCase := range testCases {
+		hasLatest, selected := selectExportVersions(versions, testCase.info)
+		if len(selected) != len(testCase.expected) {
+			t.Errorf("Test %d: expected %v, got %d versions", i+1, testCase.expected, len(selected))
+			continue
+		}
+		for j, v := range selected {
+			if v.VersionID != testCase.expected[j] {
+				t.Errorf("Test %d: expected %v at %d, got %s", i+1, testCase.expected[j], j, v.VersionID)
+			}
+		}
+		if hasLatest != testCase.hasLatest {
+			t.Errorf("Test %d: expected hasLatest %v, got %v", i+1, testCase.hasLatest, hasLatest)
+		}
+	}
+}
@@ -299,6 +299,13 @@ func (api objectAPIHandlers) ListBucketsHandler(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// Pagination and filtering are opt-in, only when requested.
+	prefix, token, maxBuckets, paginated, argsErr := getListBucketsArgs(r.URL.Query())
+	if argsErr != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(argsErr), r.URL, guessIsBrowserReq(r))
+		return
+	}
+
 	// If etcd, dns federation configured list buckets from etcd.
 	var bucketsInfo []BucketInfo
 	if globalDNSConfig != nil && globalBucketFederation {
@@ -330,6 +337,10 @@ func (api objectAPIHandlers) ListBucketsHandler(w http.ResponseWriter, r *http.R
 		}
 	}
 
+	if paginated {
+		bucketsInfo = filterBucketsInfo(bucketsInfo, prefix, token)
+	}
+
 	if s3Error == ErrAccessDenied {
 		// Set prefix value for "s3:prefix" policy conditionals.
 		r.Header.Set("prefix", "")
@@ -356,24 +367,58 @@ func (api objectAPIHandlers) ListBucketsHandler(w http.ResponseWriter, r *http.R
 			}) {
 				bucketsInfo[n] = bucketInfo
 				n++
+				// One more than a page tells whether the listing is truncated.
+				if paginated && n > maxBuckets {
+					break
+				}
 			}
 		}
 		bucketsInfo = bucketsInfo[:n]
 		// No buckets can be filtered return access denied error.
-		if len(bucketsInfo) == 0 {
+		if len(bucketsInfo) == 0 && !paginated {
 			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL, guessIsBrowserReq(r))
 			return
 		}
 	}
 
+	var nextToken string
+	if paginated && len(bucketsInfo) > maxBuckets {
+		bucketsInfo = bucketsInfo[:maxBuckets]
+		var err error
+		if nextToken, err = encodeListBucketsToken(bucketsInfo[maxBuckets-1].Name); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+			return
+		}
+	}
+
 	// Generate response.
-	response := generateListBucketsResponse(bucketsInfo)
+	response := generateListBucketsResponse(bucketsInfo, prefix, nextToken)
 	encodedSuccessResponse := encodeResponse(response)
 
 	// Write response.
 	writeSuccessResponseXML(w, encodedSuccessResponse)
 }
 
+// filterBucketsInfo returns the buckets whose name starts with prefix
+// and comes after the continuation token, if any, as a sub-slice of
+// bucketsInfo, which object layers already return sorted by name.
+func filterBucketsInfo(bucketsInfo []BucketInfo, prefix, token string) []BucketInfo {
+	less := func(i, j int) bool {
+		return bucketsInfo[i].Name < bucketsInfo[j].Name
+	}
+	if !sort.SliceIsSorted(bucketsInfo, less) {
+		sort.Slice(bucketsInfo, less)
+	}
+	start := sort.Search(len(bucketsInfo), func(i int) bool {
+		return bucketsInfo[i].Name > token && bucketsInfo[i].Name >= prefix
+	})
+	bucketsInfo = bucketsInfo[start:]
+	end := sort.Search(len(bucketsInfo), func(i int) bool {
+		return !strings.HasPrefix(bucketsInfo[i].Name, prefix)
+	})
+	return bucketsInfo[:end]
+}
+
 // DeleteMultipleObjectsHandler - deletes multiple objects.
 func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "DeleteMultipleObjects")
@@ -552,6 +597,9 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 	dObjects, errs := deleteObjectsFn(ctx, bucket, deleteList, ObjectOptions{
 		Versioned:        globalBucketVersioningSys.Enabled(bucket),
 		VersionSuspended: globalBucketVersioningSys.Suspended(bucket),
+		PrefixEnabledFn: func(prefix string) bool {
+			return globalBucketVersioningSys.PrefixEnabled(bucket, prefix)
+		},
 	})
 	deletedObjects := make([]DeletedObject, len(deleteObjects.Objects))
 	for i := range errs {
@@ -1501,6 +1549,14 @@ func (api objectAPIHandlers) PutBucketReplicationConfigHandler(w http.ResponseWr
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrReplicationNeedsVersioningError), r.URL, guessIsBrowserReq(r))
 		return
 	}
+	if vc, err := globalBucketVersioningSys.Get(bucket); err == nil && len(vc.ExcludedPrefixes) > 0 {
+		writeErrorResponse(ctx, w, APIError{
+			Code:           "InvalidBucketState",
+			Description:    "Prefixes are excluded from versioning on this bucket, so replication cannot be configured.",
+			HTTPStatusCode: http.StatusConflict,
+		}, r.URL, guessIsBrowserReq(r))
+		return
+	}
 	replicationConfig, err := replication.ParseConfig(io.LimitReader(r.Body, r.ContentLength))
 	if err != nil {
 		apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
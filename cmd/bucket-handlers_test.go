@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -857,3 +858,30 @@ func testAPIDeleteMultipleObjectsHandler(obj ObjectLayer, instanceType, bucketNa
 	// `ExecObjectLayerAPINilTest` manages the operation.
 	ExecObjectLayerAPINilTest(t, nilBucket, nilObject, instanceType, apiRouter, nilReq)
 }
+
+func TestFilterBucketsInfo(t *testing.T) {
+	bucketsInfo := []BucketInfo{{Name: "photos-2021"}, {Name: "logs"}, {Name: "photos-2019"}, {Name: "photos-2020"}}
+
+	testCases := []struct {
+		prefix   string
+		token    string
+		expected []string
+	}{
+		{"", "", []string{"logs", "photos-2019", "photos-2020", "photos-2021"}},
+		{"photos", "", []string{"photos-2019", "photos-2020", "photos-2021"}},
+		{"photos", "photos-2019", []string{"photos-2020", "photos-2021"}},
+		{"", "photos-2021", nil},
+		{"videos", "", nil},
+	}
+
+	for i, testCase := range testCases {
+		buckets := filterBucketsInfo(append([]BucketInfo{}, bucketsInfo...), testCase.prefix, testCase.token)
+		var names []string
+		for _, bucket := range buckets {
+			names = append(names, bucket.Name)
+		}
+		if !reflect.DeepEqual(names, testCase.expected) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, names)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/etag"
+	"github.com/minio/minio/pkg/hash"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+var errBucketImportInvalidArchive = errors.New("not a bucket export archive")
+
+// bucketImporter - restores a bucket from an export tar stream.
+type bucketImporter struct {
+	objAPI   ObjectLayer
+	bucket   string
+	conflict madmin.BucketImportConflict
+	info     madmin.BucketExportInfo
+
+	versioned bool
+	summary   madmin.BucketImportSummary
+
+	// Decision taken for the last object name seen, versions
+	// of an object are stored next to each other.
+	lastName string
+	skipLast bool
+}
+
+// importBucket restores bucket from the export archive read from r.
+func importBucket(ctx context.Context, objAPI ObjectLayer, bucket string, opts madmin.ImportBucketOptions, r io.Reader) (madmin.BucketImportSummary, error) {
+	im := &bucketImporter{
+		objAPI:   objAPI,
+		bucket:   bucket,
+		conflict: opts.Conflict,
+	}
+
+	tr := tar.NewReader(r)
+	hdr, err := tr.Next()
+	if err != nil || hdr.Name != madmin.BucketExportInfoFile {
+		return im.summary, errBucketImportInvalidArchive
+	}
+	if err = json.NewDecoder(tr).Decode(&im.info); err != nil {
+		return im.summary, errBucketImportInvalidArchive
+	}
+
+	if err = im.makeBucket(ctx); err != nil {
+		return im.summary, err
+	}
+	applyConfig := !opts.SkipConfig && (im.summary.Created || im.conflict == madmin.BucketImportOverwrite)
+
+	for {
+		hdr, err = tr.Next()
+		if err == io.EOF {
+			// The summary is written last, anything
+			// after it is not part of the export.
+			return im.summary, fmt.Errorf("export archive is incomplete")
+		}
+		if err != nil {
+			return im.summary, err
+		}
+
+		switch {
+		case hdr.Name == madmin.BucketExportSummaryFile:
+			return im.summary, nil
+		case strings.HasPrefix(hdr.Name, madmin.BucketExportConfigPrefix):
+			if applyConfig {
+				if err = im.importConfig(strings.TrimPrefix(hdr.Name, madmin.BucketExportConfigPrefix), tr); err != nil {
+					return im.summary, err
+				}
+			}
+		default:
+			if err = im.importVersion(ctx, hdr, tr); err != nil {
+				return im.summary, err
+			}
+		}
+	}
+}
+
+func (im *bucketImporter) makeBucket(ctx context.Context) error {
+	_, err := im.objAPI.GetBucketInfo(ctx, im.bucket)
+	if err == nil {
+		im.versioned = globalBucketVersioningSys.Enabled(im.bucket)
+		return nil
+	}
+	if !isErrBucketNotFound(err) {
+		return err
+	}
+
+	if err = im.objAPI.MakeBucketWithLocation(ctx, im.bucket, BucketOptions{
+		Location:    globalServerRegion,
+		LockEnabled: im.info.LockEnabled,
+	}); err != nil {
+		return err
+	}
+	globalNotificationSys.LoadBucketMetadata(GlobalContext, im.bucket)
+	if globalDNSConfig != nil {
+		logger.LogIf(ctx, globalDNSConfig.Put(im.bucket))
+	}
+
+	im.summary.Created = true
+	im.versioned = im.info.LockEnabled
+	return nil
+}
+
+func (im *bucketImporter) importConfig(configFile string, r io.Reader) error {
+	supported := false
+	for _, f := range bucketExportConfigs {
+		if f == configFile {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return nil
+	}
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if err = globalBucketMetadataSys.Update(im.bucket, configFile, data); err != nil {
+		return err
+	}
+	if configFile == bucketVersioningConfig {
+		im.versioned = globalBucketVersioningSys.Enabled(im.bucket)
+	}
+	im.summary.Configs = append(im.summary.Configs, configFile)
+	return nil
+}
+
+// skip returns true if object must not be imported according
+// to the conflict policy.
+func (im *bucketImporter) skip(ctx context.Context, object string) (bool, error) {
+	if object == im.lastName {
+		return im.skipLast, nil
+	}
+	im.lastName, im.skipLast = object, false
+
+	if im.conflict == madmin.BucketImportOverwrite || im.summary.Created {
+		return false, nil
+	}
+	_, err := im.objAPI.GetObjectInfo(ctx, im.bucket, object, ObjectOptions{})
+	if err != nil {
+		if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if im.conflict == madmin.BucketImportFail {
+		return false, fmt.Errorf("object %s already exists", object)
+	}
+	im.skipLast = true
+	return true, nil
+}
+
+func (im *bucketImporter) importVersion(ctx context.Context, hdr *tar.Header, r io.Reader) error {
+	object := hdr.Name
+	if object == im.lastName && im.skipLast {
+		im.summary.Skipped++
+		return nil
+	}
+	newObject := object != im.lastName
+	skip, err := im.skip(ctx, object)
+	if err != nil {
+		return err
+	}
+	if skip || hdr.PAXRecords[madmin.BucketExportPAXSkipped] != "" {
+		im.summary.Skipped++
+		return nil
+	}
+	if newObject {
+		im.summary.Objects++
+	}
+
+	versionID := hdr.PAXRecords[madmin.BucketExportPAXVersionID]
+	if versionID == nullVersionID {
+		versionID = ""
+	}
+
+	if deleteMarker, _ := strconv.ParseBool(hdr.PAXRecords[madmin.BucketExportPAXDeleteMarker]); deleteMarker {
+		if !im.versioned {
+			im.summary.Skipped++
+			return nil
+		}
+		if versionID == "" {
+			versionID = mustGetUUID()
+		}
+		if _, err = im.objAPI.DeleteObject(ctx, im.bucket, object, ObjectOptions{
+			Versioned:    true,
+			VersionID:    versionID,
+			DeleteMarker: true,
+			MTime:        hdr.ModTime,
+		}); err != nil {
+			return err
+		}
+		im.summary.DeleteMarkers++
+		return nil
+	}
+
+	metadata, err := importVersionMetadata(hdr)
+	if err != nil {
+		return err
+	}
+
+	hashReader, err := hash.NewReader(r, hdr.Size, "", "", hdr.Size)
+	if err != nil {
+		return err
+	}
+	pReader := NewPutObjReader(hashReader)
+
+	// Objects are exported decrypted, encrypt them again if they were
+	// encrypted or if the bucket has default encryption.
+	_, sseErr := globalBucketSSEConfigSys.Get(im.bucket)
+	sse := hdr.PAXRecords[madmin.BucketExportPAXSSE] != "" || globalAutoEncryption || sseErr == nil
+	if sse && im.objAPI.IsEncryptionSupported() && !HasSuffix(object, SlashSeparator) {
+		reader, objectEncryptionKey, err := newEncryptReader(hashReader, nil, im.bucket, object, metadata, true)
+		if err != nil {
+			return err
+		}
+		info := ObjectInfo{Size: hdr.Size}
+		encReader, err := hash.NewReader(etag.Wrap(reader, hashReader), info.EncryptedSize(), "", "", hdr.Size)
+		if err != nil {
+			return err
+		}
+		if pReader, err = pReader.WithEncryption(encReader, &objectEncryptionKey); err != nil {
+			return err
+		}
+	}
+
+	opts := ObjectOptions{
+		UserDefined: metadata,
+		MTime:       hdr.ModTime,
+		Versioned:   im.versioned && versionID != "",
+	}
+	if opts.Versioned {
+		opts.VersionID = versionID
+	}
+	if _, err = im.objAPI.PutObject(ctx, im.bucket, object, pReader, opts); err != nil {
+		return err
+	}
+	im.summary.Versions++
+	im.summary.Bytes += hdr.Size
+	return nil
+}
+
+// importVersionMetadata returns the metadata of an archived object
+// version to store it with.
+func importVersionMetadata(hdr *tar.Header) (map[string]string, error) {
+	var archived map[string]string
+	if meta := hdr.PAXRecords[madmin.BucketExportPAXMetadata]; meta != "" {
+		if err := json.Unmarshal([]byte(meta), &archived); err != nil {
+			return nil, err
+		}
+	}
+	metadata := make(map[string]string, len(archived)+2)
+	for k, v := range archived {
+		// Internal metadata is never trusted from the archive.
+		if strings.HasPrefix(strings.ToLower(k), ReservedMetadataPrefixLower) {
+			continue
+		}
+		metadata[k] = v
+	}
+	if tags := hdr.PAXRecords[madmin.BucketExportPAXTags]; tags != "" {
+		metadata[xhttp.AmzObjectTagging] = tags
+	}
+	// Preserve multipart ETags, which cannot be computed again,
+	// all others are computed from the imported content.
+	if etag := hdr.PAXRecords[madmin.BucketExportPAXETag]; strings.Contains(etag, "-") {
+		metadata["etag"] = etag
+	}
+	return metadata, nil
+}
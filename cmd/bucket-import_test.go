@@ -0,0 +1,94 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestImportBucketInvalidArchive(t *testing.T) {
+	writeArchive := func(names ...string) *bytes.Buffer {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for _, name := range names {
+			data := []byte("{}")
+			if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data))}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := tw.Write(data); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := tw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return &buf
+	}
+
+	testCases := []*bytes.Buffer{
+		bytes.NewBufferString("not a tar archive"),
+		writeArchive(),
+		writeArchive("object"),
+		// The info entry must come first.
+		writeArchive("object", madmin.BucketExportInfoFile),
+	}
+
+	for i, archive := range testCases {
+		_, err := importBucket(context.Background(), nil, "bucket", madmin.ImportBucketOptions{}, archive)
+		if err != errBucketImportInvalidArchive {
+			t.Errorf("Test %d: expected %v, got %v", i+1, errBucketImportInvalidArchive, err)
+		}
+	}
+}
+
+func TestImportVersionMetadata(t *testing.T) {
+	hdr := &tar.Header{
+		PAXRecords: map[string]string{
+			madmin.BucketExportPAXMetadata: `{"content-type":"text/plain","X-Minio-Internal-Server-Side-Encryption-Sealed-Key":"c2VhbGVk"}`,
+			madmin.BucketExportPAXTags:     "key=value",
+			madmin.BucketExportPAXETag:     "d41d8cd98f00b204e9800998ecf8427e",
+		},
+	}
+	metadata, err := importVersionMetadata(hdr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"content-type":          "text/plain",
+		xhttp.AmzObjectTagging: "key=value",
+	}
+	if !reflect.DeepEqual(metadata, expected) {
+		t.Errorf("expected %v, got %v", expected, metadata)
+	}
+
+	// Multipart ETags cannot be computed from the content again.
+	hdr.PAXRecords[madmin.BucketExportPAXETag] = "d41d8cd98f00b204e9800998ecf8427e-2"
+	if metadata, err = importVersionMetadata(hdr); err != nil {
+		t.Fatal(err)
+	}
+	if metadata["etag"] != "d41d8cd98f00b204e9800998ecf8427e-2" {
+		t.Errorf("expected multipart ETag to be preserved, got %v", metadata)
+	}
+}
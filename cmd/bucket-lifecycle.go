@@ -317,7 +317,7 @@ func deleteTransitionedObject(ctx context.Context, objectAPI ObjectLayer, bucket
 	}
 
 	var opts ObjectOptions
-	opts.Versioned = globalBucketVersioningSys.Enabled(bucket)
+	opts.Versioned = globalBucketVersioningSys.PrefixEnabled(bucket, object)
 	opts.VersionID = lcOpts.VersionID
 	if restoredObject {
 		// delete locally restored copy of object or object version
@@ -374,6 +374,7 @@ func transitionObject(ctx context.Context, objectAPI ObjectLayer, objInfo Object
 	lcOpts := lifecycle.ObjectOpts{
 		Name:     objInfo.Name,
 		UserTags: objInfo.UserTags,
+		Size:     objInfo.Size,
 	}
 	arn := getLifecycleTransitionTargetArn(ctx, lc, objInfo.Bucket, lcOpts)
 	if arn == nil {
@@ -410,7 +411,7 @@ func transitionObject(ctx context.Context, objectAPI ObjectLayer, objInfo Object
 	gr.Close()
 
 	var opts ObjectOptions
-	opts.Versioned = globalBucketVersioningSys.Enabled(oi.Bucket)
+	opts.Versioned = globalBucketVersioningSys.PrefixEnabled(oi.Bucket, oi.Name)
 	opts.VersionID = oi.VersionID
 	opts.TransitionStatus = lifecycle.TransitionComplete
 	eventName := event.ObjectTransitionComplete
@@ -452,6 +453,7 @@ func getTransitionedObjectReader(ctx context.Context, bucket, object string, rs
 	arn := getLifecycleTransitionTargetArn(ctx, lc, bucket, lifecycle.ObjectOpts{
 		Name:         object,
 		UserTags:     oi.UserTags,
+		Size:         oi.Size,
 		ModTime:      oi.ModTime,
 		VersionID:    oi.VersionID,
 		DeleteMarker: oi.DeleteMarker,
@@ -634,8 +636,8 @@ func putRestoreOpts(bucket, object string, rreq *RestoreObjectRequest, objInfo O
 			meta[xhttp.AmzServerSideEncryption] = xhttp.AmzEncryptionAES
 		}
 		return ObjectOptions{
-			Versioned:        globalBucketVersioningSys.Enabled(bucket),
-			VersionSuspended: globalBucketVersioningSys.Suspended(bucket),
+			Versioned:        globalBucketVersioningSys.PrefixEnabled(bucket, object),
+			VersionSuspended: globalBucketVersioningSys.PrefixSuspended(bucket, object),
 			UserDefined:      meta,
 		}
 	}
@@ -647,8 +649,8 @@ func putRestoreOpts(bucket, object string, rreq *RestoreObjectRequest, objInfo O
 	}
 
 	return ObjectOptions{
-		Versioned:        globalBucketVersioningSys.Enabled(bucket),
-		VersionSuspended: globalBucketVersioningSys.Suspended(bucket),
+		Versioned:        globalBucketVersioningSys.PrefixEnabled(bucket, object),
+		VersionSuspended: globalBucketVersioningSys.PrefixSuspended(bucket, object),
 		UserDefined:      meta,
 		VersionID:        objInfo.VersionID,
 		MTime:            objInfo.ModTime,
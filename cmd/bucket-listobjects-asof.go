@@ -0,0 +1,143 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// versionAsOf returns the version of an object which was current at
+// asOf, versions must be ordered newest first as they are listed. The
+// second returned value is false if the object did not exist at asOf,
+// either because it was created later or because its current version
+// was a delete marker.
+func versionAsOf(versions []ObjectInfo, asOf time.Time) (ObjectInfo, bool) {
+	for _, v := range versions {
+		if !v.ModTime.After(asOf) {
+			return v, !v.DeleteMarker
+		}
+	}
+	return ObjectInfo{}, false
+}
+
+// asOfLister - collects the result of a point-in-time listing.
+type asOfLister struct {
+	prefix    string
+	delimiter string
+	maxKeys   int
+	asOf      time.Time
+
+	// Last common prefix returned, objects under it are skipped.
+	lastPrefix string
+	// Last object name or common prefix returned.
+	last string
+
+	loi ListObjectVersionsInfo
+	n   int
+}
+
+// add adds the version of the object current at asOf, if any. It
+// returns false once the listing is full.
+func (l *asOfLister) add(versions []ObjectInfo) bool {
+	if len(versions) == 0 {
+		return true
+	}
+	obj, ok := versionAsOf(versions, l.asOf)
+	if !ok {
+		return true
+	}
+
+	var commonPrefix string
+	if l.delimiter != "" {
+		rest := strings.TrimPrefix(obj.Name, l.prefix)
+		if i := strings.Index(rest, l.delimiter); i >= 0 {
+			commonPrefix = l.prefix + rest[:i+len(l.delimiter)]
+			if commonPrefix == l.lastPrefix {
+				return true
+			}
+		}
+	}
+
+	if l.n == l.maxKeys {
+		l.loi.IsTruncated = true
+		l.loi.NextMarker = l.last
+		return false
+	}
+	l.n++
+	if commonPrefix != "" {
+		l.lastPrefix = commonPrefix
+		l.loi.Prefixes = append(l.loi.Prefixes, commonPrefix)
+		l.last = commonPrefix
+	} else {
+		l.loi.Objects = append(l.loi.Objects, obj)
+		l.last = obj.Name
+	}
+	return true
+}
+
+// listObjectVersionsAsOf lists bucket as it was at asOf: one entry
+// per object, the version which was current at that time, leaving
+// out the objects which did not exist or were deleted. Common
+// prefixes are only returned if they held an object at asOf.
+//
+// The returned versions keep their IsLatest flag, a version which
+// is not the latest one has been overwritten or deleted since asOf.
+func listObjectVersionsAsOf(ctx context.Context, objAPI ObjectLayer, bucket, prefix, marker, delimiter string, maxKeys int, asOf time.Time) (ListObjectVersionsInfo, error) {
+	if maxKeys <= 0 || maxKeys > maxObjectList {
+		maxKeys = maxObjectList
+	}
+	l := &asOfLister{
+		prefix:    prefix,
+		delimiter: delimiter,
+		maxKeys:   maxKeys,
+		asOf:      asOf,
+	}
+	// The marker of a truncated listing may be a common prefix,
+	// the objects under it have already been accounted for.
+	if delimiter != "" && strings.HasSuffix(marker, delimiter) {
+		l.lastPrefix = marker
+	}
+
+	// Versions of an object are listed together, newest first,
+	// and may be split over several pages.
+	var versions []ObjectInfo
+	keyMarker, versionMarker := marker, ""
+	for {
+		res, err := objAPI.ListObjectVersions(ctx, bucket, prefix, keyMarker, versionMarker, "", maxObjectList)
+		if err != nil {
+			return ListObjectVersionsInfo{}, err
+		}
+		for _, obj := range res.Objects {
+			if len(versions) > 0 && versions[0].Name != obj.Name {
+				if !l.add(versions) {
+					return l.loi, nil
+				}
+				versions = versions[:0]
+			}
+			versions = append(versions, obj)
+		}
+		if !res.IsTruncated || len(res.Objects) == 0 {
+			break
+		}
+		keyMarker, versionMarker = res.NextMarker, res.NextVersionIDMarker
+	}
+	l.add(versions)
+	return l.loi, nil
+}
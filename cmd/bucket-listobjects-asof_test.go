@@ -0,0 +1,95 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestVersionAsOf(t *testing.T) {
+	t0 := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	versions := []ObjectInfo{
+		{Name: "obj", VersionID: "v3", ModTime: t0.Add(3 * time.Hour)},
+		{Name: "obj", VersionID: "v2", ModTime: t0.Add(2 * time.Hour), DeleteMarker: true},
+		{Name: "obj", VersionID: "v1", ModTime: t0.Add(time.Hour)},
+	}
+
+	testCases := []struct {
+		asOf      time.Time
+		versionID string
+		exists    bool
+	}{
+		// Before the object was created.
+		{t0, "", false},
+		{t0.Add(time.Hour), "v1", true},
+		{t0.Add(90 * time.Minute), "v1", true},
+		// Deleted at that time.
+		{t0.Add(150 * time.Minute), "v2", false},
+		{t0.Add(4 * time.Hour), "v3", true},
+	}
+
+	for i, testCase := range testCases {
+		v, ok := versionAsOf(versions, testCase.asOf)
+		if ok != testCase.exists || v.VersionID != testCase.versionID {
+			t.Errorf("Test %d: expected (%s, %t), got (%s, %t)", i+1, testCase.versionID, testCase.exists, v.VersionID, ok)
+		}
+	}
+}
+
+func TestAsOfListerAdd(t *testing.T) {
+	t0 := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	live := func(name string) []ObjectInfo {
+		return []ObjectInfo{{Name: name, ModTime: t0}}
+	}
+	deleted := func(name string) []ObjectInfo {
+		return []ObjectInfo{{Name: name, ModTime: t0, DeleteMarker: true}}
+	}
+
+	l := &asOfLister{
+		prefix:    "p/",
+		delimiter: "/",
+		maxKeys:   3,
+		asOf:      t0,
+	}
+	objects := [][]ObjectInfo{
+		deleted("p/a/1"),
+		live("p/a/2"),
+		live("p/a/3"),
+		deleted("p/b/1"),
+		live("p/c"),
+		live("p/d/1"),
+		live("p/e"),
+	}
+	for _, versions := range objects {
+		if !l.add(versions) {
+			break
+		}
+	}
+
+	if !reflect.DeepEqual(l.loi.Prefixes, []string{"p/a/", "p/d/"}) {
+		t.Errorf("unexpected prefixes %v", l.loi.Prefixes)
+	}
+	if len(l.loi.Objects) != 1 || l.loi.Objects[0].Name != "p/c" {
+		t.Errorf("unexpected objects %v", l.loi.Objects)
+	}
+	if !l.loi.IsTruncated || l.loi.NextMarker != "p/d/" {
+		t.Errorf("expected listing truncated at p/d/, got %t %s", l.loi.IsTruncated, l.loi.NextMarker)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/minio/minio/cmd/logger"
@@ -108,6 +109,20 @@ func (api objectAPIHandlers) ListObjectVersionsHandler(w http.ResponseWriter, r
 
 	listObjectVersions := objectAPI.ListObjectVersions
 
+	// MinIO extension: list the bucket as it was at the time given
+	// by "as-of", one version per object.
+	if v := urlValues.Get("as-of"); v != "" {
+		asOf, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrMalformedDate), r.URL, guessIsBrowserReq(r))
+			return
+		}
+		versionIDMarker = ""
+		listObjectVersions = func(ctx context.Context, bucket, prefix, marker, versionMarker, delimiter string, maxKeys int) (ListObjectVersionsInfo, error) {
+			return listObjectVersionsAsOf(ctx, objectAPI, bucket, prefix, marker, delimiter, maxKeys, asOf)
+		}
+	}
+
 	// Inititate a list object versions operation based on the input params.
 	// On success would return back ListObjectsInfo object to be
 	// marshaled into S3 compatible XML header.
@@ -51,6 +51,7 @@ func (sys *BucketMetadataSys) Remove(bucket string) {
 	sys.Lock()
 	delete(sys.metadataMap, bucket)
 	globalBucketMonitor.DeleteBucket(bucket)
+	globalBucketThrottleSys.remove(bucket)
 	sys.Unlock()
 }
 
@@ -67,6 +68,9 @@ func (sys *BucketMetadataSys) Set(bucket string, meta BucketMetadata) {
 	if bucket != minioMetaBucket {
 		sys.Lock()
 		sys.metadataMap[bucket] = meta
+		if meta.throttleConfig == nil || meta.throttleConfig.IsEmpty() {
+			globalBucketThrottleSys.remove(bucket)
+		}
 		sys.Unlock()
 	}
 }
@@ -153,6 +157,8 @@ func (sys *BucketMetadataSys) Update(bucket string, configFile string, configDat
 		meta.TaggingConfigXML = configData
 	case bucketQuotaConfigFile:
 		meta.QuotaConfigJSON = configData
+	case bucketThrottleConfigFile:
+		meta.ThrottleConfigJSON = configData
 	case objectLockConfig:
 		if !globalIsErasure && !globalIsDistErasure {
 			return NotImplemented{}
@@ -347,6 +353,16 @@ func (sys *BucketMetadataSys) GetQuotaConfig(bucket string) (*madmin.BucketQuota
 	return meta.quotaConfig, nil
 }
 
+// GetThrottleConfig returns configured bucket request limits
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetThrottleConfig(bucket string) (*madmin.BucketThrottle, error) {
+	meta, err := sys.GetConfig(bucket)
+	if err != nil {
+		return nil, err
+	}
+	return meta.throttleConfig, nil
+}
+
 // GetReplicationConfig returns configured bucket replication config
 // The returned object may not be modified.
 func (sys *BucketMetadataSys) GetReplicationConfig(ctx context.Context, bucket string) (*replication.Config, error) {
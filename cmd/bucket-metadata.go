@@ -80,6 +80,7 @@ type BucketMetadata struct {
 	ReplicationConfigXML        []byte
 	BucketTargetsConfigJSON     []byte
 	BucketTargetsConfigMetaJSON []byte
+	ThrottleConfigJSON          []byte
 
 	// Unexported fields. Must be updated atomically.
 	policyConfig           *policy.Policy
@@ -93,6 +94,7 @@ type BucketMetadata struct {
 	replicationConfig      *replication.Config
 	bucketTargetConfig     *madmin.BucketTargets
 	bucketTargetConfigMeta map[string]string
+	throttleConfig         *madmin.BucketThrottle
 }
 
 // newBucketMetadata creates BucketMetadata with the supplied name and Created to Now.
@@ -103,7 +105,8 @@ func newBucketMetadata(name string) BucketMetadata {
 		notificationConfig: &event.Config{
 			XMLNS: "http://s3.amazonaws.com/doc/2006-03-01/",
 		},
-		quotaConfig: &madmin.BucketQuota{},
+		quotaConfig:    &madmin.BucketQuota{},
+		throttleConfig: &madmin.BucketThrottle{},
 		versioningConfig: &versioning.Versioning{
 			XMLNS: "http://s3.amazonaws.com/doc/2006-03-01/",
 		},
@@ -232,6 +235,15 @@ func (b *BucketMetadata) parseAllConfigs(ctx context.Context, objectAPI ObjectLa
 		}
 	}
 
+	if len(b.ThrottleConfigJSON) != 0 {
+		b.throttleConfig, err = parseBucketThrottle(b.Name, b.ThrottleConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.throttleConfig = &madmin.BucketThrottle{}
+	}
+
 	if len(b.ReplicationConfigXML) != 0 {
 		b.replicationConfig, err = replication.ParseConfig(bytes.NewReader(b.ReplicationConfigXML))
 		if err != nil {
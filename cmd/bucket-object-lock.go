@@ -20,13 +20,17 @@ import (
 	"context"
 	"math"
 	"net/http"
+	"time"
 
 	xhttp "github.com/minio/minio/cmd/http"
 	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/cmd/logger/message/audit"
 	"github.com/minio/minio/pkg/auth"
 	objectlock "github.com/minio/minio/pkg/bucket/object/lock"
 	"github.com/minio/minio/pkg/bucket/policy"
 	"github.com/minio/minio/pkg/bucket/replication"
+	"github.com/minio/minio/pkg/handlers"
+	"github.com/minio/minio/pkg/madmin"
 )
 
 // BucketObjectLockSys - map of bucket and retention configuration.
@@ -54,6 +58,23 @@ func (sys *BucketObjectLockSys) Get(bucketName string) (r objectlock.Retention,
 	return config.ToRetention(), nil
 }
 
+// GetForObject - Get retention configuration applied to new versions of
+// the object, taking the prefix rules of the bucket into account.
+func (sys *BucketObjectLockSys) GetForObject(bucketName, object string) (r objectlock.Retention, err error) {
+	if globalIsGateway {
+		return sys.Get(bucketName)
+	}
+
+	config, err := globalBucketMetadataSys.GetObjectLockConfig(bucketName)
+	if err != nil {
+		if _, ok := err.(BucketObjectLockConfigNotFound); ok {
+			return r, nil
+		}
+		return r, err
+	}
+	return config.ToObjectRetention(object), nil
+}
+
 // enforceRetentionForDeletion checks if it is appropriate to remove an
 // object according to locking configuration when this is lifecycle/ bucket quota asking.
 func enforceRetentionForDeletion(ctx context.Context, objInfo ObjectInfo) (locked bool) {
@@ -163,6 +184,9 @@ func enforceRetentionBypassForDelete(ctx context.Context, r *http.Request, bucke
 			if govBypassPerms1 != ErrNone && govBypassPerms2 != ErrNone {
 				return ErrAccessDenied
 			}
+			if t, err := objectlock.UTCNowNTP(); err != nil || ret.RetainUntilDate.After(t) {
+				auditLogGovernanceBypass(ctx, r, bucket, object.ObjectName, oi.VersionID, ret)
+			}
 		}
 	}
 	return ErrNone
@@ -212,10 +236,13 @@ func enforceRetentionBypassForPut(ctx context.Context, r *http.Request, bucket,
 				objRetention.RetainUntilDate.Time, objRetention.Mode,
 				byPassSet, r, cred, owner, claims)
 			// Governance mode retention period cannot be shortened, if x-amz-bypass-governance is not set.
+			weakened := objRetention.Mode != objectlock.RetGovernance || objRetention.RetainUntilDate.Before((ret.RetainUntilDate.Time))
 			if !byPassSet {
-				if objRetention.Mode != objectlock.RetGovernance || objRetention.RetainUntilDate.Before((ret.RetainUntilDate.Time)) {
+				if weakened {
 					return oi, ErrObjectLocked
 				}
+			} else if weakened && govPerm == ErrNone {
+				auditLogGovernanceBypass(ctx, r, bucket, object, oi.VersionID, ret)
 			}
 			return oi, govPerm
 		case objectlock.RetCompliance:
@@ -256,7 +283,7 @@ func checkPutObjectLockAllowed(ctx context.Context, rq *http.Request, bucket, ob
 	retentionRequested := objectlock.IsObjectLockRetentionRequested(rq.Header)
 	legalHoldRequested := objectlock.IsObjectLockLegalHoldRequested(rq.Header)
 
-	retentionCfg, err := globalBucketObjectLockSys.Get(bucket)
+	retentionCfg, err := globalBucketObjectLockSys.GetForObject(bucket, object)
 	if err != nil {
 		return mode, retainDate, legalHold, ErrInvalidBucketObjectLockConfiguration
 	}
@@ -346,3 +373,92 @@ func checkPutObjectLockAllowed(ctx context.Context, rq *http.Request, bucket, ob
 func NewBucketObjectLockSys() *BucketObjectLockSys {
 	return &BucketObjectLockSys{}
 }
+
+// auditLogGovernanceBypass - sends a dedicated audit entry whenever
+// x-amz-bypass-governance-retention is used to delete an object
+// version, or to shorten or remove its retention, while it is still
+// under governance mode retention.
+func auditLogGovernanceBypass(ctx context.Context, r *http.Request, bucket, object, versionID string, ret objectlock.ObjectRetention) {
+	entry := audit.NewEntry(globalDeploymentID)
+	entry.Trigger = "governance-bypass"
+	entry.API.Bucket = bucket
+	entry.API.Object = object
+	entry.RemoteHost = handlers.GetSourceIP(r)
+	entry.UserAgent = r.UserAgent()
+	entry.Tags = map[string]interface{}{
+		"versionId":       versionID,
+		"retentionMode":   string(ret.Mode),
+		"retainUntilDate": ret.RetainUntilDate.Format(time.RFC3339),
+	}
+	if reqInfo := logger.GetReqInfo(ctx); reqInfo != nil {
+		entry.API.Name = reqInfo.API
+		entry.RequestID = reqInfo.RequestID
+		entry.Tags["accessKey"] = reqInfo.AccessKey
+	}
+	ctx = logger.SetAuditEntry(ctx, &entry)
+	logger.AuditLog(ctx, nil, nil, nil)
+}
+
+// objectLockReportBuilder accumulates the retention and legal hold
+// status of object versions into an object lock bucket report.
+type objectLockReportBuilder struct {
+	report madmin.ObjectLockBucketReport
+	now    time.Time
+}
+
+func (b *objectLockReportBuilder) add(obj ObjectInfo) {
+	if obj.DeleteMarker {
+		return
+	}
+	b.report.Versions++
+
+	lhold := objectlock.GetObjectLegalHoldMeta(obj.UserDefined)
+	if lhold.Status.Valid() && lhold.Status == objectlock.LegalHoldOn {
+		b.report.LegalHold++
+	}
+
+	ret := objectlock.GetObjectRetentionMeta(obj.UserDefined)
+	if !ret.Mode.Valid() || !ret.RetainUntilDate.After(b.now) {
+		return
+	}
+	switch ret.Mode {
+	case objectlock.RetGovernance:
+		b.report.Governance++
+	case objectlock.RetCompliance:
+		b.report.Compliance++
+	}
+	until := ret.RetainUntilDate.Time
+	if b.report.EarliestRetainUntil == nil || until.Before(*b.report.EarliestRetainUntil) {
+		b.report.EarliestRetainUntil = &until
+	}
+	if b.report.LatestRetainUntil == nil || until.After(*b.report.LatestRetainUntil) {
+		b.report.LatestRetainUntil = &until
+	}
+}
+
+// getObjectLockBucketReport - walks all the object versions in bucket and
+// reports the ones currently protected by retention or legal hold.
+func getObjectLockBucketReport(ctx context.Context, objAPI ObjectLayer, bucket string) (madmin.ObjectLockBucketReport, error) {
+	now, err := objectlock.UTCNowNTP()
+	if err != nil {
+		return madmin.ObjectLockBucketReport{}, err
+	}
+
+	b := objectLockReportBuilder{
+		report: madmin.ObjectLockBucketReport{Bucket: bucket},
+		now:    now,
+	}
+	if rcfg, err := globalBucketObjectLockSys.Get(bucket); err == nil && rcfg.Mode.Valid() {
+		b.report.DefaultMode = string(rcfg.Mode)
+		b.report.DefaultValidity = rcfg.Validity.String()
+	}
+
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(ctx, bucket, "", objInfoCh, ObjectOptions{WalkVersions: true}); err != nil {
+		return b.report, err
+	}
+	for obj := range objInfoCh {
+		b.add(obj)
+	}
+	return b.report, ctx.Err()
+}
@@ -0,0 +1,74 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	objectlock "github.com/minio/minio/pkg/bucket/object/lock"
+)
+
+func TestObjectLockReportBuilder(t *testing.T) {
+	now := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	retained := func(mode objectlock.RetMode, until time.Time) ObjectInfo {
+		return ObjectInfo{UserDefined: map[string]string{
+			objectlock.AmzObjectLockMode:            string(mode),
+			objectlock.AmzObjectLockRetainUntilDate: until.Format(time.RFC3339),
+		}}
+	}
+
+	earliest := now.Add(24 * time.Hour)
+	latest := now.Add(365 * 24 * time.Hour)
+	objects := []ObjectInfo{
+		{},
+		{DeleteMarker: true},
+		retained(objectlock.RetGovernance, earliest),
+		retained(objectlock.RetCompliance, latest),
+		retained(objectlock.RetCompliance, now.Add(-time.Hour)), // expired retention.
+		{UserDefined: map[string]string{
+			objectlock.AmzObjectLockLegalHold: string(objectlock.LegalHoldOn),
+		}},
+		{UserDefined: map[string]string{
+			objectlock.AmzObjectLockLegalHold: string(objectlock.LegalHoldOff),
+		}},
+	}
+
+	b := objectLockReportBuilder{now: now}
+	for _, obj := range objects {
+		b.add(obj)
+	}
+
+	if b.report.Versions != 6 {
+		t.Errorf("expected 6 versions, got %d", b.report.Versions)
+	}
+	if b.report.Governance != 1 {
+		t.Errorf("expected 1 version under governance, got %d", b.report.Governance)
+	}
+	if b.report.Compliance != 1 {
+		t.Errorf("expected 1 version under compliance, got %d", b.report.Compliance)
+	}
+	if b.report.LegalHold != 1 {
+		t.Errorf("expected 1 version under legal hold, got %d", b.report.LegalHold)
+	}
+	if b.report.EarliestRetainUntil == nil || !b.report.EarliestRetainUntil.Equal(earliest) {
+		t.Errorf("expected earliest retain until %s, got %v", earliest, b.report.EarliestRetainUntil)
+	}
+	if b.report.LatestRetainUntil == nil || !b.report.LatestRetainUntil.Equal(latest) {
+		t.Errorf("expected latest retain until %s, got %v", latest, b.report.LatestRetainUntil)
+	}
+}
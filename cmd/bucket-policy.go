@@ -17,6 +17,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -26,9 +27,11 @@ import (
 
 	jsoniter "github.com/json-iterator/go"
 	miniogopolicy "github.com/minio/minio-go/v7/pkg/policy"
+	"github.com/minio/minio-go/v7/pkg/tags"
 	xhttp "github.com/minio/minio/cmd/http"
 	"github.com/minio/minio/cmd/logger"
 	"github.com/minio/minio/pkg/bucket/policy"
+	"github.com/minio/minio/pkg/bucket/policy/condition"
 	"github.com/minio/minio/pkg/handlers"
 )
 
@@ -76,19 +79,14 @@ func getConditionValues(r *http.Request, lc string, username string, claims map[
 		}
 	}
 
-	vid := r.URL.Query().Get("versionId")
-	if vid == "" {
-		if u, err := url.Parse(r.Header.Get(xhttp.AmzCopySource)); err == nil {
-			vid = u.Query().Get("versionId")
-		}
-	}
+	vid := getConditionVersionID(r)
 
 	authType := getRequestAuthType(r)
 	var signatureVersion string
 	switch authType {
 	case authTypeSignedV2, authTypePresignedV2:
 		signatureVersion = signV2Algorithm
-	case authTypeSigned, authTypePresigned, authTypeStreamingSigned, authTypePostPolicy:
+	case authTypeSigned, authTypePresigned, authTypeStreamingSigned, authTypeStreamingUnsignedTrailer, authTypePostPolicy:
 		signatureVersion = signV4Algorithm
 	}
 
@@ -96,7 +94,7 @@ func getConditionValues(r *http.Request, lc string, username string, claims map[
 	switch authType {
 	case authTypePresignedV2, authTypePresigned:
 		authtype = "REST-QUERY-STRING"
-	case authTypeSignedV2, authTypeSigned, authTypeStreamingSigned:
+	case authTypeSignedV2, authTypeSigned, authTypeStreamingSigned, authTypeStreamingUnsignedTrailer:
 		authtype = "REST-HEADER"
 	case authTypePostPolicy:
 		authtype = "POST"
@@ -121,7 +119,18 @@ func getConditionValues(r *http.Request, lc string, username string, claims map[
 		args["LocationConstraint"] = []string{lc}
 	}
 
+	// Validity of presigned requests, only ever set by the server.
+	switch authType {
+	case authTypePresigned:
+		args[xhttp.AmzExpires] = []string{r.URL.Query().Get(xhttp.AmzExpires)}
+	case authTypePresignedV2:
+		if expires, err := strconv.ParseInt(r.URL.Query().Get(xhttp.Expires), 10, 64); err == nil {
+			args[xhttp.AmzExpires] = []string{strconv.FormatInt(expires-currTime.Unix(), 10)}
+		}
+	}
+
 	cloneHeader := r.Header.Clone()
+	cloneHeader.Del(xhttp.AmzExpires)
 
 	for _, objLock := range []string{
 		xhttp.AmzObjectLockMode,
@@ -146,6 +155,7 @@ func getConditionValues(r *http.Request, lc string, username string, claims map[
 	for k, v := range r.URL.Query() {
 		cloneURLValues[k] = v
 	}
+	cloneURLValues.Del(xhttp.AmzExpires)
 
 	for _, objLock := range []string{
 		xhttp.AmzObjectLockMode,
@@ -159,6 +169,10 @@ func getConditionValues(r *http.Request, lc string, username string, claims map[
 	}
 
 	for key, values := range cloneURLValues {
+		// Tags of the existing object are only ever set by the server.
+		if strings.HasPrefix(key, existingObjectTagPrefix) {
+			continue
+		}
 		if existingValues, found := args[key]; found {
 			args[key] = append(existingValues, values...)
 		} else {
@@ -182,6 +196,92 @@ func getConditionValues(r *http.Request, lc string, username string, claims map[
 	return args
 }
 
+// getConditionVersionID - returns the version ID of the object the request
+// refers to, that of the copy source for copy requests.
+func getConditionVersionID(r *http.Request) string {
+	vid := r.URL.Query().Get("versionId")
+	if vid == "" {
+		if u, err := url.Parse(r.Header.Get(xhttp.AmzCopySource)); err == nil {
+			vid = u.Query().Get("versionId")
+		}
+	}
+	return vid
+}
+
+// existingObjectTagPrefix - prefix of the condition values holding the
+// tags of the existing object, i.e. "ExistingObjectTag/<tag-key>".
+var existingObjectTagPrefix = condition.ExistingObjectTagKey("").Name()
+
+// existingObjectTagActions - object actions whose policies can be
+// conditioned on the tags of the existing object.
+var existingObjectTagActions = map[policy.Action]struct{}{
+	policy.GetObjectAction:           {},
+	policy.DeleteObjectAction:        {},
+	policy.PutObjectTaggingAction:    {},
+	policy.GetObjectTaggingAction:    {},
+	policy.DeleteObjectTaggingAction: {},
+}
+
+// conditionsReferExistingObjectTag - returns whether any of the conditions
+// is on a tag of the existing object.
+func conditionsReferExistingObjectTag(conditions condition.Functions) bool {
+	for key := range conditions.Keys() {
+		if key.IsExistingObjectTag() {
+			return true
+		}
+	}
+	return false
+}
+
+// RefersExistingObjectTag - returns whether the policy of the bucket has
+// s3:ExistingObjectTag conditions.
+func (sys *PolicySys) RefersExistingObjectTag(bucket string) bool {
+	p, err := sys.Get(bucket)
+	if err != nil {
+		return false
+	}
+	for _, statement := range p.Statements {
+		if conditionsReferExistingObjectTag(statement.Conditions) {
+			return true
+		}
+	}
+	return false
+}
+
+// getExistingObjectTags - returns the tags of the object the request refers
+// to, for evaluating s3:ExistingObjectTag conditions. It returns nil for
+// actions not supporting these conditions and objects that do not exist.
+func getExistingObjectTags(ctx context.Context, r *http.Request, action policy.Action, bucket, object string) map[string]string {
+	if _, ok := existingObjectTagActions[action]; !ok || object == "" {
+		return nil
+	}
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		return nil
+	}
+	objInfo, err := objAPI.GetObjectInfo(ctx, bucket, object, ObjectOptions{
+		VersionID: getConditionVersionID(r),
+	})
+	if err != nil || objInfo.UserTags == "" {
+		return nil
+	}
+	t, err := tags.ParseObjectTags(objInfo.UserTags)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return nil
+	}
+	return t.ToMap()
+}
+
+// setExistingObjectTags - sets the tags of the existing object as
+// condition values, returning the condition values.
+func setExistingObjectTags(args map[string][]string, objTags map[string]string) map[string][]string {
+	for k, v := range objTags {
+		args[condition.ExistingObjectTagKey(k).Name()] = []string{v}
+	}
+	return args
+}
+
 // PolicyToBucketAccessPolicy converts a MinIO policy into a minio-go policy data structure.
 func PolicyToBucketAccessPolicy(bucketPolicy *policy.Policy) (*miniogopolicy.BucketAccessPolicy, error) {
 	// Return empty BucketAccessPolicy for empty bucket policy.
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/minio/minio/cmd/logger"
@@ -30,6 +31,13 @@ import (
 // BucketQuotaSys - map of bucket and quota configuration.
 type BucketQuotaSys struct {
 	bucketStorageCache timedValue
+
+	// Objects added by this server to buckets with an object count
+	// quota since the data usage was last updated, so that counts
+	// are enforced between two updates by the scanner.
+	mu           sync.Mutex
+	objectDeltas map[string]uint64
+	deltasSince  time.Time
 }
 
 // Get - Get quota configuration.
@@ -47,7 +55,7 @@ func (sys *BucketQuotaSys) Get(bucketName string) (*madmin.BucketQuota, error) {
 
 // NewBucketQuotaSys returns initialized BucketQuotaSys
 func NewBucketQuotaSys() *BucketQuotaSys {
-	return &BucketQuotaSys{}
+	return &BucketQuotaSys{objectDeltas: make(map[string]uint64)}
 }
 
 // parseBucketQuota parses BucketQuota from json
@@ -62,12 +70,8 @@ func parseBucketQuota(bucket string, data []byte) (quotaCfg *madmin.BucketQuota,
 	return
 }
 
-func (sys *BucketQuotaSys) check(ctx context.Context, bucket string, size int64) error {
-	objAPI := newObjectLayerFn()
-	if objAPI == nil {
-		return errServerNotInitialized
-	}
-
+// dataUsage - returns the data usage of all buckets, cached briefly.
+func (sys *BucketQuotaSys) dataUsage(objAPI ObjectLayer) (madmin.DataUsageInfo, error) {
 	sys.bucketStorageCache.Once.Do(func() {
 		sys.bucketStorageCache.TTL = 1 * time.Second
 		sys.bucketStorageCache.Update = func() (interface{}, error) {
@@ -77,19 +81,30 @@ func (sys *BucketQuotaSys) check(ctx context.Context, bucket string, size int64)
 		}
 	})
 
+	v, err := sys.bucketStorageCache.Get()
+	if err != nil {
+		return madmin.DataUsageInfo{}, err
+	}
+	return v.(madmin.DataUsageInfo), nil
+}
+
+func (sys *BucketQuotaSys) check(ctx context.Context, bucket string, size int64) error {
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		return errServerNotInitialized
+	}
+
 	q, err := sys.Get(bucket)
 	if err != nil {
 		return err
 	}
 
 	if q != nil && q.Type == madmin.HardQuota && q.Quota > 0 {
-		v, err := sys.bucketStorageCache.Get()
+		dui, err := sys.dataUsage(objAPI)
 		if err != nil {
 			return err
 		}
 
-		dui := v.(madmin.DataUsageInfo)
-
 		bui, ok := dui.BucketsUsage[bucket]
 		if !ok {
 			// bucket not found, cannot enforce quota
@@ -113,6 +128,64 @@ func enforceBucketQuota(ctx context.Context, bucket string, size int64) error {
 	return globalBucketQuotaSys.check(ctx, bucket, size)
 }
 
+// checkObjects - checks that writing object does not exceed the object
+// count quota of bucket. Objects which do not exist yet are counted
+// from then on, even if they fail to be written, until the scanner
+// updates the data usage.
+func (sys *BucketQuotaSys) checkObjects(ctx context.Context, bucket, object string) error {
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		return errServerNotInitialized
+	}
+
+	q, err := sys.Get(bucket)
+	if err != nil {
+		return err
+	}
+	if q == nil || q.ObjectCount == 0 {
+		return nil
+	}
+
+	// Overwriting an object, or adding a version of
+	// it, does not change the number of objects.
+	if _, err = objAPI.GetObjectInfo(ctx, bucket, object, ObjectOptions{}); err == nil {
+		return nil
+	}
+
+	dui, err := sys.dataUsage(objAPI)
+	if err != nil {
+		return err
+	}
+	// Buckets not scanned yet only have the objects added since.
+	bui := dui.BucketsUsage[bucket]
+	if !sys.addObject(bucket, bui.ObjectsCount, q.ObjectCount, dui.LastUpdate) {
+		return BucketQuotaExceeded{Bucket: bucket}
+	}
+	return nil
+}
+
+// addObject - counts a new object in bucket, which had count objects
+// when the data usage was updated at lastUpdate, unless the bucket
+// already has limit objects.
+func (sys *BucketQuotaSys) addObject(bucket string, count, limit uint64, lastUpdate time.Time) bool {
+	sys.mu.Lock()
+	defer sys.mu.Unlock()
+	if lastUpdate.After(sys.deltasSince) {
+		// The scanner accounted for the objects added so far.
+		sys.objectDeltas = make(map[string]uint64)
+		sys.deltasSince = lastUpdate
+	}
+	if count+sys.objectDeltas[bucket] >= limit {
+		return false
+	}
+	sys.objectDeltas[bucket]++
+	return true
+}
+
+func enforceBucketObjectsQuota(ctx context.Context, bucket, object string) error {
+	return globalBucketQuotaSys.checkObjects(ctx, bucket, object)
+}
+
 // enforceFIFOQuota deletes objects in FIFO order until sufficient objects
 // have been deleted so as to bring bucket usage within quota.
 func enforceFIFOQuotaBucket(ctx context.Context, objectAPI ObjectLayer, bucket string, bui madmin.BucketUsageInfo) {
@@ -0,0 +1,47 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBucketQuotaSysAddObject(t *testing.T) {
+	sys := NewBucketQuotaSys()
+	scanned := time.Now()
+
+	// Two objects were scanned, one more fits.
+	if !sys.addObject("bucket", 2, 3, scanned) {
+		t.Fatal("Expected the third object to be allowed")
+	}
+	if sys.addObject("bucket", 2, 3, scanned) {
+		t.Fatal("Expected the fourth object to be rejected")
+	}
+	if !sys.addObject("other", 2, 3, scanned) {
+		t.Fatal("Expected objects of other buckets to be counted separately")
+	}
+
+	// Once scanned again, the objects added are part of the count.
+	if !sys.addObject("bucket", 2, 3, scanned.Add(time.Minute)) {
+		t.Fatal("Expected the added objects to be reset by a newer data usage")
+	}
+	if sys.addObject("bucket", 3, 3, scanned.Add(time.Minute)) {
+		t.Fatal("Expected the scanned objects to be enforced")
+	}
+}
@@ -34,6 +34,8 @@ import (
 	"github.com/minio/minio/cmd/logger"
 	"github.com/minio/minio/pkg/bucket/bandwidth"
 	"github.com/minio/minio/pkg/bucket/replication"
+	"github.com/minio/minio/pkg/color"
+	"github.com/minio/minio/pkg/console"
 	"github.com/minio/minio/pkg/event"
 	iampolicy "github.com/minio/minio/pkg/iam/policy"
 	"github.com/minio/minio/pkg/madmin"
@@ -176,6 +178,10 @@ func isStandardHeader(matchHeaderKey string) bool {
 
 // returns whether object version is a deletemarker and if object qualifies for replication
 func checkReplicateDelete(ctx context.Context, bucket string, dobj ObjectToDelete, oi ObjectInfo, gerr error) (replicate, sync bool) {
+	if debugLogEnabled(debugLogReplication) {
+		console.Debugf(color.Green("replication:")+" replicating delete of %s/%s (%s)\n", bucket, dobj.ObjectName, dobj.VersionID)
+	}
+
 	rcfg, err := getReplicationConfig(ctx, bucket)
 	if err != nil || rcfg == nil {
 		return false, sync
@@ -587,6 +593,9 @@ func replicateObject(ctx context.Context, ri ReplicateObjectInfo, objectAPI Obje
 		})
 		return
 	}
+	if debugLogEnabled(debugLogReplication) {
+		console.Debugf(color.Green("replication:")+" replicating %s/%s (%s) to %s\n", bucket, object, objInfo.VersionID, cfg.RoleArn)
+	}
 	tgt := globalBucketTargetSys.GetRemoteTargetClient(ctx, cfg.RoleArn)
 	if tgt == nil {
 		logger.LogIf(ctx, fmt.Errorf("failed to get target for bucket:%s arn:%s", bucket, cfg.RoleArn))
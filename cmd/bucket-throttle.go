@@ -0,0 +1,158 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const bucketThrottleConfigFile = "throttle.json"
+
+var globalBucketThrottleSys = newBucketThrottleSys()
+
+// parseBucketThrottle parses BucketThrottle from json
+func parseBucketThrottle(bucket string, data []byte) (throttleCfg *madmin.BucketThrottle, err error) {
+	throttleCfg = &madmin.BucketThrottle{}
+	if err = json.Unmarshal(data, throttleCfg); err != nil {
+		return throttleCfg, err
+	}
+	return throttleCfg, nil
+}
+
+// bucketRateLimiter is a token bucket refilled with rate tokens per
+// second, holding at most one second worth of tokens.
+type bucketRateLimiter struct {
+	rate   uint64
+	tokens float64
+	last   time.Time
+}
+
+func (l *bucketRateLimiter) allow(rate uint64, now time.Time) bool {
+	if rate == 0 {
+		return true
+	}
+	if l.rate != rate || l.last.IsZero() {
+		// New or changed limit, start with a full bucket.
+		l.rate = rate
+		l.tokens = float64(rate)
+		l.last = now
+	}
+	if now.After(l.last) {
+		l.tokens += now.Sub(l.last).Seconds() * float64(rate)
+		if l.tokens > float64(rate) {
+			l.tokens = float64(rate)
+		}
+		l.last = now
+	}
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+type bucketLimiter struct {
+	rps      bucketRateLimiter
+	inFlight uint64
+}
+
+// acquire accounts a request against the limits, it returns false
+// if the request exceeds them.
+func (l *bucketLimiter) acquire(rps, concurrency uint64, now time.Time) bool {
+	if concurrency > 0 && l.inFlight >= concurrency {
+		return false
+	}
+	if !l.rps.allow(rps, now) {
+		return false
+	}
+	l.inFlight++
+	return true
+}
+
+type bucketThrottle struct {
+	read, write bucketLimiter
+}
+
+// bucketThrottleSys enforces the request limits of the buckets on
+// this server.
+type bucketThrottleSys struct {
+	mu      sync.Mutex
+	buckets map[string]*bucketThrottle
+}
+
+func newBucketThrottleSys() *bucketThrottleSys {
+	return &bucketThrottleSys{
+		buckets: make(map[string]*bucketThrottle),
+	}
+}
+
+// acquire accounts a read or write request to bucket against limits.
+// It returns false if the request exceeds them, otherwise the returned
+// function must be called once the request is done.
+func (sys *bucketThrottleSys) acquire(bucket string, limits madmin.BucketThrottle, write bool, now time.Time) (release func(), ok bool) {
+	sys.mu.Lock()
+	defer sys.mu.Unlock()
+
+	t, ok := sys.buckets[bucket]
+	if !ok {
+		t = &bucketThrottle{}
+		sys.buckets[bucket] = t
+	}
+	l, rps, concurrency := &t.read, limits.ReadRPS, limits.ReadConcurrency
+	if write {
+		l, rps, concurrency = &t.write, limits.WriteRPS, limits.WriteConcurrency
+	}
+	if !l.acquire(rps, concurrency, now) {
+		return nil, false
+	}
+	return func() {
+		sys.mu.Lock()
+		l.inFlight--
+		sys.mu.Unlock()
+	}, true
+}
+
+// remove forgets the request accounting of bucket, once the bucket
+// is deleted or its limits are removed.
+func (sys *bucketThrottleSys) remove(bucket string) {
+	sys.mu.Lock()
+	delete(sys.buckets, bucket)
+	sys.mu.Unlock()
+}
+
+// throttleBucketRequest accounts r against the request limits of its
+// bucket. It returns false if the request must be rejected, otherwise
+// the returned function must be called once the request is done.
+func throttleBucketRequest(r *http.Request) (release func(), ok bool) {
+	bucket := mux.Vars(r)["bucket"]
+	if bucket == "" || globalIsGateway || globalBucketMetadataSys == nil {
+		return func() {}, true
+	}
+	limits, err := globalBucketMetadataSys.GetThrottleConfig(bucket)
+	if err != nil || limits.IsEmpty() {
+		return func() {}, true
+	}
+	write := r.Method != http.MethodGet && r.Method != http.MethodHead
+	return globalBucketThrottleSys.acquire(bucket, *limits, write, time.Now())
+}
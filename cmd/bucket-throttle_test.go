@@ -0,0 +1,91 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestBucketThrottleRate(t *testing.T) {
+	sys := newBucketThrottleSys()
+	limits := madmin.BucketThrottle{ReadRPS: 2}
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		release, ok := sys.acquire("bucket", limits, false, now)
+		if !ok {
+			t.Fatalf("request %d: expected to be allowed", i)
+		}
+		release()
+	}
+	if _, ok := sys.acquire("bucket", limits, false, now); ok {
+		t.Fatal("expected request above the rate to be rejected")
+	}
+	// Writes and other buckets are not limited.
+	if _, ok := sys.acquire("bucket", limits, true, now); !ok {
+		t.Fatal("expected write request to be allowed")
+	}
+	if _, ok := sys.acquire("other", limits, false, now); !ok {
+		t.Fatal("expected request to another bucket to be allowed")
+	}
+	// Half a second refills one token.
+	if _, ok := sys.acquire("bucket", limits, false, now.Add(500*time.Millisecond)); !ok {
+		t.Fatal("expected request to be allowed after refill")
+	}
+}
+
+func TestBucketThrottleConcurrency(t *testing.T) {
+	sys := newBucketThrottleSys()
+	limits := madmin.BucketThrottle{WriteConcurrency: 1}
+	now := time.Now()
+
+	release, ok := sys.acquire("bucket", limits, true, now)
+	if !ok {
+		t.Fatal("expected first request to be allowed")
+	}
+	if _, ok = sys.acquire("bucket", limits, true, now); ok {
+		t.Fatal("expected concurrent request to be rejected")
+	}
+	release()
+	if _, ok = sys.acquire("bucket", limits, true, now); !ok {
+		t.Fatal("expected request to be allowed once the first one is done")
+	}
+}
+
+func TestBucketThrottleRemove(t *testing.T) {
+	sys := newBucketThrottleSys()
+	limits := madmin.BucketThrottle{WriteConcurrency: 1}
+	now := time.Now()
+
+	release, ok := sys.acquire("bucket", limits, true, now)
+	if !ok {
+		t.Fatal("expected first request to be allowed")
+	}
+	sys.remove("bucket")
+	if len(sys.buckets) != 0 {
+		t.Fatalf("expected no buckets to be tracked, got %d", len(sys.buckets))
+	}
+	// Requests started before the removal do not count anymore.
+	if _, ok = sys.acquire("bucket", limits, true, now); !ok {
+		t.Fatal("expected request to be allowed after removal")
+	}
+	release()
+}
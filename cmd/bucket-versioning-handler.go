@@ -78,6 +78,26 @@ func (api objectAPIHandlers) PutBucketVersioningHandler(w http.ResponseWriter, r
 		}, r.URL, guessIsBrowserReq(r))
 		return
 	}
+	if len(v.ExcludedPrefixes) > 0 {
+		// Object lock and replication both rely on every
+		// object of the bucket being versioned.
+		if rcfg, _ := globalBucketObjectLockSys.Get(bucket); rcfg.LockEnabled {
+			writeErrorResponse(ctx, w, APIError{
+				Code:           "InvalidBucketState",
+				Description:    "An Object Lock configuration is present on this bucket, so prefixes cannot be excluded from versioning.",
+				HTTPStatusCode: http.StatusConflict,
+			}, r.URL, guessIsBrowserReq(r))
+			return
+		}
+		if _, err := getReplicationConfig(ctx, bucket); err == nil {
+			writeErrorResponse(ctx, w, APIError{
+				Code:           "InvalidBucketState",
+				Description:    "A replication configuration is present on this bucket, so prefixes cannot be excluded from versioning.",
+				HTTPStatusCode: http.StatusConflict,
+			}, r.URL, guessIsBrowserReq(r))
+			return
+		}
+	}
 
 	configData, err := xml.Marshal(v)
 	if err != nil {
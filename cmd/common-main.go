@@ -256,12 +256,34 @@ func handleCommonEnvVars() {
 		logger.Fatal(config.ErrInvalidBrowserValue(err), "Invalid MINIO_BROWSER value in environment variable")
 	}
 
+	if globalBrowserAddr = env.Get(config.EnvBrowserAddress, ""); globalBrowserAddr != "" {
+		if _, _, err = net.SplitHostPort(globalBrowserAddr); err != nil {
+			logger.Fatal(config.ErrInvalidBrowserAddress(err), "Invalid MINIO_BROWSER_ADDRESS value in environment variable")
+		}
+	}
+	globalBrowserTLSCerts, err = getBrowserTLSConfig()
+	logger.FatalIf(err, "Invalid browser TLS certificate file")
+
 	globalFSOSync, err = config.ParseBool(env.Get(config.EnvFSOSync, config.EnableOff))
 	if err != nil {
 		logger.Fatal(config.ErrInvalidFSOSyncValue(err), "Invalid MINIO_FS_OSYNC value in environment variable")
 	}
 
+	globalFSXattrMeta, err = config.ParseBool(env.Get(config.EnvFSXattr, config.EnableOff))
+	if err != nil {
+		logger.Fatal(config.ErrInvalidFSXattrValue(err), "Invalid MINIO_FS_XATTR_METADATA value in environment variable")
+	}
+
 	domains := env.Get(config.EnvDomain, "")
+	if len(domains) == 0 && globalTLSCerts != nil {
+		fromCerts, err := config.ParseBool(env.Get(config.EnvDomainFromCerts, config.EnableOff))
+		if err != nil {
+			logger.Fatal(config.ErrInvalidDomainValue(err), "Invalid MINIO_DOMAIN_FROM_CERTS value in environment variable")
+		}
+		if fromCerts {
+			domains = strings.Join(certDomainNames(globalTLSCerts.DNSNames()), config.ValueSeparator)
+		}
+	}
 	if len(domains) != 0 {
 		for _, domainName := range strings.Split(domains, config.ValueSeparator) {
 			if _, ok := dns2.IsDomainName(domainName); !ok {
@@ -331,6 +353,19 @@ func handleCommonEnvVars() {
 		globalActiveCred = cred
 	}
 
+	if env.IsSet(config.EnvBreakGlassUser) || env.IsSet(config.EnvBreakGlassPassword) {
+		cred, err := auth.CreateCredentials(env.Get(config.EnvBreakGlassUser, ""), env.Get(config.EnvBreakGlassPassword, ""))
+		if err != nil {
+			logger.Fatal(config.ErrInvalidCredentials(err),
+				"Unable to validate break-glass credentials inherited from the shell environment")
+		}
+		if cred.AccessKey == globalActiveCred.AccessKey {
+			logger.Fatal(config.ErrInvalidCredentials(errors.New("break-glass and root access keys must differ")),
+				"Unable to validate break-glass credentials inherited from the shell environment")
+		}
+		globalBreakGlassCred = cred
+	}
+
 	if env.IsSet(config.EnvKMSSecretKey) && env.IsSet(config.EnvKESEndpoint) {
 		logger.Fatal(errors.New("ambigious KMS configuration"), fmt.Sprintf("The environment contains %q as well as %q", config.EnvKMSSecretKey, config.EnvKESEndpoint))
 	}
@@ -363,6 +398,13 @@ func handleCommonEnvVars() {
 		}
 		GlobalKMS = KMS
 	}
+	if env.IsSet(config.EnvKMSOldSecretKey) {
+		KMS, err := kms.Parse(env.Get(config.EnvKMSOldSecretKey, ""))
+		if err != nil {
+			logger.Fatal(err, "Unable to parse the old KMS secret key inherited from the shell environment")
+		}
+		globalOldKMS = KMS
+	}
 	if env.IsSet(config.EnvKESEndpoint) {
 		kesEndpoints, err := crypto.ParseKESEndpoints(env.Get(config.EnvKESEndpoint, ""))
 		if err != nil {
@@ -391,6 +433,55 @@ func logStartupMessage(msg string) {
 	logger.StartupMessage(msg)
 }
 
+// getBrowserTLSConfig - returns the certificate of the web browser
+// listener, nil if the browser uses the certificates of the server.
+func getBrowserTLSConfig() (*certs.Manager, error) {
+	certFile := env.Get(config.EnvBrowserCertFile, "")
+	keyFile := env.Get(config.EnvBrowserKeyFile, "")
+	if certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" || globalBrowserAddr == "" {
+		return nil, config.ErrInvalidBrowserCert(nil)
+	}
+	if _, err := config.ParsePublicCertFile(certFile); err != nil {
+		return nil, err
+	}
+	return certs.NewManager(GlobalContext, certFile, keyFile, config.LoadX509KeyPair)
+}
+
+// certDomainNames returns the domains of the wildcard DNS SANs,
+// such as '*.s3.example.com', in names usable for virtual-host-style
+// requests. Since overlapping domains are not allowed, a domain
+// with a sub-domain among them is dropped in favor of the latter.
+func certDomainNames(names []string) []string {
+	domains := set.NewStringSet()
+	for _, name := range names {
+		if !strings.HasPrefix(name, "*.") {
+			continue
+		}
+		domain := strings.ToLower(strings.TrimPrefix(name, "*."))
+		if _, ok := dns2.IsDomainName(domain); !ok || !strings.Contains(domain, ".") {
+			continue
+		}
+		domains.Add(domain)
+	}
+	var domainNames []string
+	for _, domain := range domains.ToSlice() {
+		var overlaps bool
+		for other := range domains {
+			if strings.HasSuffix(other, "."+domain) {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			domainNames = append(domainNames, domain)
+		}
+	}
+	return domainNames
+}
+
 func getTLSConfig() (x509Certs []*x509.Certificate, manager *certs.Manager, secureConn bool, err error) {
 	if !(isFile(getPublicCertFile()) && isFile(getPrivateKeyFile())) {
 		return nil, nil, false, nil
@@ -0,0 +1,42 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCertDomainNames(t *testing.T) {
+	testCases := []struct {
+		names    []string
+		expected []string
+	}{
+		{nil, nil},
+		{[]string{"minio.example.com", "localhost"}, nil},
+		{[]string{"*.s3.example.com", "s3.example.com"}, []string{"s3.example.com"}},
+		{[]string{"*.S3.example.com", "*.s3.example.com", "*.s3.example.org"}, []string{"s3.example.com", "s3.example.org"}},
+		{[]string{"*.example.com", "*.s3.example.com"}, []string{"s3.example.com"}},
+		{[]string{"*.com", "*.localhost"}, nil},
+	}
+
+	for i, testCase := range testCases {
+		if domains := certDomainNames(testCase.names); !reflect.DeepEqual(domains, testCase.expected) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, domains)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/config"
+	"github.com/minio/minio/cmd/config/backup"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/hash"
+	"github.com/minio/minio/pkg/kms"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// Backups are saved under this prefix of the backup bucket.
+	configBackupPrefix = "minio-config-backup/"
+	configBackupSuffix = ".tar.enc"
+
+	// Time format of the backup names, which list in the
+	// order the backups were taken.
+	configBackupTimeFormat = "20060102T150405Z"
+
+	// How often a node checks whether a backup is due.
+	configBackupCheckInterval = time.Minute
+)
+
+var (
+	globalBackupConfig   backup.Config
+	globalBackupConfigMu sync.RWMutex
+)
+
+var errConfigBackupNoKMS = config.Errorf("configuration backups require a KMS to be configured")
+
+// The KMS context of the backups does not depend on where they are
+// saved, a backup can be restored after it was moved or renamed.
+var configBackupKMSContext = kms.Context{minioMetaBucket: path.Join(minioMetaBucket, minioConfigPrefix)}
+
+func getBackupConfig() backup.Config {
+	globalBackupConfigMu.RLock()
+	defer globalBackupConfigMu.RUnlock()
+	return globalBackupConfig
+}
+
+// initConfigBackup starts saving the IAM and server configuration
+// to the configured backup bucket.
+func initConfigBackup(ctx context.Context, objAPI ObjectLayer) {
+	go runConfigBackup(ctx, objAPI)
+}
+
+func runConfigBackup(ctx context.Context, objAPI ObjectLayer) {
+	timer := time.NewTimer(configBackupCheckInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			if cfg := getBackupConfig(); cfg.Enabled {
+				logger.LogIf(ctx, backupConfigIfDue(ctx, objAPI, cfg))
+			}
+			timer.Reset(configBackupCheckInterval)
+		}
+	}
+}
+
+// backupConfigIfDue takes a new backup if the latest one is older
+// than the backup interval, then removes the expired backups. Only
+// one node in the cluster does this at a time.
+func backupConfigIfDue(ctx context.Context, objAPI ObjectLayer, cfg backup.Config) error {
+	locker := objAPI.NewNSLock(minioMetaBucket, "config-backup.lock")
+	lkctx, err := locker.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		// Another node is taking care of it.
+		return nil
+	}
+	defer locker.Unlock()
+
+	if _, err = objAPI.GetBucketInfo(lkctx, cfg.Bucket); err != nil {
+		if !isErrBucketNotFound(err) {
+			return err
+		}
+		if err = objAPI.MakeBucketWithLocation(lkctx, cfg.Bucket, BucketOptions{Location: globalServerRegion}); err != nil {
+			return err
+		}
+		globalNotificationSys.LoadBucketMetadata(GlobalContext, cfg.Bucket)
+	}
+
+	backups, err := listConfigBackups(lkctx, objAPI, cfg.Bucket)
+	if err != nil {
+		return err
+	}
+	now := UTCNow()
+	if len(backups) == 0 || now.Sub(backups[len(backups)-1].Created) >= cfg.Interval {
+		info, err := saveConfigBackup(lkctx, objAPI, cfg.Bucket, now)
+		if err != nil {
+			return err
+		}
+		backups = append(backups, info)
+	}
+
+	// The latest backup is always kept.
+	for _, b := range backups[:len(backups)-1] {
+		if now.Sub(b.Created) < cfg.Retention {
+			break
+		}
+		if _, err = objAPI.DeleteObject(lkctx, cfg.Bucket, configBackupPrefix+b.Name, ObjectOptions{}); err != nil {
+			logger.LogIf(ctx, err)
+		}
+	}
+	return nil
+}
+
+// listConfigBackups returns the backups saved to bucket, oldest first.
+func listConfigBackups(ctx context.Context, objAPI ObjectLayer, bucket string) ([]madmin.ConfigBackupInfo, error) {
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(ctx, bucket, configBackupPrefix, objInfoCh, ObjectOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var backups []madmin.ConfigBackupInfo
+	for obj := range objInfoCh {
+		name := strings.TrimPrefix(obj.Name, configBackupPrefix)
+		if !strings.HasSuffix(name, configBackupSuffix) || strings.Contains(name, SlashSeparator) {
+			continue
+		}
+		backups = append(backups, madmin.ConfigBackupInfo{
+			Name:    name,
+			Created: obj.ModTime,
+			Size:    obj.Size,
+		})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Name < backups[j].Name
+	})
+	return backups, nil
+}
+
+// configBackupEntries returns the names of the configuration files
+// saved in backups, the configuration history is left out.
+func configBackupEntries(ctx context.Context, objAPI ObjectLayer) ([]string, error) {
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(ctx, minioMetaBucket, minioConfigPrefix+SlashSeparator, objInfoCh, ObjectOptions{}); err != nil {
+		return nil, err
+	}
+	var names []string
+	for obj := range objInfoCh {
+		if strings.HasPrefix(obj.Name, minioConfigHistoryPrefix+SlashSeparator) {
+			continue
+		}
+		names = append(names, obj.Name)
+	}
+	return names, nil
+}
+
+// saveConfigBackup saves the server configuration and the IAM data
+// stored in the meta bucket to bucket, as a tar archive encrypted
+// with the KMS. Files are saved as they are stored, IAM data is
+// already encrypted with the KMS.
+func saveConfigBackup(ctx context.Context, objAPI ObjectLayer, bucket string, now time.Time) (info madmin.ConfigBackupInfo, err error) {
+	if GlobalKMS == nil {
+		return info, errConfigBackupNoKMS
+	}
+
+	names, err := configBackupEntries(ctx, objAPI)
+	if err != nil {
+		return info, err
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, name := range names {
+		data, err := readConfig(ctx, objAPI, name)
+		if err != nil {
+			if err == errConfigNotFound {
+				continue
+			}
+			return info, err
+		}
+		if err = tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Size:     int64(len(data)),
+			Mode:     0600,
+			ModTime:  now,
+		}); err != nil {
+			return info, err
+		}
+		if _, err = tw.Write(data); err != nil {
+			return info, err
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return info, err
+	}
+
+	data, err := config.EncryptBytes(GlobalKMS, buf.Bytes(), configBackupKMSContext)
+	if err != nil {
+		return info, err
+	}
+
+	name := now.Format(configBackupTimeFormat) + configBackupSuffix
+	hashReader, err := hash.NewReader(bytes.NewReader(data), int64(len(data)), "", getSHA256Hash(data), int64(len(data)))
+	if err != nil {
+		return info, err
+	}
+	objInfo, err := objAPI.PutObject(ctx, bucket, configBackupPrefix+name, NewPutObjReader(hashReader), ObjectOptions{MaxParity: true})
+	if err != nil {
+		return info, err
+	}
+	return madmin.ConfigBackupInfo{
+		Name:    name,
+		Created: objInfo.ModTime,
+		Size:    objInfo.Size,
+	}, nil
+}
+
+// restoreConfigBackup writes back the files saved in the backup name
+// of bucket to the meta bucket. Files created after the backup was
+// taken are left as they are.
+func restoreConfigBackup(ctx context.Context, objAPI ObjectLayer, bucket, name string) (summary madmin.ConfigRestoreSummary, err error) {
+	if GlobalKMS == nil {
+		return summary, errConfigBackupNoKMS
+	}
+	if name == "" || strings.Contains(name, SlashSeparator) {
+		return summary, config.Errorf("invalid configuration backup name '%s'", name)
+	}
+
+	gr, err := objAPI.GetObjectNInfo(ctx, bucket, configBackupPrefix+name, nil, http.Header{}, readLock, ObjectOptions{})
+	if err != nil {
+		return summary, err
+	}
+	data, err := ioutil.ReadAll(gr)
+	gr.Close()
+	if err != nil {
+		return summary, err
+	}
+	if data, err = config.DecryptBytes(GlobalKMS, data, configBackupKMSContext); err != nil {
+		return summary, err
+	}
+
+	// Validate the whole archive before writing anything.
+	files := make(map[string][]byte)
+	var names []string
+	tr := tar.NewReader(bytes.NewReader(data))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return summary, config.Errorf("invalid configuration backup: %v", err)
+		}
+		if path.Clean(hdr.Name) != hdr.Name || !strings.HasPrefix(hdr.Name, minioConfigPrefix+SlashSeparator) {
+			return summary, config.Errorf("invalid configuration backup: unexpected file %s", hdr.Name)
+		}
+		if files[hdr.Name], err = ioutil.ReadAll(tr); err != nil {
+			return summary, err
+		}
+		names = append(names, hdr.Name)
+	}
+
+	for _, file := range names {
+		if err = saveConfig(ctx, objAPI, file, files[file]); err != nil {
+			return summary, err
+		}
+		summary.Files++
+	}
+	summary.Backup = name
+	return summary, nil
+}
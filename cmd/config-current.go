@@ -25,13 +25,18 @@ import (
 
 	"github.com/minio/minio/cmd/config"
 	"github.com/minio/minio/cmd/config/api"
+	"github.com/minio/minio/cmd/config/backup"
 	"github.com/minio/minio/cmd/config/cache"
 	"github.com/minio/minio/cmd/config/compress"
+	"github.com/minio/minio/cmd/config/diagnostics"
 	"github.com/minio/minio/cmd/config/dns"
+	"github.com/minio/minio/cmd/config/drivealert"
 	"github.com/minio/minio/cmd/config/etcd"
+	"github.com/minio/minio/cmd/config/eventlog"
 	"github.com/minio/minio/cmd/config/heal"
 	xldap "github.com/minio/minio/cmd/config/identity/ldap"
 	"github.com/minio/minio/cmd/config/identity/openid"
+	"github.com/minio/minio/cmd/config/multipart"
 	"github.com/minio/minio/cmd/config/notify"
 	"github.com/minio/minio/cmd/config/policy/opa"
 	"github.com/minio/minio/cmd/config/scanner"
@@ -60,6 +65,11 @@ func initHelp() {
 		config.AuditWebhookSubSys:   logger.DefaultAuditKVS,
 		config.HealSubSys:           heal.DefaultKVS,
 		config.ScannerSubSys:        scanner.DefaultKVS,
+		config.BackupSubSys:         backup.DefaultKVS,
+		config.DiagnosticsSubSys:    diagnostics.DefaultKVS,
+		config.DriveAlertSubSys:     drivealert.DefaultKVS,
+		config.EventLogSubSys:       eventlog.DefaultKVS,
+		config.MultipartSubSys:      multipart.DefaultKVS,
 	}
 	for k, v := range notify.DefaultNotificationKVS {
 		kvs[k] = v
@@ -119,6 +129,26 @@ func initHelp() {
 			Key:         config.ScannerSubSys,
 			Description: "manage namespace scanning for usage calculation, lifecycle, healing and more",
 		},
+		config.HelpKV{
+			Key:         config.BackupSubSys,
+			Description: "periodically back up the IAM and server configuration to a bucket",
+		},
+		config.HelpKV{
+			Key:         config.DiagnosticsSubSys,
+			Description: "periodically collect and keep health reports of the cluster",
+		},
+		config.HelpKV{
+			Key:         config.DriveAlertSubSys,
+			Description: "send alerts about drives likely to fail to notification targets",
+		},
+		config.HelpKV{
+			Key:         config.EventLogSubSys,
+			Description: "keep the bucket events emitted for replay",
+		},
+		config.HelpKV{
+			Key:         config.MultipartSubSys,
+			Description: "abort incomplete multipart uploads of all buckets",
+		},
 		config.HelpKV{
 			Key:             config.LoggerWebhookSubSys,
 			Description:     "send server logs to webhook endpoints",
@@ -200,6 +230,11 @@ func initHelp() {
 		config.CompressionSubSys:    compress.Help,
 		config.HealSubSys:           heal.Help,
 		config.ScannerSubSys:        scanner.Help,
+		config.BackupSubSys:         backup.Help,
+		config.DiagnosticsSubSys:    diagnostics.Help,
+		config.DriveAlertSubSys:     drivealert.Help,
+		config.EventLogSubSys:       eventlog.Help,
+		config.MultipartSubSys:      multipart.Help,
 		config.IdentityOpenIDSubSys: openid.Help,
 		config.IdentityLDAPSubSys:   xldap.Help,
 		config.PolicyOPASubSys:      opa.Help,
@@ -279,6 +314,30 @@ func validateConfig(s config.Config, setDriveCounts []int) error {
 		return err
 	}
 
+	backupCfg, err := backup.LookupConfig(s[config.BackupSubSys][config.Default])
+	if err != nil {
+		return err
+	}
+	if backupCfg.Enabled && GlobalKMS == nil {
+		return errConfigBackupNoKMS
+	}
+
+	if _, err = diagnostics.LookupConfig(s[config.DiagnosticsSubSys][config.Default]); err != nil {
+		return err
+	}
+
+	if _, err = drivealert.LookupConfig(s[config.DriveAlertSubSys][config.Default]); err != nil {
+		return err
+	}
+
+	if _, err = eventlog.LookupConfig(s[config.EventLogSubSys][config.Default]); err != nil {
+		return err
+	}
+
+	if _, err = multipart.LookupConfig(s[config.MultipartSubSys][config.Default]); err != nil {
+		return err
+	}
+
 	{
 		etcdCfg, err := etcd.LookupConfig(s[config.EtcdSubSys][config.Default], globalRootCAs)
 		if err != nil {
@@ -380,6 +439,9 @@ func lookupConfigs(s config.Config, setDriveCounts []int) {
 					dns.DomainNames(globalDomainNames),
 					dns.DomainIPs(globalDomainIPs),
 					dns.DomainPort(globalMinioPort),
+					dns.DomainTTL(etcdCfg.CoreDNSTTL),
+					dns.BucketTTL(etcdCfg.CoreDNSBucketTTL),
+					dns.HealthCheck(etcdCfg.CoreDNSHealth),
 					dns.CoreDNSPath(etcdCfg.CoreDNSPath),
 				)
 				if err != nil {
@@ -572,6 +634,40 @@ func applyDynamicConfig(ctx context.Context, objAPI ObjectLayer, s config.Config
 		return fmt.Errorf("Unable to apply scanner config: %w", err)
 	}
 
+	// Backup
+	backupCfg, err := backup.LookupConfig(s[config.BackupSubSys][config.Default])
+	if err != nil {
+		return fmt.Errorf("Unable to apply backup config: %w", err)
+	}
+	if backupCfg.Enabled && GlobalKMS == nil {
+		logger.LogIf(ctx, fmt.Errorf("Unable to apply backup config: %w", errConfigBackupNoKMS))
+		backupCfg.Enabled = false
+	}
+
+	// Diagnostics
+	diagnosticsCfg, err := diagnostics.LookupConfig(s[config.DiagnosticsSubSys][config.Default])
+	if err != nil {
+		return fmt.Errorf("Unable to apply diagnostics config: %w", err)
+	}
+
+	// Drive alerts
+	driveAlertCfg, err := drivealert.LookupConfig(s[config.DriveAlertSubSys][config.Default])
+	if err != nil {
+		return fmt.Errorf("Unable to apply drive alert config: %w", err)
+	}
+
+	// Event log
+	eventLogCfg, err := eventlog.LookupConfig(s[config.EventLogSubSys][config.Default])
+	if err != nil {
+		return fmt.Errorf("Unable to apply event log config: %w", err)
+	}
+
+	// Multipart uploads
+	multipartCfg, err := multipart.LookupConfig(s[config.MultipartSubSys][config.Default])
+	if err != nil {
+		return fmt.Errorf("Unable to apply multipart config: %w", err)
+	}
+
 	// Apply configurations.
 	// We should not fail after this.
 	globalAPIConfig.init(apiConfig, objAPI.SetDriveCounts())
@@ -584,9 +680,30 @@ func applyDynamicConfig(ctx context.Context, objAPI ObjectLayer, s config.Config
 	globalHealConfig = healCfg
 	globalHealConfigMu.Unlock()
 
+	globalBackupConfigMu.Lock()
+	globalBackupConfig = backupCfg
+	globalBackupConfigMu.Unlock()
+
+	globalDiagnosticsConfigMu.Lock()
+	globalDiagnosticsConfig = diagnosticsCfg
+	globalDiagnosticsConfigMu.Unlock()
+
+	globalDriveAlertConfigMu.Lock()
+	globalDriveAlertConfig = driveAlertCfg
+	globalDriveAlertConfigMu.Unlock()
+
+	globalEventLogConfigMu.Lock()
+	globalEventLogConfig = eventLogCfg
+	globalEventLogConfigMu.Unlock()
+
+	globalMultipartConfigMu.Lock()
+	globalMultipartConfig = multipartCfg
+	globalMultipartConfigMu.Unlock()
+
 	// update dynamic scanner values.
 	scannerCycle.Update(scannerCfg.Cycle)
 	logger.LogIf(ctx, scannerSleeper.Update(scannerCfg.Delay, scannerCfg.MaxWait))
+	globalAccessTimeTracker.Update(scannerCfg.AccessTime, scannerCfg.AccessTimeInterval)
 
 	// Update all dynamic config values in memory.
 	globalServerConfigMu.Lock()
@@ -19,6 +19,8 @@ package api
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +39,8 @@ const (
 	apiListQuorum              = "list_quorum"
 	apiExtendListCacheLife     = "extend_list_cache_life"
 	apiReplicationWorkers      = "replication_workers"
+	apiPresignedExpiryMax      = "presigned_expiry_max"
+	apiTrustedProxies          = "trusted_proxies"
 
 	EnvAPIRequestsMax             = "MINIO_API_REQUESTS_MAX"
 	EnvAPIRequestsDeadline        = "MINIO_API_REQUESTS_DEADLINE"
@@ -47,8 +51,14 @@ const (
 	EnvAPIExtendListCacheLife     = "MINIO_API_EXTEND_LIST_CACHE_LIFE"
 	EnvAPISecureCiphers           = "MINIO_API_SECURE_CIPHERS"
 	EnvAPIReplicationWorkers      = "MINIO_API_REPLICATION_WORKERS"
+	EnvAPIPresignedExpiryMax      = "MINIO_API_PRESIGNED_EXPIRY_MAX"
+	EnvAPITrustedProxies          = "MINIO_API_TRUSTED_PROXIES"
 )
 
+// MaxPresignedExpiry - the longest validity of presigned requests
+// accepted by S3, the default for presigned_expiry_max when unset.
+const MaxPresignedExpiry = 7 * 24 * time.Hour
+
 // Deprecated key and ENVs
 const (
 	apiReadyDeadline    = "ready_deadline"
@@ -90,6 +100,14 @@ var (
 			Key:   apiReplicationWorkers,
 			Value: "500",
 		},
+		config.KV{
+			Key:   apiPresignedExpiryMax,
+			Value: "",
+		},
+		config.KV{
+			Key:   apiTrustedProxies,
+			Value: "",
+		},
 	}
 )
 
@@ -103,6 +121,8 @@ type Config struct {
 	ListQuorum              string        `json:"list_strict_quorum"`
 	ExtendListLife          time.Duration `json:"extend_list_cache_life"`
 	ReplicationWorkers      int           `json:"replication_workers"`
+	PresignedExpiryMax      time.Duration `json:"presigned_expiry_max"`
+	TrustedProxies          []*net.IPNet  `json:"trusted_proxies"`
 }
 
 // UnmarshalJSON - Validate SS and RRS parity when unmarshalling JSON.
@@ -189,6 +209,29 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 		return cfg, config.ErrInvalidReplicationWorkersValue(nil).Msg("Minimum number of replication workers should be 1")
 	}
 
+	var presignedExpiryMax time.Duration
+	if v := env.Get(EnvAPIPresignedExpiryMax, kvs.Get(apiPresignedExpiryMax)); v != "" {
+		presignedExpiryMax, err = time.ParseDuration(v)
+		if err != nil {
+			return cfg, err
+		}
+
+		if presignedExpiryMax <= 0 || presignedExpiryMax > MaxPresignedExpiry {
+			return cfg, errors.New("invalid value for presigned expiry max, must be between 1s and 168h")
+		}
+	}
+
+	var trustedProxies []*net.IPNet
+	if v := env.Get(EnvAPITrustedProxies, kvs.Get(apiTrustedProxies)); v != "" {
+		for _, cidr := range strings.Split(v, ",") {
+			_, proxies, err := net.ParseCIDR(strings.TrimSpace(cidr))
+			if err != nil {
+				return cfg, fmt.Errorf("invalid value for trusted proxies: %w", err)
+			}
+			trustedProxies = append(trustedProxies, proxies)
+		}
+	}
+
 	return Config{
 		RequestsMax:             requestsMax,
 		RequestsDeadline:        requestsDeadline,
@@ -198,5 +241,7 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 		ListQuorum:              listQuorum,
 		ExtendListLife:          listLife,
 		ReplicationWorkers:      replicationWorkers,
+		PresignedExpiryMax:      presignedExpiryMax,
+		TrustedProxies:          trustedProxies,
 	}, nil
 }
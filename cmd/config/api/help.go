@@ -51,5 +51,17 @@ var (
 			Optional:    true,
 			Type:        "number",
 		},
+		config.HelpKV{
+			Key:         apiPresignedExpiryMax,
+			Description: `set the maximum validity of presigned URLs, longer ones are rejected, defaults to 7 days for V4 and no limit for V2 e.g. "24h"`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         apiTrustedProxies,
+			Description: `set comma separated list of proxy networks whose forwarding headers give the client IP e.g. "10.0.0.0/8,192.168.1.0/24"`,
+			Optional:    true,
+			Type:        "csv",
+		},
 	}
 )
@@ -0,0 +1,133 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/minio/cmd/config"
+	"github.com/minio/minio/pkg/env"
+)
+
+// Backup environment variables
+const (
+	Bucket    = "bucket"
+	Interval  = "interval"
+	Retention = "retention"
+
+	EnvBackupEnable    = "MINIO_BACKUP_ENABLE"
+	EnvBackupBucket    = "MINIO_BACKUP_BUCKET"
+	EnvBackupInterval  = "MINIO_BACKUP_INTERVAL"
+	EnvBackupRetention = "MINIO_BACKUP_RETENTION"
+)
+
+// Config represents the IAM and server configuration backup settings.
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// Bucket the backups are saved to.
+	Bucket string `json:"bucket"`
+	// Interval is the time.Duration between two backups.
+	Interval time.Duration `json:"interval"`
+	// Retention is how long backups are kept, the
+	// latest backup is never removed.
+	Retention time.Duration `json:"retention"`
+}
+
+var (
+	// DefaultKVS - default KV config for backup settings
+	DefaultKVS = config.KVS{
+		config.KV{
+			Key:   config.Enable,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   Bucket,
+			Value: "",
+		},
+		config.KV{
+			Key:   Interval,
+			Value: "24h",
+		},
+		config.KV{
+			Key:   Retention,
+			Value: "720h",
+		},
+	}
+
+	// Help provides help for config values
+	Help = config.HelpKVS{
+		config.HelpKV{
+			Key:         Bucket,
+			Description: `bucket the backups are saved to, it is created if it does not exist`,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         Interval,
+			Description: `time duration between two backups, defaults to '24h'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         Retention,
+			Description: `time duration backups are kept for, defaults to '720h'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+	}
+)
+
+// LookupConfig - lookup backup config and override with valid environment settings if any.
+func LookupConfig(kvs config.KVS) (cfg Config, err error) {
+	if err = config.CheckValidKeys(config.BackupSubSys, kvs, DefaultKVS); err != nil {
+		return cfg, err
+	}
+
+	cfg.Enabled, err = config.ParseBool(env.Get(EnvBackupEnable, kvs.Get(config.Enable)))
+	if err != nil {
+		// Parsing failures happen due to empty KVS, ignore it.
+		if kvs.Empty() {
+			return cfg, nil
+		}
+		return cfg, err
+	}
+	if !cfg.Enabled {
+		return cfg, nil
+	}
+
+	cfg.Bucket = env.Get(EnvBackupBucket, kvs.Get(Bucket))
+	if err = s3utils.CheckValidBucketNameStrict(cfg.Bucket); err != nil {
+		return cfg, config.Errorf("invalid backup bucket '%s': %s", cfg.Bucket, err)
+	}
+
+	cfg.Interval, err = time.ParseDuration(env.Get(EnvBackupInterval, kvs.Get(Interval)))
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.Interval < time.Minute {
+		return cfg, config.Errorf("backup interval cannot be shorter than 1m")
+	}
+
+	cfg.Retention, err = time.ParseDuration(env.Get(EnvBackupRetention, kvs.Get(Retention)))
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.Retention < cfg.Interval {
+		return cfg, config.Errorf("backup retention cannot be shorter than the backup interval")
+	}
+	return cfg, nil
+}
@@ -79,6 +79,11 @@ const (
 	HealSubSys           = "heal"
 	ScannerSubSys        = "scanner"
 	CrawlerSubSys        = "crawler"
+	BackupSubSys         = "backup"
+	DiagnosticsSubSys    = "diagnostics"
+	DriveAlertSubSys     = "drive_alert"
+	EventLogSubSys       = "event_log"
+	MultipartSubSys      = "multipart"
 
 	// Add new constants here if you add new fields to config.
 )
@@ -117,6 +122,11 @@ var SubSystems = set.CreateStringSet(
 	IdentityOpenIDSubSys,
 	ScannerSubSys,
 	HealSubSys,
+	BackupSubSys,
+	DiagnosticsSubSys,
+	DriveAlertSubSys,
+	EventLogSubSys,
+	MultipartSubSys,
 	NotifyAMQPSubSys,
 	NotifyESSubSys,
 	NotifyKafkaSubSys,
@@ -135,6 +145,11 @@ var SubSystemsDynamic = set.CreateStringSet(
 	CompressionSubSys,
 	ScannerSubSys,
 	HealSubSys,
+	BackupSubSys,
+	DiagnosticsSubSys,
+	DriveAlertSubSys,
+	EventLogSubSys,
+	MultipartSubSys,
 )
 
 // SubSystemsSingleTargets - subsystems which only support single target.
@@ -153,6 +168,11 @@ var SubSystemsSingleTargets = set.CreateStringSet([]string{
 	IdentityOpenIDSubSys,
 	HealSubSys,
 	ScannerSubSys,
+	BackupSubSys,
+	DiagnosticsSubSys,
+	DriveAlertSubSys,
+	EventLogSubSys,
+	MultipartSubSys,
 }...)
 
 // Constant separators
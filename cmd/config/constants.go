@@ -28,14 +28,25 @@ const (
 	EnvRootUser     = "MINIO_ROOT_USER"
 	EnvRootPassword = "MINIO_ROOT_PASSWORD"
 
+	// Break-glass root credential, sealed until enabled.
+	EnvBreakGlassUser     = "MINIO_BREAKGLASS_USER"
+	EnvBreakGlassPassword = "MINIO_BREAKGLASS_PASSWORD"
+
 	EnvBrowser    = "MINIO_BROWSER"
 	EnvDomain     = "MINIO_DOMAIN"
 	EnvRegionName = "MINIO_REGION_NAME"
 	EnvPublicIPs  = "MINIO_PUBLIC_IPS"
 	EnvFSOSync    = "MINIO_FS_OSYNC"
+	EnvFSXattr    = "MINIO_FS_XATTR_METADATA"
 	EnvArgs       = "MINIO_ARGS"
 	EnvDNSWebhook = "MINIO_DNS_WEBHOOK_ENDPOINT"
 
+	EnvBrowserAddress  = "MINIO_BROWSER_ADDRESS"
+	EnvBrowserCertFile = "MINIO_BROWSER_CERT_FILE"
+	EnvBrowserKeyFile  = "MINIO_BROWSER_KEY_FILE"
+
+	EnvDomainFromCerts = "MINIO_DOMAIN_FROM_CERTS"
+
 	EnvUpdate = "MINIO_UPDATE"
 
 	EnvKMSMasterKey  = "MINIO_KMS_MASTER_KEY" // legacy
@@ -46,6 +57,10 @@ const (
 	EnvKESClientCert = "MINIO_KMS_KES_CERT_FILE"
 	EnvKESServerCA   = "MINIO_KMS_KES_CAPATH"
 
+	// Single-key KMS used before switching KMS, to unseal the
+	// object keys which are not yet re-encrypted.
+	EnvKMSOldSecretKey = "MINIO_KMS_OLD_SECRET_KEY"
+
 	EnvEndpoints = "MINIO_ENDPOINTS" // legacy
 	EnvWorm      = "MINIO_WORM"      // legacy
 	EnvRegion    = "MINIO_REGION"    // legacy
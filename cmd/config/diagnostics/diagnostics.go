@@ -0,0 +1,134 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diagnostics
+
+import (
+	"time"
+
+	"github.com/minio/minio/cmd/config"
+	"github.com/minio/minio/pkg/env"
+)
+
+// Diagnostics environment variables
+const (
+	Interval  = "interval"
+	Retention = "retention"
+	Perf      = "perf"
+
+	EnvDiagnosticsEnable    = "MINIO_DIAGNOSTICS_ENABLE"
+	EnvDiagnosticsInterval  = "MINIO_DIAGNOSTICS_INTERVAL"
+	EnvDiagnosticsRetention = "MINIO_DIAGNOSTICS_RETENTION"
+	EnvDiagnosticsPerf      = "MINIO_DIAGNOSTICS_PERF"
+)
+
+// Config represents the scheduled health report settings.
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// Interval is the time.Duration between two health reports.
+	Interval time.Duration `json:"interval"`
+	// Retention is how long health reports are kept, the
+	// latest report is never removed.
+	Retention time.Duration `json:"retention"`
+	// Perf includes the drive and network performance
+	// tests in the health reports.
+	Perf bool `json:"perf"`
+}
+
+var (
+	// DefaultKVS - default KV config for diagnostics settings
+	DefaultKVS = config.KVS{
+		config.KV{
+			Key:   config.Enable,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   Interval,
+			Value: "168h",
+		},
+		config.KV{
+			Key:   Retention,
+			Value: "2160h",
+		},
+		config.KV{
+			Key:   Perf,
+			Value: config.EnableOff,
+		},
+	}
+
+	// Help provides help for config values
+	Help = config.HelpKVS{
+		config.HelpKV{
+			Key:         Interval,
+			Description: `time duration between two health reports, defaults to '168h'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         Retention,
+			Description: `time duration health reports are kept for, defaults to '2160h'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         Perf,
+			Description: `set to 'on' to run the drive and network performance tests, defaults to 'off'`,
+			Optional:    true,
+			Type:        "on|off",
+		},
+	}
+)
+
+// LookupConfig - lookup diagnostics config and override with valid environment settings if any.
+func LookupConfig(kvs config.KVS) (cfg Config, err error) {
+	if err = config.CheckValidKeys(config.DiagnosticsSubSys, kvs, DefaultKVS); err != nil {
+		return cfg, err
+	}
+
+	cfg.Enabled, err = config.ParseBool(env.Get(EnvDiagnosticsEnable, kvs.Get(config.Enable)))
+	if err != nil {
+		// Parsing failures happen due to empty KVS, ignore it.
+		if kvs.Empty() {
+			return cfg, nil
+		}
+		return cfg, err
+	}
+	if !cfg.Enabled {
+		return cfg, nil
+	}
+
+	cfg.Interval, err = time.ParseDuration(env.Get(EnvDiagnosticsInterval, kvs.Get(Interval)))
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.Interval < time.Hour {
+		return cfg, config.Errorf("diagnostics interval cannot be shorter than 1h")
+	}
+
+	cfg.Retention, err = time.ParseDuration(env.Get(EnvDiagnosticsRetention, kvs.Get(Retention)))
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.Retention < cfg.Interval {
+		return cfg, config.Errorf("diagnostics retention cannot be shorter than the diagnostics interval")
+	}
+
+	cfg.Perf, err = config.ParseBool(env.Get(EnvDiagnosticsPerf, kvs.Get(Perf)))
+	if err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
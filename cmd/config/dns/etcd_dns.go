@@ -24,6 +24,7 @@ import (
 	"net"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/coredns/coredns/plugin/etcd/msg"
@@ -166,7 +167,12 @@ func (c *CoreDNS) Put(bucket string) error {
 
 	t := time.Now().UTC()
 	for ip := range c.domainIPs {
-		bucketMsg, err := newCoreDNSMsg(ip, c.domainPort, defaultTTL, t)
+		if !c.IsHostOnline(ip) {
+			// Skip hosts failing health checks, records
+			// are added back once the host is online.
+			continue
+		}
+		bucketMsg, err := newCoreDNSMsg(ip, c.domainPort, c.bucketTTL(bucket), t)
 		if err != nil {
 			return err
 		}
@@ -221,13 +227,74 @@ func (c *CoreDNS) String() string {
 	return "etcdDNS"
 }
 
+// Hosts - returns the list of domain IPs managed by this CoreDNS client.
+func (c *CoreDNS) Hosts() []string {
+	return c.domainIPs.ToSlice()
+}
+
+// IsHostOnline - returns false if the host was marked offline
+// with SetHostOnline().
+func (c *CoreDNS) IsHostOnline(host string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return !c.offlineIPs.Contains(host)
+}
+
+// SetHostOnline - marks a domain IP online or offline, hosts marked
+// offline are skipped in Put() until marked online again. Returns
+// true if the state of the host changed.
+func (c *CoreDNS) SetHostOnline(host string, online bool) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if online == !c.offlineIPs.Contains(host) {
+		return false
+	}
+	if online {
+		c.offlineIPs.Remove(host)
+	} else {
+		c.offlineIPs.Add(host)
+	}
+	return true
+}
+
+// bucketTTL - returns the TTL of the DNS records of bucket.
+func (c *CoreDNS) bucketTTL(bucket string) uint32 {
+	if ttl, ok := c.bucketTTLs[bucket]; ok {
+		return ttl
+	}
+	return c.ttl
+}
+
+// MinTTL - returns the lowest TTL of the bucket DNS records.
+func (c *CoreDNS) MinTTL() time.Duration {
+	ttl := c.ttl
+	for _, bucketTTL := range c.bucketTTLs {
+		if bucketTTL < ttl {
+			ttl = bucketTTL
+		}
+	}
+	return time.Duration(ttl) * time.Second
+}
+
+// HealthCheck - returns true if the records of hosts failing
+// health checks are to be removed.
+func (c *CoreDNS) HealthCheck() bool {
+	return c.healthCheck
+}
+
 // CoreDNS - represents dns config for coredns server.
 type CoreDNS struct {
 	domainNames []string
 	domainIPs   set.StringSet
 	domainPort  string
 	prefixPath  string
+	ttl         uint32
+	bucketTTLs  map[string]uint32
+	healthCheck bool
 	etcdClient  *clientv3.Client
+
+	mu         sync.RWMutex
+	offlineIPs set.StringSet
 }
 
 // EtcdOption - functional options pattern style
@@ -266,6 +333,33 @@ func CoreDNSPath(prefix string) EtcdOption {
 	}
 }
 
+// DomainTTL - TTL of the bucket DNS records, if not set
+// defaults to 30 seconds.
+func DomainTTL(ttl time.Duration) EtcdOption {
+	return func(args *CoreDNS) {
+		args.ttl = uint32(ttl / time.Second)
+	}
+}
+
+// BucketTTL - TTL of the DNS records of specific buckets, the
+// other buckets use the TTL set by DomainTTL.
+func BucketTTL(ttls map[string]time.Duration) EtcdOption {
+	return func(args *CoreDNS) {
+		args.bucketTTLs = make(map[string]uint32, len(ttls))
+		for bucket, ttl := range ttls {
+			args.bucketTTLs[bucket] = uint32(ttl / time.Second)
+		}
+	}
+}
+
+// HealthCheck - remove the records of hosts failing health
+// checks until they are healthy again.
+func HealthCheck(enabled bool) EtcdOption {
+	return func(args *CoreDNS) {
+		args.healthCheck = enabled
+	}
+}
+
 // NewCoreDNS - initialize a new coreDNS set/unset values.
 func NewCoreDNS(cfg clientv3.Config, setters ...EtcdOption) (Store, error) {
 	etcdClient, err := clientv3.New(cfg)
@@ -275,15 +369,22 @@ func NewCoreDNS(cfg clientv3.Config, setters ...EtcdOption) (Store, error) {
 
 	args := &CoreDNS{
 		etcdClient: etcdClient,
+		ttl:        defaultTTL,
+		offlineIPs: set.NewStringSet(),
 	}
 
 	for _, setter := range setters {
 		setter(args)
 	}
 
-	if len(args.domainNames) == 0 || args.domainIPs.IsEmpty() {
+	if len(args.domainNames) == 0 || args.domainIPs.IsEmpty() || args.ttl == 0 {
 		return nil, errors.New("invalid argument")
 	}
+	for _, ttl := range args.bucketTTLs {
+		if ttl == 0 {
+			return nil, errors.New("invalid argument")
+		}
+	}
 
 	// strip ports off of domainIPs
 	domainIPsWithoutPorts := args.domainIPs.ApplyFunc(func(ip string) string {
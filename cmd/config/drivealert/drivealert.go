@@ -0,0 +1,199 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drivealert
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio/cmd/config"
+	"github.com/minio/minio/pkg/env"
+	"github.com/minio/minio/pkg/event"
+)
+
+// Drive alert environment variables
+const (
+	Targets         = "targets"
+	Interval        = "interval"
+	Cooldown        = "cooldown"
+	ErrorThreshold  = "error_threshold"
+	BitrotThreshold = "bitrot_threshold"
+	SMART           = "smart"
+
+	EnvDriveAlertEnable          = "MINIO_DRIVE_ALERT_ENABLE"
+	EnvDriveAlertTargets         = "MINIO_DRIVE_ALERT_TARGETS"
+	EnvDriveAlertInterval        = "MINIO_DRIVE_ALERT_INTERVAL"
+	EnvDriveAlertCooldown        = "MINIO_DRIVE_ALERT_COOLDOWN"
+	EnvDriveAlertErrorThreshold  = "MINIO_DRIVE_ALERT_ERROR_THRESHOLD"
+	EnvDriveAlertBitrotThreshold = "MINIO_DRIVE_ALERT_BITROT_THRESHOLD"
+	EnvDriveAlertSMART           = "MINIO_DRIVE_ALERT_SMART"
+)
+
+// Config represents the drive failure alert settings.
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// Targets are the notification targets alerts are sent to,
+	// alerts are always logged.
+	Targets []event.TargetID `json:"targets"`
+	// Interval is the time.Duration between two checks of the drives.
+	Interval time.Duration `json:"interval"`
+	// Cooldown is the minimum time.Duration between two alerts
+	// about the same drive.
+	Cooldown time.Duration `json:"cooldown"`
+	// ErrorThreshold is the number of I/O errors of a drive
+	// between two checks raising an alert, 0 disables it.
+	ErrorThreshold uint64 `json:"errorThreshold"`
+	// BitrotThreshold is the number of bitrot errors of a drive
+	// between two checks raising an alert, 0 disables it.
+	BitrotThreshold uint64 `json:"bitrotThreshold"`
+	// SMART enables the alerts on the S.M.A.R.T. warnings of
+	// the drives.
+	SMART bool `json:"smart"`
+}
+
+var (
+	// DefaultKVS - default KV config for drive alert settings
+	DefaultKVS = config.KVS{
+		config.KV{
+			Key:   config.Enable,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   Targets,
+			Value: "",
+		},
+		config.KV{
+			Key:   Interval,
+			Value: "5m",
+		},
+		config.KV{
+			Key:   Cooldown,
+			Value: "24h",
+		},
+		config.KV{
+			Key:   ErrorThreshold,
+			Value: "10",
+		},
+		config.KV{
+			Key:   BitrotThreshold,
+			Value: "1",
+		},
+		config.KV{
+			Key:   SMART,
+			Value: config.EnableOn,
+		},
+	}
+
+	// Help provides help for config values
+	Help = config.HelpKVS{
+		config.HelpKV{
+			Key:         Targets,
+			Description: `comma separated list of notification target ARNs alerts are sent to e.g. "arn:minio:sqs::1:webhook"`,
+			Optional:    true,
+			Type:        "csv",
+		},
+		config.HelpKV{
+			Key:         Interval,
+			Description: `time duration between two checks of the drives, defaults to '5m'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         Cooldown,
+			Description: `minimum time duration between two alerts about the same drive, defaults to '24h'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         ErrorThreshold,
+			Description: `number of I/O errors of a drive between two checks raising an alert, '0' to disable, defaults to '10'`,
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         BitrotThreshold,
+			Description: `number of bitrot errors of a drive between two checks raising an alert, '0' to disable, defaults to '1'`,
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         SMART,
+			Description: `set to 'off' to not raise alerts on S.M.A.R.T. warnings, defaults to 'on'`,
+			Optional:    true,
+			Type:        "on|off",
+		},
+	}
+)
+
+// LookupConfig - lookup drive alert config and override with valid environment settings if any.
+func LookupConfig(kvs config.KVS) (cfg Config, err error) {
+	if err = config.CheckValidKeys(config.DriveAlertSubSys, kvs, DefaultKVS); err != nil {
+		return cfg, err
+	}
+
+	cfg.Enabled, err = config.ParseBool(env.Get(EnvDriveAlertEnable, kvs.Get(config.Enable)))
+	if err != nil {
+		// Parsing failures happen due to empty KVS, ignore it.
+		if kvs.Empty() {
+			return cfg, nil
+		}
+		return cfg, err
+	}
+	if !cfg.Enabled {
+		return cfg, nil
+	}
+
+	if targets := env.Get(EnvDriveAlertTargets, kvs.Get(Targets)); targets != "" {
+		for _, s := range strings.Split(targets, config.ValueSeparator) {
+			arn, err := event.ParseARN(strings.TrimSpace(s))
+			if err != nil {
+				return cfg, config.Errorf("invalid drive alert target '%s': %s", s, err)
+			}
+			cfg.Targets = append(cfg.Targets, arn.TargetID)
+		}
+	}
+
+	cfg.Interval, err = time.ParseDuration(env.Get(EnvDriveAlertInterval, kvs.Get(Interval)))
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.Interval < time.Minute {
+		return cfg, config.Errorf("drive alert interval cannot be shorter than 1m")
+	}
+
+	cfg.Cooldown, err = time.ParseDuration(env.Get(EnvDriveAlertCooldown, kvs.Get(Cooldown)))
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.ErrorThreshold, err = strconv.ParseUint(env.Get(EnvDriveAlertErrorThreshold, kvs.Get(ErrorThreshold)), 10, 64)
+	if err != nil {
+		return cfg, config.Errorf("invalid drive alert error threshold: %s", err)
+	}
+
+	cfg.BitrotThreshold, err = strconv.ParseUint(env.Get(EnvDriveAlertBitrotThreshold, kvs.Get(BitrotThreshold)), 10, 64)
+	if err != nil {
+		return cfg, config.Errorf("invalid drive alert bitrot threshold: %s", err)
+	}
+
+	cfg.SMART, err = config.ParseBool(env.Get(EnvDriveAlertSMART, kvs.Get(SMART)))
+	if err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
@@ -24,12 +24,30 @@ var (
 		"Browser can only accept `on` and `off` values. To disable web browser access, set this value to `off`",
 	)
 
+	ErrInvalidBrowserAddress = newErrFn(
+		"Invalid browser address",
+		"Please check the passed value",
+		"MINIO_BROWSER_ADDRESS must be a `host:port` address, such as `:9443`, different from the server address",
+	)
+
+	ErrInvalidBrowserCert = newErrFn(
+		"Invalid browser certificate",
+		"Please check the passed value",
+		"MINIO_BROWSER_CERT_FILE and MINIO_BROWSER_KEY_FILE must be set together, along with MINIO_BROWSER_ADDRESS",
+	)
+
 	ErrInvalidFSOSyncValue = newErrFn(
 		"Invalid O_SYNC value",
 		"Please check the passed value",
 		"Can only accept `on` and `off` values. To enable O_SYNC for fs backend, set this value to `on`",
 	)
 
+	ErrInvalidFSXattrValue = newErrFn(
+		"Invalid xattr metadata value",
+		"Please check the passed value",
+		"Can only accept `on` and `off` values. To store object metadata of fs backend in extended attributes, set this value to `on`",
+	)
+
 	ErrOverlappingDomainValue = newErrFn(
 		"Overlapping domain values",
 		"Please check the passed value",
@@ -34,6 +34,9 @@ const (
 	// Default values used while communicating with etcd.
 	defaultDialTimeout   = 5 * time.Second
 	defaultDialKeepAlive = 30 * time.Second
+
+	// Default TTL of bucket DNS records.
+	defaultCoreDNSTTL = 30 * time.Second
 )
 
 // etcd environment values
@@ -41,14 +44,20 @@ const (
 	Endpoints     = "endpoints"
 	PathPrefix    = "path_prefix"
 	CoreDNSPath   = "coredns_path"
-	ClientCert    = "client_cert"
-	ClientCertKey = "client_cert_key"
-
-	EnvEtcdEndpoints     = "MINIO_ETCD_ENDPOINTS"
-	EnvEtcdPathPrefix    = "MINIO_ETCD_PATH_PREFIX"
-	EnvEtcdCoreDNSPath   = "MINIO_ETCD_COREDNS_PATH"
-	EnvEtcdClientCert    = "MINIO_ETCD_CLIENT_CERT"
-	EnvEtcdClientCertKey = "MINIO_ETCD_CLIENT_CERT_KEY"
+	CoreDNSTTL       = "coredns_ttl"
+	CoreDNSBucketTTL = "coredns_bucket_ttl"
+	CoreDNSHealth    = "coredns_health_check"
+	ClientCert       = "client_cert"
+	ClientCertKey    = "client_cert_key"
+
+	EnvEtcdEndpoints        = "MINIO_ETCD_ENDPOINTS"
+	EnvEtcdPathPrefix       = "MINIO_ETCD_PATH_PREFIX"
+	EnvEtcdCoreDNSPath      = "MINIO_ETCD_COREDNS_PATH"
+	EnvEtcdCoreDNSTTL       = "MINIO_ETCD_COREDNS_TTL"
+	EnvEtcdCoreDNSBucketTTL = "MINIO_ETCD_COREDNS_BUCKET_TTL"
+	EnvEtcdCoreDNSHealth    = "MINIO_ETCD_COREDNS_HEALTH_CHECK"
+	EnvEtcdClientCert       = "MINIO_ETCD_CLIENT_CERT"
+	EnvEtcdClientCertKey    = "MINIO_ETCD_CLIENT_CERT_KEY"
 )
 
 // DefaultKVS - default KV settings for etcd.
@@ -66,6 +75,18 @@ var (
 			Key:   CoreDNSPath,
 			Value: "/skydns",
 		},
+		config.KV{
+			Key:   CoreDNSTTL,
+			Value: "30s",
+		},
+		config.KV{
+			Key:   CoreDNSBucketTTL,
+			Value: "",
+		},
+		config.KV{
+			Key:   CoreDNSHealth,
+			Value: config.EnableOff,
+		},
 		config.KV{
 			Key:   ClientCert,
 			Value: "",
@@ -79,9 +100,12 @@ var (
 
 // Config - server etcd config.
 type Config struct {
-	Enabled     bool   `json:"enabled"`
-	PathPrefix  string `json:"pathPrefix"`
-	CoreDNSPath string `json:"coreDNSPath"`
+	Enabled          bool                     `json:"enabled"`
+	PathPrefix       string                   `json:"pathPrefix"`
+	CoreDNSPath      string                   `json:"coreDNSPath"`
+	CoreDNSTTL       time.Duration            `json:"coreDNSTTL"`
+	CoreDNSBucketTTL map[string]time.Duration `json:"coreDNSBucketTTL"`
+	CoreDNSHealth    bool                     `json:"coreDNSHealthCheck"`
 	clientv3.Config
 }
 
@@ -119,6 +143,43 @@ func parseEndpoints(endpoints string) ([]string, bool, error) {
 	return etcdEndpoints, etcdSecure, nil
 }
 
+func parseCoreDNSTTL(ttl string) (time.Duration, error) {
+	if ttl == "" {
+		return defaultCoreDNSTTL, nil
+	}
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		return 0, config.Errorf("invalid coredns_ttl %s: %s", ttl, err)
+	}
+	if d < time.Second {
+		return 0, config.Errorf("invalid coredns_ttl %s: must be at least 1s", ttl)
+	}
+	return d, nil
+}
+
+// parseCoreDNSBucketTTL parses comma separated bucket=ttl pairs.
+func parseCoreDNSBucketTTL(s string) (map[string]time.Duration, error) {
+	ttls := make(map[string]time.Duration)
+	if s == "" {
+		return ttls, nil
+	}
+	for _, pair := range strings.Split(s, config.ValueSeparator) {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, config.Errorf("invalid coredns_bucket_ttl %s: expected bucket=ttl", pair)
+		}
+		if kv[1] == "" {
+			return nil, config.Errorf("invalid coredns_bucket_ttl %s: missing ttl", pair)
+		}
+		ttl, err := parseCoreDNSTTL(kv[1])
+		if err != nil {
+			return nil, err
+		}
+		ttls[kv[0]] = ttl
+	}
+	return ttls, nil
+}
+
 // Enabled returns if etcd is enabled.
 func Enabled(kvs config.KVS) bool {
 	endpoints := kvs.Get(Endpoints)
@@ -154,6 +215,20 @@ func LookupConfig(kvs config.KVS, rootCAs *x509.CertPool) (Config, error) {
 	}
 	cfg.Endpoints = etcdEndpoints
 	cfg.CoreDNSPath = env.Get(EnvEtcdCoreDNSPath, kvs.Get(CoreDNSPath))
+	cfg.CoreDNSTTL, err = parseCoreDNSTTL(env.Get(EnvEtcdCoreDNSTTL, kvs.Get(CoreDNSTTL)))
+	if err != nil {
+		return cfg, err
+	}
+	cfg.CoreDNSBucketTTL, err = parseCoreDNSBucketTTL(env.Get(EnvEtcdCoreDNSBucketTTL, kvs.Get(CoreDNSBucketTTL)))
+	if err != nil {
+		return cfg, err
+	}
+	if healthCheck := env.Get(EnvEtcdCoreDNSHealth, kvs.Get(CoreDNSHealth)); healthCheck != "" {
+		cfg.CoreDNSHealth, err = config.ParseBool(healthCheck)
+		if err != nil {
+			return cfg, err
+		}
+	}
 	// Default path prefix for all keys on etcd, other than CoreDNSPath.
 	cfg.PathPrefix = env.Get(EnvEtcdPathPrefix, kvs.Get(PathPrefix))
 	if etcdSecure {
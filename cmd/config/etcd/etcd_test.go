@@ -19,6 +19,7 @@ package etcd
 import (
 	"reflect"
 	"testing"
+	"time"
 )
 
 // TestParseEndpoints - tests parseEndpoints function with valid and invalid inputs.
@@ -64,3 +65,69 @@ func TestParseEndpoints(t *testing.T) {
 		})
 	}
 }
+
+// TestParseCoreDNSTTL - tests parseCoreDNSTTL function with valid and invalid inputs.
+func TestParseCoreDNSTTL(t *testing.T) {
+	testCases := []struct {
+		s       string
+		ttl     time.Duration
+		success bool
+	}{
+		{"", defaultCoreDNSTTL, true},
+		{"5s", 5 * time.Second, true},
+		{"2m", 2 * time.Minute, true},
+		{"500ms", 0, false},
+		{"-5s", 0, false},
+		{"abc", 0, false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.s, func(t *testing.T) {
+			ttl, err := parseCoreDNSTTL(testCase.s)
+			if err != nil && testCase.success {
+				t.Errorf("expected to succeed but failed with %s", err)
+			}
+			if !testCase.success && err == nil {
+				t.Error("expected failure but succeeded instead")
+			}
+			if testCase.success && ttl != testCase.ttl {
+				t.Errorf("expected %s, got %s", testCase.ttl, ttl)
+			}
+		})
+	}
+}
+
+// TestParseCoreDNSBucketTTL - tests parseCoreDNSBucketTTL function with valid and invalid inputs.
+func TestParseCoreDNSBucketTTL(t *testing.T) {
+	testCases := []struct {
+		s       string
+		ttls    map[string]time.Duration
+		success bool
+	}{
+		{"", map[string]time.Duration{}, true},
+		{"bucket1=10s", map[string]time.Duration{"bucket1": 10 * time.Second}, true},
+		{"bucket1=10s,bucket2=5m", map[string]time.Duration{"bucket1": 10 * time.Second, "bucket2": 5 * time.Minute}, true},
+		{"bucket1", nil, false},
+		{"bucket1=", nil, false},
+		{"=10s", nil, false},
+		{"bucket1=500ms", nil, false},
+		{"bucket1=10s,bucket2=abc", nil, false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.s, func(t *testing.T) {
+			ttls, err := parseCoreDNSBucketTTL(testCase.s)
+			if err != nil && testCase.success {
+				t.Errorf("expected to succeed but failed with %s", err)
+			}
+			if !testCase.success && err == nil {
+				t.Error("expected failure but succeeded instead")
+			}
+			if testCase.success && !reflect.DeepEqual(ttls, testCase.ttls) {
+				t.Errorf("expected %v, got %v", testCase.ttls, ttls)
+			}
+		})
+	}
+}
@@ -38,6 +38,24 @@ var (
 			Optional:    true,
 			Type:        "path",
 		},
+		config.HelpKV{
+			Key:         CoreDNSTTL,
+			Description: `TTL for bucket DNS records, default is "30s"`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         CoreDNSBucketTTL,
+			Description: `TTL for the DNS records of specific buckets e.g. "bucket1=10s,bucket2=5m"`,
+			Optional:    true,
+			Type:        "csv",
+		},
+		config.HelpKV{
+			Key:         CoreDNSHealth,
+			Description: `set to "on" to remove bucket DNS records of hosts failing health checks, defaults to "off"`,
+			Optional:    true,
+			Type:        "on|off",
+		},
 		config.HelpKV{
 			Key:         ClientCert,
 			Description: `client cert for mTLS authentication`,
@@ -0,0 +1,92 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package eventlog
+
+import (
+	"time"
+
+	"github.com/minio/minio/cmd/config"
+	"github.com/minio/minio/pkg/env"
+)
+
+// Event log environment variables
+const (
+	Retention = "retention"
+
+	EnvEventLogEnable    = "MINIO_EVENT_LOG_ENABLE"
+	EnvEventLogRetention = "MINIO_EVENT_LOG_RETENTION"
+)
+
+// Config represents the bucket event log settings.
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// Retention is how long the bucket events are kept
+	// for replay.
+	Retention time.Duration `json:"retention"`
+}
+
+var (
+	// DefaultKVS - default KV config for event log settings
+	DefaultKVS = config.KVS{
+		config.KV{
+			Key:   config.Enable,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   Retention,
+			Value: "24h",
+		},
+	}
+
+	// Help provides help for config values
+	Help = config.HelpKVS{
+		config.HelpKV{
+			Key:         Retention,
+			Description: `time duration bucket events are kept for replay, defaults to '24h'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+	}
+)
+
+// LookupConfig - lookup event log config and override with valid environment settings if any.
+func LookupConfig(kvs config.KVS) (cfg Config, err error) {
+	if err = config.CheckValidKeys(config.EventLogSubSys, kvs, DefaultKVS); err != nil {
+		return cfg, err
+	}
+
+	cfg.Enabled, err = config.ParseBool(env.Get(EnvEventLogEnable, kvs.Get(config.Enable)))
+	if err != nil {
+		// Parsing failures happen due to empty KVS, ignore it.
+		if kvs.Empty() {
+			return cfg, nil
+		}
+		return cfg, err
+	}
+	if !cfg.Enabled {
+		return cfg, nil
+	}
+
+	cfg.Retention, err = time.ParseDuration(env.Get(EnvEventLogRetention, kvs.Get(Retention)))
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.Retention < time.Hour {
+		return cfg, config.Errorf("event log retention cannot be shorter than 1h")
+	}
+	return cfg, nil
+}
@@ -50,6 +50,12 @@ var (
 			Optional:    true,
 			Type:        "csv",
 		},
+		config.HelpKV{
+			Key:         JwksRefreshInterval,
+			Description: `how often the JWKS are fetched again, keys stay cached while the provider is unreachable, defaults to "15m"`,
+			Optional:    true,
+			Type:        "duration",
+		},
 		config.HelpKV{
 			Key:         config.Comment,
 			Description: config.DefaultComment,
@@ -40,56 +40,193 @@ import (
 // RSA authentication target arguments
 type Config struct {
 	JWKS struct {
-		URL *xnet.URL `json:"url"`
+		URL  *xnet.URL   `json:"url"`
+		URLs []*xnet.URL `json:"urls,omitempty"`
 	} `json:"jwks"`
-	URL          *xnet.URL `json:"url,omitempty"`
-	ClaimPrefix  string    `json:"claimPrefix,omitempty"`
-	ClaimName    string    `json:"claimName,omitempty"`
-	DiscoveryDoc DiscoveryDoc
-	ClientID     string
-	publicKeys   map[string]crypto.PublicKey
-	transport    *http.Transport
-	closeRespFn  func(io.ReadCloser)
-	mutex        *sync.Mutex
+	URL             *xnet.URL `json:"url,omitempty"`
+	ClaimPrefix     string    `json:"claimPrefix,omitempty"`
+	ClaimName       string    `json:"claimName,omitempty"`
+	DiscoveryDoc    DiscoveryDoc
+	ClientID        string
+	RefreshInterval time.Duration
+	publicKeys      *jwksCache
+	transport       *http.Transport
+	closeRespFn     func(io.ReadCloser)
+	mutex           *sync.Mutex
 }
 
-// PopulatePublicKey - populates a new publickey from the JWKS URL.
+const (
+	// defaultJWKSRefreshInterval - how often the JWKS are fetched
+	// again in the background when not configured otherwise.
+	defaultJWKSRefreshInterval = 15 * time.Minute
+
+	// jwksMinRefreshInterval - the shortest interval between fetches
+	// of the JWKS for tokens signed with unknown keys, so that such
+	// tokens cannot overwhelm the provider.
+	jwksMinRefreshInterval = 10 * time.Second
+
+	// jwksFetchTimeout - how long fetching a JWKS may take.
+	jwksFetchTimeout = 10 * time.Second
+)
+
+// jwksCache - public keys of all JWKS URLs. The keys of a URL are
+// only replaced by a successful fetch, so that tokens can still be
+// validated while the provider is unreachable.
+type jwksCache struct {
+	mu          sync.RWMutex
+	keys        map[string]map[string]crypto.PublicKey // by URL, then by key ID
+	lastAttempt time.Time
+}
+
+func newJWKSCache() *jwksCache {
+	return &jwksCache{keys: make(map[string]map[string]crypto.PublicKey)}
+}
+
+// set - replaces the public keys of the JWKS URL.
+func (c *jwksCache) set(u string, keys map[string]crypto.PublicKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keys[u] = keys
+}
+
+// get - returns the public key with the key ID from any JWKS URL.
+func (c *jwksCache) get(kid string) (crypto.PublicKey, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, keys := range c.keys {
+		if key, ok := keys[kid]; ok {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+// allowRefresh - returns whether the JWKS were not fetched within
+// the interval, marking them as fetched now if so.
+func (c *jwksCache) allowRefresh(interval time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Since(c.lastAttempt) < interval {
+		return false
+	}
+	c.lastAttempt = time.Now()
+	return true
+}
+
+// jwksURLs - returns all configured JWKS URLs.
+func (r *Config) jwksURLs() []*xnet.URL {
+	if len(r.JWKS.URLs) > 0 {
+		return r.JWKS.URLs
+	}
+	if r.JWKS.URL == nil || r.JWKS.URL.String() == "" {
+		return nil
+	}
+	return []*xnet.URL{r.JWKS.URL}
+}
+
+// PopulatePublicKey - populates the public keys from all JWKS URLs. The
+// previous keys of a URL are kept when it cannot be fetched.
 func (r *Config) PopulatePublicKey() error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
-	if r.JWKS.URL == nil || r.JWKS.URL.String() == "" {
-		return nil
+	var firstErr error
+	for _, u := range r.jwksURLs() {
+		keys, err := r.fetchPublicKeys(u)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("unable to fetch JWKS from %s: %w", u, err)
+			}
+			continue
+		}
+		r.publicKeys.set(u.String(), keys)
 	}
+
+	return firstErr
+}
+
+// fetchPublicKeys - returns the public keys of a JWKS URL by key ID,
+// skipping keys that are not supported for token validation.
+func (r *Config) fetchPublicKeys(u *xnet.URL) (map[string]crypto.PublicKey, error) {
 	transport := http.DefaultTransport
 	if r.transport != nil {
 		transport = r.transport
 	}
 	client := &http.Client{
 		Transport: transport,
+		Timeout:   jwksFetchTimeout,
 	}
-	resp, err := client.Get(r.JWKS.URL.String())
+	resp, err := client.Get(u.String())
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if r.closeRespFn != nil {
+		defer r.closeRespFn(resp.Body)
+	} else {
+		defer resp.Body.Close()
 	}
-	defer r.closeRespFn(resp.Body)
 	if resp.StatusCode != http.StatusOK {
-		return errors.New(resp.Status)
+		return nil, errors.New(resp.Status)
 	}
 
 	var jwk JWKS
 	if err = json.NewDecoder(resp.Body).Decode(&jwk); err != nil {
-		return err
+		return nil, err
 	}
 
+	keys := make(map[string]crypto.PublicKey, len(jwk.Keys))
+	var decodeErr error
 	for _, key := range jwk.Keys {
-		r.publicKeys[key.Kid], err = key.DecodePublicKey()
+		pubKey, err := key.DecodePublicKey()
 		if err != nil {
-			return err
+			decodeErr = err
+			continue
 		}
+		keys[key.Kid] = pubKey
+	}
+	if len(keys) == 0 && decodeErr != nil {
+		return nil, decodeErr
 	}
 
-	return nil
+	return keys, nil
+}
+
+// publicKey - returns the public key with the key ID. The JWKS are
+// fetched again for unknown key IDs, as the provider may have rolled
+// its keys over, and in the background when due for a refresh.
+func (r *Config) publicKey(kid string) (crypto.PublicKey, error) {
+	if key, ok := r.publicKeys.get(kid); ok {
+		refreshInterval := r.RefreshInterval
+		if refreshInterval <= 0 {
+			refreshInterval = defaultJWKSRefreshInterval
+		}
+		if r.publicKeys.allowRefresh(refreshInterval) {
+			// Failures are retried on the next refresh, the
+			// cached keys are valid until then.
+			go r.PopulatePublicKey()
+		}
+		return key, nil
+	}
+
+	if !r.publicKeys.allowRefresh(jwksMinRefreshInterval) {
+		// Wait for a fetch in progress, if any.
+		r.mutex.Lock()
+		key, ok := r.publicKeys.get(kid)
+		r.mutex.Unlock()
+		if ok {
+			return key, nil
+		}
+		return nil, fmt.Errorf("No public key found for kid %s", kid)
+	}
+
+	err := r.PopulatePublicKey()
+	if key, ok := r.publicKeys.get(kid); ok {
+		return key, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("No public key found for kid %s", kid)
 }
 
 // UnmarshalJSON - decodes JSON data.
@@ -184,21 +321,13 @@ func (p *JWT) Validate(token, dsecs string) (map[string]interface{}, error) {
 		if !ok {
 			return nil, fmt.Errorf("Invalid kid value %v", jwtToken.Header["kid"])
 		}
-		return p.publicKeys[kid], nil
+		return p.publicKey(kid)
 	}
 
 	var claims jwtgo.MapClaims
 	jwtToken, err := jp.ParseWithClaims(token, &claims, keyFuncCallback)
 	if err != nil {
-		// Re-populate the public key in-case the JWKS
-		// pubkeys are refreshed
-		if err = p.PopulatePublicKey(); err != nil {
-			return nil, err
-		}
-		jwtToken, err = jwtgo.ParseWithClaims(token, &claims, keyFuncCallback)
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
 	}
 
 	if !jwtToken.Valid {
@@ -226,12 +355,16 @@ const (
 	ClientID    = "client_id"
 	Scopes      = "scopes"
 
+	JwksRefreshInterval = "jwks_refresh_interval"
+
 	EnvIdentityOpenIDClientID    = "MINIO_IDENTITY_OPENID_CLIENT_ID"
 	EnvIdentityOpenIDJWKSURL     = "MINIO_IDENTITY_OPENID_JWKS_URL"
 	EnvIdentityOpenIDURL         = "MINIO_IDENTITY_OPENID_CONFIG_URL"
 	EnvIdentityOpenIDClaimName   = "MINIO_IDENTITY_OPENID_CLAIM_NAME"
 	EnvIdentityOpenIDClaimPrefix = "MINIO_IDENTITY_OPENID_CLAIM_PREFIX"
 	EnvIdentityOpenIDScopes      = "MINIO_IDENTITY_OPENID_SCOPES"
+
+	EnvIdentityOpenIDJWKSRefreshInterval = "MINIO_IDENTITY_OPENID_JWKS_REFRESH_INTERVAL"
 )
 
 // DiscoveryDoc - parses the output from openid-configuration
@@ -304,6 +437,10 @@ var (
 			Key:   JwksURL,
 			Value: "",
 		},
+		config.KV{
+			Key:   JwksRefreshInterval,
+			Value: "15m",
+		},
 	}
 )
 
@@ -323,14 +460,26 @@ func LookupConfig(kvs config.KVS, transport *http.Transport, closeRespFn func(io
 		jwksURL = env.Get(EnvIdentityOpenIDJWKSURL, kvs.Get(JwksURL))
 	}
 
+	refreshInterval := defaultJWKSRefreshInterval
+	if v := env.Get(EnvIdentityOpenIDJWKSRefreshInterval, kvs.Get(JwksRefreshInterval)); v != "" {
+		refreshInterval, err = time.ParseDuration(v)
+		if err != nil {
+			return c, config.Errorf("invalid JWKS refresh interval '%s': %v", v, err)
+		}
+		if refreshInterval < jwksMinRefreshInterval {
+			return c, config.Errorf("JWKS refresh interval must be at least %s", jwksMinRefreshInterval)
+		}
+	}
+
 	c = Config{
-		ClaimName:   env.Get(EnvIdentityOpenIDClaimName, kvs.Get(ClaimName)),
-		ClaimPrefix: env.Get(EnvIdentityOpenIDClaimPrefix, kvs.Get(ClaimPrefix)),
-		publicKeys:  make(map[string]crypto.PublicKey),
-		ClientID:    env.Get(EnvIdentityOpenIDClientID, kvs.Get(ClientID)),
-		transport:   transport,
-		closeRespFn: closeRespFn,
-		mutex:       &sync.Mutex{}, // allocate for copying
+		ClaimName:       env.Get(EnvIdentityOpenIDClaimName, kvs.Get(ClaimName)),
+		ClaimPrefix:     env.Get(EnvIdentityOpenIDClaimPrefix, kvs.Get(ClaimPrefix)),
+		publicKeys:      newJWKSCache(), // allocate for copying
+		ClientID:        env.Get(EnvIdentityOpenIDClientID, kvs.Get(ClientID)),
+		RefreshInterval: refreshInterval,
+		transport:       transport,
+		closeRespFn:     closeRespFn,
+		mutex:           &sync.Mutex{}, // allocate for copying
 	}
 
 	configURL := env.Get(EnvIdentityOpenIDURL, kvs.Get(ConfigURL))
@@ -371,10 +520,14 @@ func LookupConfig(kvs config.KVS, transport *http.Transport, closeRespFn func(io
 		return c, nil
 	}
 
-	c.JWKS.URL, err = xnet.ParseHTTPURL(jwksURL)
-	if err != nil {
-		return c, err
+	for _, u := range strings.Split(jwksURL, ",") {
+		jwksU, err := xnet.ParseHTTPURL(strings.TrimSpace(u))
+		if err != nil {
+			return c, err
+		}
+		c.JWKS.URLs = append(c.JWKS.URLs, jwksU)
 	}
+	c.JWKS.URL = c.JWKS.URLs[0]
 
 	if err = c.PopulatePublicKey(); err != nil {
 		return c, err
@@ -19,8 +19,11 @@ package openid
 import (
 	"crypto"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -92,7 +95,8 @@ func TestJWTAzureFail(t *testing.T) {
 	cfg := Config{}
 	cfg.mutex = &sync.Mutex{}
 	cfg.JWKS.URL = u1
-	cfg.publicKeys = keys
+	cfg.publicKeys = newJWKSCache()
+	cfg.publicKeys.set(u1.String(), keys)
 	jwt := NewJWT(cfg)
 	if jwt.ID() != "jwt" {
 		t.Fatalf("Uexpected id %s for the validator", jwt.ID())
@@ -140,7 +144,8 @@ func TestJWT(t *testing.T) {
 	cfg := Config{}
 	cfg.mutex = &sync.Mutex{}
 	cfg.JWKS.URL = u1
-	cfg.publicKeys = keys
+	cfg.publicKeys = newJWKSCache()
+	cfg.publicKeys.set(u1.String(), keys)
 	jwt := NewJWT(cfg)
 	if jwt.ID() != "jwt" {
 		t.Fatalf("Uexpected id %s for the validator", jwt.ID())
@@ -199,3 +204,56 @@ func TestDefaultExpiryDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestJWKSCache(t *testing.T) {
+	const jsonkey = `{"keys":
+       [
+         {"kty":"RSA",
+          "n": "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+          "e":"AQAB",
+          "alg":"RS256",
+          "kid":"2011-04-29"}
+       ]
+     }`
+
+	var fetches int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		w.Write([]byte(jsonkey))
+	}))
+
+	u, err := xnet.ParseHTTPURL(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := Config{}
+	cfg.mutex = &sync.Mutex{}
+	cfg.publicKeys = newJWKSCache()
+	cfg.JWKS.URLs = []*xnet.URL{u}
+
+	// Unknown keys are fetched on demand.
+	if _, err = cfg.publicKey("2011-04-29"); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&fetches); n != 1 {
+		t.Fatalf("Expected 1 fetch, got %d", n)
+	}
+
+	// Known keys are served from the cache while the provider is down.
+	ts.Close()
+	if err = cfg.PopulatePublicKey(); err == nil {
+		t.Fatal("Expected fetching from a closed server to fail")
+	}
+	if _, err = cfg.publicKey("2011-04-29"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unknown keys do not cause a fetch more than once in a while.
+	if _, err = cfg.publicKey("unknown"); err == nil {
+		t.Fatal("Expected an unknown key ID to fail")
+	}
+	if n := atomic.LoadInt32(&fetches); n != 1 {
+		t.Fatalf("Expected 1 fetch, got %d", n)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package multipart
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio/cmd/config"
+	"github.com/minio/minio/pkg/env"
+)
+
+// Multipart upload environment variables
+const (
+	AbortIncomplete        = "abort_incomplete"
+	AbortIncompleteDays    = "abort_incomplete_days"
+	AbortIncompleteExclude = "abort_incomplete_exclude"
+
+	EnvAbortIncomplete        = "MINIO_MULTIPART_ABORT_INCOMPLETE"
+	EnvAbortIncompleteDays    = "MINIO_MULTIPART_ABORT_INCOMPLETE_DAYS"
+	EnvAbortIncompleteExclude = "MINIO_MULTIPART_ABORT_INCOMPLETE_EXCLUDE"
+)
+
+// Config represents the multipart upload settings.
+type Config struct {
+	// AbortIncomplete enables aborting the incomplete multipart
+	// uploads of all buckets once they are older than AbortAfter.
+	AbortIncomplete bool          `json:"abortIncomplete"`
+	AbortAfter      time.Duration `json:"abortAfter"`
+	// Exclude lists the buckets whose uploads are never aborted.
+	Exclude []string `json:"exclude"`
+}
+
+// Expired returns whether an incomplete multipart upload of bucket
+// initiated at initiated is to be aborted at now. bucket is empty
+// when it is not known, such uploads are never excluded.
+func (c Config) Expired(bucket string, initiated, now time.Time) bool {
+	if !c.AbortIncomplete || now.Sub(initiated) <= c.AbortAfter {
+		return false
+	}
+	for _, excluded := range c.Exclude {
+		if bucket == excluded {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	// DefaultKVS - default KV config for multipart upload settings
+	DefaultKVS = config.KVS{
+		config.KV{
+			Key:   AbortIncomplete,
+			Value: config.EnableOn,
+		},
+		config.KV{
+			Key:   AbortIncompleteDays,
+			Value: "1",
+		},
+		config.KV{
+			Key:   AbortIncompleteExclude,
+			Value: "",
+		},
+	}
+
+	// Help provides help for config values
+	Help = config.HelpKVS{
+		config.HelpKV{
+			Key:         AbortIncomplete,
+			Description: `set to 'off' to keep incomplete multipart uploads of all buckets, defaults to 'on'`,
+			Optional:    true,
+			Type:        "on|off",
+		},
+		config.HelpKV{
+			Key:         AbortIncompleteDays,
+			Description: `number of days after which incomplete multipart uploads are aborted, defaults to '1'`,
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         AbortIncompleteExclude,
+			Description: `comma separated list of buckets whose incomplete multipart uploads are never aborted`,
+			Optional:    true,
+			Type:        "csv",
+		},
+	}
+)
+
+// LookupConfig - lookup multipart upload config and override with valid environment settings if any.
+func LookupConfig(kvs config.KVS) (cfg Config, err error) {
+	if err = config.CheckValidKeys(config.MultipartSubSys, kvs, DefaultKVS); err != nil {
+		return cfg, err
+	}
+
+	abortIncomplete := env.Get(EnvAbortIncomplete, kvs.Get(AbortIncomplete))
+	if abortIncomplete == "" && kvs.Empty() {
+		abortIncomplete = config.EnableOn
+	}
+	cfg.AbortIncomplete, err = config.ParseBool(abortIncomplete)
+	if err != nil {
+		return cfg, err
+	}
+
+	days := env.Get(EnvAbortIncompleteDays, kvs.Get(AbortIncompleteDays))
+	if days == "" {
+		days = "1"
+	}
+	n, err := strconv.Atoi(days)
+	if err != nil {
+		return cfg, config.Errorf("invalid %s value %q: %s", AbortIncompleteDays, days, err)
+	}
+	if n < 1 {
+		return cfg, config.Errorf("%s must be at least 1", AbortIncompleteDays)
+	}
+	cfg.AbortAfter = time.Duration(n) * 24 * time.Hour
+
+	for _, bucket := range strings.Split(env.Get(EnvAbortIncompleteExclude, kvs.Get(AbortIncompleteExclude)), config.ValueSeparator) {
+		if bucket = strings.TrimSpace(bucket); bucket != "" {
+			cfg.Exclude = append(cfg.Exclude, bucket)
+		}
+	}
+	return cfg, nil
+}
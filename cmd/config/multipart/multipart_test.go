@@ -0,0 +1,114 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package multipart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/minio/cmd/config"
+)
+
+func TestLookupConfig(t *testing.T) {
+	testCases := []struct {
+		kvs       config.KVS
+		expected  Config
+		shouldErr bool
+	}{
+		{
+			kvs:      config.KVS{},
+			expected: Config{AbortIncomplete: true, AbortAfter: 24 * time.Hour},
+		},
+		{
+			kvs: config.KVS{
+				config.KV{Key: AbortIncomplete, Value: config.EnableOff},
+				config.KV{Key: AbortIncompleteDays, Value: "1"},
+				config.KV{Key: AbortIncompleteExclude, Value: ""},
+			},
+			expected: Config{AbortAfter: 24 * time.Hour},
+		},
+		{
+			kvs: config.KVS{
+				config.KV{Key: AbortIncomplete, Value: config.EnableOn},
+				config.KV{Key: AbortIncompleteDays, Value: "7"},
+				config.KV{Key: AbortIncompleteExclude, Value: "backups, archive"},
+			},
+			expected: Config{AbortIncomplete: true, AbortAfter: 7 * 24 * time.Hour, Exclude: []string{"backups", "archive"}},
+		},
+		{
+			kvs: config.KVS{
+				config.KV{Key: AbortIncomplete, Value: config.EnableOn},
+				config.KV{Key: AbortIncompleteDays, Value: "0"},
+				config.KV{Key: AbortIncompleteExclude, Value: ""},
+			},
+			shouldErr: true,
+		},
+		{
+			kvs: config.KVS{
+				config.KV{Key: AbortIncomplete, Value: config.EnableOn},
+				config.KV{Key: AbortIncompleteDays, Value: "week"},
+				config.KV{Key: AbortIncompleteExclude, Value: ""},
+			},
+			shouldErr: true,
+		},
+	}
+
+	for i, testCase := range testCases {
+		cfg, err := LookupConfig(testCase.kvs)
+		if testCase.shouldErr != (err != nil) {
+			t.Fatalf("Test %d: expected error %t, got %v", i+1, testCase.shouldErr, err)
+		}
+		if err != nil {
+			continue
+		}
+		if cfg.AbortIncomplete != testCase.expected.AbortIncomplete || cfg.AbortAfter != testCase.expected.AbortAfter {
+			t.Errorf("Test %d: expected %+v, got %+v", i+1, testCase.expected, cfg)
+		}
+		if len(cfg.Exclude) != len(testCase.expected.Exclude) {
+			t.Fatalf("Test %d: expected exclude %v, got %v", i+1, testCase.expected.Exclude, cfg.Exclude)
+		}
+		for j := range cfg.Exclude {
+			if cfg.Exclude[j] != testCase.expected.Exclude[j] {
+				t.Errorf("Test %d: expected exclude %v, got %v", i+1, testCase.expected.Exclude, cfg.Exclude)
+			}
+		}
+	}
+}
+
+func TestConfigExpired(t *testing.T) {
+	now := time.Now()
+	cfg := Config{AbortIncomplete: true, AbortAfter: 24 * time.Hour, Exclude: []string{"backups"}}
+
+	testCases := []struct {
+		cfg       Config
+		bucket    string
+		initiated time.Time
+		expired   bool
+	}{
+		{cfg, "bucket", now.Add(-48 * time.Hour), true},
+		{cfg, "bucket", now.Add(-time.Hour), false},
+		{cfg, "backups", now.Add(-48 * time.Hour), false},
+		{cfg, "", now.Add(-48 * time.Hour), true},
+		{Config{AbortAfter: 24 * time.Hour}, "bucket", now.Add(-48 * time.Hour), false},
+	}
+
+	for i, testCase := range testCases {
+		if expired := testCase.cfg.Expired(testCase.bucket, testCase.initiated, now); expired != testCase.expired {
+			t.Errorf("Test %d: expected %t, got %t", i+1, testCase.expired, expired)
+		}
+	}
+}
@@ -30,11 +30,17 @@ const (
 	MaxWait = "max_wait"
 	Cycle   = "cycle"
 
+	AccessTime         = "access_time"
+	AccessTimeInterval = "access_time_interval"
+
 	EnvDelay         = "MINIO_SCANNER_DELAY"
 	EnvCycle         = "MINIO_SCANNER_CYCLE"
 	EnvDelayLegacy   = "MINIO_CRAWLER_DELAY"
 	EnvMaxWait       = "MINIO_SCANNER_MAX_WAIT"
 	EnvMaxWaitLegacy = "MINIO_CRAWLER_MAX_WAIT"
+
+	EnvAccessTime         = "MINIO_SCANNER_ACCESS_TIME"
+	EnvAccessTimeInterval = "MINIO_SCANNER_ACCESS_TIME_INTERVAL"
 )
 
 // Config represents the heal settings.
@@ -45,6 +51,12 @@ type Config struct {
 	MaxWait time.Duration
 	// Cycle is the time.Duration between each scanner cycles
 	Cycle time.Duration
+	// AccessTime enables recording when objects are read, for
+	// the lifecycle transitions based on the last access.
+	AccessTime bool
+	// AccessTimeInterval is the minimum time between two
+	// updates of the recorded access time of an object.
+	AccessTimeInterval time.Duration
 }
 
 var (
@@ -62,6 +74,14 @@ var (
 			Key:   Cycle,
 			Value: "1m",
 		},
+		config.KV{
+			Key:   AccessTime,
+			Value: config.EnableOn,
+		},
+		config.KV{
+			Key:   AccessTimeInterval,
+			Value: "24h",
+		},
 	}
 
 	// Help provides help for config values
@@ -84,6 +104,18 @@ var (
 			Optional:    true,
 			Type:        "duration",
 		},
+		config.HelpKV{
+			Key:         AccessTime,
+			Description: `record when objects are read for lifecycle transitions based on the last access, defaults to 'on'`,
+			Optional:    true,
+			Type:        "on|off",
+		},
+		config.HelpKV{
+			Key:         AccessTimeInterval,
+			Description: `minimum time between updates of the recorded access time of an object, defaults to '24h'`,
+			Optional:    true,
+			Type:        "duration",
+		},
 	}
 )
 
@@ -113,5 +145,22 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 	if err != nil {
 		return cfg, err
 	}
+
+	accessTime := env.Get(EnvAccessTime, kvs.Get(AccessTime))
+	if accessTime == "" {
+		accessTime = config.EnableOn
+	}
+	cfg.AccessTime, err = config.ParseBool(accessTime)
+	if err != nil {
+		return cfg, err
+	}
+	accessTimeInterval := env.Get(EnvAccessTimeInterval, kvs.Get(AccessTimeInterval))
+	if accessTimeInterval == "" {
+		accessTimeInterval = "24h"
+	}
+	cfg.AccessTimeInterval, err = time.ParseDuration(accessTimeInterval)
+	if err != nil {
+		return cfg, err
+	}
 	return cfg, nil
 }
@@ -39,6 +39,12 @@ var (
 			Optional:    true,
 			Type:        "string",
 		},
+		config.HelpKV{
+			Key:         ClassBitrot,
+			Description: `set the bitrot checksum algorithm of new objects, defaults to "highwayhash" e.g. "blake3"`,
+			Optional:    true,
+			Type:        "string",
+		},
 		config.HelpKV{
 			Key:         config.Comment,
 			Description: config.DefaultComment,
@@ -40,6 +40,10 @@ const (
 	// Valid values are "write" and "read+write"
 	DMAWrite     = "write"
 	DMAReadWrite = "read+write"
+
+	// Valid values are "highwayhash" and "blake3"
+	BitrotHighwayHash = "highwayhash"
+	BitrotBLAKE3      = "blake3"
 )
 
 // Standard constats for config info storage class
@@ -47,6 +51,7 @@ const (
 	ClassStandard = "standard"
 	ClassRRS      = "rrs"
 	ClassDMA      = "dma"
+	ClassBitrot   = "bitrot"
 
 	// Reduced redundancy storage class environment variable
 	RRSEnv = "MINIO_STORAGE_CLASS_RRS"
@@ -54,6 +59,8 @@ const (
 	StandardEnv = "MINIO_STORAGE_CLASS_STANDARD"
 	// DMA storage class environment variable
 	DMAEnv = "MINIO_STORAGE_CLASS_DMA"
+	// Bitrot algorithm environment variable
+	BitrotEnv = "MINIO_STORAGE_CLASS_BITROT"
 
 	// Supported storage class scheme is EC
 	schemePrefix = "EC"
@@ -66,6 +73,9 @@ const (
 
 	// Default DMA value
 	defaultDMA = DMAReadWrite
+
+	// Default bitrot algorithm
+	defaultBitrot = BitrotHighwayHash
 )
 
 // DefaultKVS - default storage class config
@@ -83,6 +93,10 @@ var (
 			Key:   ClassDMA,
 			Value: defaultDMA,
 		},
+		config.KV{
+			Key:   ClassBitrot,
+			Value: defaultBitrot,
+		},
 	}
 )
 
@@ -99,6 +113,7 @@ type Config struct {
 	Standard StorageClass `json:"standard"`
 	RRS      StorageClass `json:"rrs"`
 	DMA      string       `json:"dma"`
+	Bitrot   string       `json:"bitrot"`
 }
 
 // UnmarshalJSON - Validate SS and RRS parity when unmarshalling JSON.
@@ -251,12 +266,13 @@ func (sCfg Config) GetParityForSC(sc string) (parity int) {
 }
 
 // Update update storage-class with new config
-func (sCfg Config) Update(newCfg Config) {
+func (sCfg *Config) Update(newCfg Config) {
 	ConfigLock.Lock()
 	defer ConfigLock.Unlock()
 	sCfg.RRS = newCfg.RRS
 	sCfg.DMA = newCfg.DMA
 	sCfg.Standard = newCfg.Standard
+	sCfg.Bitrot = newCfg.Bitrot
 }
 
 // GetDMA - returns DMA configuration.
@@ -266,6 +282,13 @@ func (sCfg Config) GetDMA() string {
 	return sCfg.DMA
 }
 
+// GetBitrot - returns the bitrot algorithm for new objects.
+func (sCfg Config) GetBitrot() string {
+	ConfigLock.RLock()
+	defer ConfigLock.RUnlock()
+	return sCfg.Bitrot
+}
+
 // Enabled returns if etcd is enabled.
 func Enabled(kvs config.KVS) bool {
 	ssc := kvs.Get(ClassStandard)
@@ -284,6 +307,7 @@ func LookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
 	ssc := env.Get(StandardEnv, kvs.Get(ClassStandard))
 	rrsc := env.Get(RRSEnv, kvs.Get(ClassRRS))
 	dma := env.Get(DMAEnv, kvs.Get(ClassDMA))
+	bitrot := env.Get(BitrotEnv, kvs.Get(ClassBitrot))
 	// Check for environment variables and parse into storageClass struct
 	if ssc != "" {
 		cfg.Standard, err = parseStorageClass(ssc)
@@ -310,6 +334,14 @@ func LookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
 	}
 	cfg.DMA = dma
 
+	if bitrot == "" {
+		bitrot = defaultBitrot
+	}
+	if bitrot != BitrotHighwayHash && bitrot != BitrotBLAKE3 {
+		return Config{}, errors.New(`valid bitrot values are "highwayhash" and "blake3"`)
+	}
+	cfg.Bitrot = bitrot
+
 	// Validation is done after parsing both the storage classes. This is needed because we need one
 	// storage class value to deduce the correct value of the other storage class.
 	if err = validateParity(cfg.Standard.Parity, cfg.RRS.Parity, setDriveCount); err != nil {
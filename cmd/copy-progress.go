@@ -0,0 +1,148 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// globalCopyProgress tracks the server-side copies of this server.
+var globalCopyProgress = &copyProgress{
+	copies: make(map[string]*copyProgressReader),
+}
+
+// copyProgress holds the server-side copies in progress.
+type copyProgress struct {
+	mu     sync.Mutex
+	copies map[string]*copyProgressReader
+}
+
+// track registers a copy reading from r, the returned reader must be
+// used in place of r and done must be called once the copy is over.
+func (p *copyProgress) track(ctx context.Context, r io.Reader, info madmin.CopyProgress) (reader *copyProgressReader, done func()) {
+	info.ID = mustGetUUID()
+	info.Node = globalLocalNodeName
+	info.StartTime = UTCNow()
+	reader = &copyProgressReader{ctx: ctx, reader: r, info: info}
+
+	p.mu.Lock()
+	p.copies[info.ID] = reader
+	p.mu.Unlock()
+
+	return reader, func() {
+		p.mu.Lock()
+		delete(p.copies, info.ID)
+		p.mu.Unlock()
+	}
+}
+
+// list returns the progress of the copies in progress, oldest first.
+func (p *copyProgress) list() []madmin.CopyProgress {
+	p.mu.Lock()
+	copies := make([]madmin.CopyProgress, 0, len(p.copies))
+	for _, reader := range p.copies {
+		copies = append(copies, reader.progress())
+	}
+	p.mu.Unlock()
+
+	sortCopyProgress(copies)
+	return copies
+}
+
+func sortCopyProgress(copies []madmin.CopyProgress) {
+	sort.Slice(copies, func(i, j int) bool {
+		if copies[i].StartTime.Equal(copies[j].StartTime) {
+			return copies[i].ID < copies[j].ID
+		}
+		return copies[i].StartTime.Before(copies[j].StartTime)
+	})
+}
+
+// copyProgressReader counts the bytes read by a server-side copy,
+// and throttles the reads to the bandwidth limit of the copy if any.
+type copyProgressReader struct {
+	copied int64 // must be first for atomic operations on 32-bit platforms.
+
+	ctx    context.Context
+	reader io.Reader
+	info   madmin.CopyProgress
+}
+
+func (c *copyProgressReader) Read(p []byte) (n int, err error) {
+	if limit := c.info.BandwidthLimit; limit > 0 {
+		// Never read more than a second worth of data at once,
+		// so that the copy proceeds at a steady pace.
+		if int64(len(p)) > limit {
+			p = p[:limit]
+		}
+		if err = c.wait(limit); err != nil {
+			return 0, err
+		}
+	}
+	n, err = c.reader.Read(p)
+	atomic.AddInt64(&c.copied, int64(n))
+	return n, err
+}
+
+// wait blocks until the bytes already copied are within the limit.
+func (c *copyProgressReader) wait(limit int64) error {
+	copied := atomic.LoadInt64(&c.copied)
+	due := c.info.StartTime.Add(time.Duration(float64(copied) / float64(limit) * float64(time.Second)))
+	delay := time.Until(due)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (c *copyProgressReader) progress() madmin.CopyProgress {
+	info := c.info
+	info.CopiedBytes = atomic.LoadInt64(&c.copied)
+	return info
+}
+
+// getCopyBandwidth returns the bandwidth limit of a server-side copy,
+// in bytes per second, zero if the request sets none.
+func getCopyBandwidth(h http.Header) (int64, error) {
+	v := h.Get(xhttp.MinIOCopyBandwidth)
+	if v == "" {
+		return 0, nil
+	}
+	limit, err := humanize.ParseBytes(v)
+	if err != nil {
+		return 0, err
+	}
+	return int64(limit), nil
+}
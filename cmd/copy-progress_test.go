@@ -0,0 +1,120 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestCopyProgress(t *testing.T) {
+	progress := &copyProgress{copies: make(map[string]*copyProgressReader)}
+	reader, done := progress.track(context.Background(), bytes.NewReader(make([]byte, 100)), madmin.CopyProgress{
+		SrcBucket:  "src",
+		SrcObject:  "object",
+		DstBucket:  "dst",
+		DstObject:  "object",
+		TotalBytes: 100,
+	})
+	if _, err := io.CopyN(ioutil.Discard, reader, 60); err != nil {
+		t.Fatal(err)
+	}
+
+	copies := progress.list()
+	if len(copies) != 1 {
+		t.Fatalf("expected 1 copy in progress, got %d", len(copies))
+	}
+	if copies[0].ID == "" || copies[0].SrcBucket != "src" || copies[0].DstBucket != "dst" {
+		t.Errorf("unexpected copy %#v", copies[0])
+	}
+	if copies[0].CopiedBytes != 60 || copies[0].TotalBytes != 100 {
+		t.Errorf("expected 60 of 100 bytes copied, got %d of %d", copies[0].CopiedBytes, copies[0].TotalBytes)
+	}
+
+	done()
+	if copies = progress.list(); len(copies) != 0 {
+		t.Fatalf("expected no copy in progress, got %d", len(copies))
+	}
+}
+
+func TestCopyProgressBandwidth(t *testing.T) {
+	progress := &copyProgress{copies: make(map[string]*copyProgressReader)}
+	reader, done := progress.track(context.Background(), bytes.NewReader(make([]byte, 2000)), madmin.CopyProgress{
+		BandwidthLimit: 4000,
+	})
+	defer done()
+
+	start := time.Now()
+	n, err := io.CopyBuffer(ioutil.Discard, reader, make([]byte, 1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2000 {
+		t.Fatalf("expected 2000 bytes copied, got %d", n)
+	}
+	// The second half can only be read once the first half is within the limit.
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("copy was not throttled, took %v", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reader, done = progress.track(ctx, bytes.NewReader(make([]byte, 2000)), madmin.CopyProgress{
+		BandwidthLimit: 1,
+	})
+	defer done()
+	if _, err = io.Copy(ioutil.Discard, reader); err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestGetCopyBandwidth(t *testing.T) {
+	testCases := []struct {
+		value    string
+		limit    int64
+		expectOk bool
+	}{
+		{"", 0, true},
+		{"1024", 1024, true},
+		{"10MiB", 10 << 20, true},
+		{"1 KB", 1000, true},
+		{"fast", 0, false},
+	}
+
+	for i, testCase := range testCases {
+		h := make(http.Header)
+		if testCase.value != "" {
+			h.Set(xhttp.MinIOCopyBandwidth, testCase.value)
+		}
+		limit, err := getCopyBandwidth(h)
+		if (err == nil) != testCase.expectOk {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+		}
+		if limit != testCase.limit {
+			t.Errorf("Test %d: expected %d, got %d", i+1, testCase.limit, limit)
+		}
+	}
+}
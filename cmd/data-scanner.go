@@ -130,7 +130,7 @@ func runDataScanner(ctx context.Context, objAPI ObjectLayer) {
 			// Reset the timer for next cycle.
 			scannerTimer.Reset(scannerCycle.Get())
 
-			if intDataUpdateTracker.debug {
+			if debugLogEnabled(debugLogScanner) {
 				console.Debugln("starting scanner cycle")
 			}
 
@@ -193,7 +193,7 @@ func scanDataFolder(ctx context.Context, basePath string, cache dataUsageCache,
 
 	logPrefix := color.Green("data-usage: ")
 	logSuffix := color.Blue("- %v + %v", basePath, cache.Info.Name)
-	if intDataUpdateTracker.debug {
+	if debugLogEnabled(debugLogScanner) {
 		defer func() {
 			console.Debugf(logPrefix+" Scanner time: %v %s\n", time.Since(t), logSuffix)
 		}()
@@ -214,7 +214,7 @@ func scanDataFolder(ctx context.Context, basePath string, cache dataUsageCache,
 		newCache:              dataUsageCache{Info: cache.Info},
 		newFolders:            nil,
 		existingFolders:       nil,
-		dataUsageScannerDebug: intDataUpdateTracker.debug,
+		dataUsageScannerDebug: debugLogEnabled(debugLogScanner),
 		healFolderInclude:     0,
 		healObjectSelect:      0,
 	}
@@ -799,6 +799,30 @@ type sizeSummary struct {
 	replicaSize    int64
 	pendingCount   uint64
 	failedCount    uint64
+
+	// object versions per storage class, and per remote tier.
+	storageClasses map[string]tierStats
+	tiers          map[string]tierStats
+}
+
+// addVersion accounts an object version of the given size to its
+// storage class, or to its remote tier if it was transitioned.
+func (s *sizeSummary) addVersion(ctx context.Context, oi ObjectInfo, size int64) {
+	if oi.DeleteMarker || size < 0 {
+		return
+	}
+	stats := &s.storageClasses
+	name := oi.StorageClass
+	if oi.TransitionStatus == lifecycle.TransitionComplete {
+		// Versions transitioned to a tier which is no
+		// longer configured are accounted with no name.
+		stats = &s.tiers
+		name = transitionSC(ctx, oi.Bucket)
+	}
+	if *stats == nil {
+		*stats = make(map[string]tierStats, 1)
+	}
+	(*stats)[name] = (*stats)[name].add(tierStats{Size: uint64(size), Objects: 1})
 }
 
 type getSizeFn func(item scannerItem) (sizeSummary, error)
@@ -846,23 +870,32 @@ func (i *scannerItem) applyHealing(ctx context.Context, o ObjectLayer, meta acti
 	return res.ObjectSize
 }
 
-func (i *scannerItem) applyLifecycle(ctx context.Context, o ObjectLayer, meta actionMeta) (applied bool, size int64) {
+// applyLifecycle applies the lifecycle rules on a scanned item, it returns
+// the action applied, lifecycle.NoneAction when none is.
+func (i *scannerItem) applyLifecycle(ctx context.Context, o ObjectLayer, meta actionMeta) (applied lifecycle.Action, size int64) {
 	size, err := meta.oi.GetActualSize()
 	if i.debug {
 		logger.LogIf(ctx, err)
 	}
-	if i.lifeCycle == nil {
-		if i.debug {
-			console.Debugf(applyActionsLogPrefix+" no lifecycle rules to apply: %q\n", i.objectPath())
+	lc := i.lifeCycle
+	if lc == nil {
+		if objectExpiresAt(meta.oi).IsZero() {
+			if i.debug {
+				console.Debugf(applyActionsLogPrefix+" no lifecycle rules to apply: %q\n", i.objectPath())
+			}
+			return lifecycle.NoneAction, size
 		}
-		return false, size
+		// Objects written with their own expiration
+		// expire without any lifecycle rule.
+		lc = &lifecycle.Lifecycle{}
 	}
 
 	versionID := meta.oi.VersionID
-	action := i.lifeCycle.ComputeAction(
+	action := lc.ComputeAction(
 		lifecycle.ObjectOpts{
 			Name:             i.objectPath(),
 			UserTags:         meta.oi.UserTags,
+			Size:             meta.oi.Size,
 			ModTime:          meta.oi.ModTime,
 			VersionID:        meta.oi.VersionID,
 			DeleteMarker:     meta.oi.DeleteMarker,
@@ -872,6 +905,8 @@ func (i *scannerItem) applyLifecycle(ctx context.Context, o ObjectLayer, meta ac
 			RestoreOngoing:   meta.oi.RestoreOngoing,
 			RestoreExpires:   meta.oi.RestoreExpires,
 			TransitionStatus: meta.oi.TransitionStatus,
+			AccessTime:       globalAccessTimeTracker.AccessTime(meta.oi),
+			ExpiresAt:        objectExpiresAt(meta.oi),
 		})
 	if i.debug {
 		if versionID != "" {
@@ -889,7 +924,7 @@ func (i *scannerItem) applyLifecycle(ctx context.Context, o ObjectLayer, meta ac
 		if i.debug {
 			console.Debugf(applyActionsLogPrefix+" object not expirable: %q\n", i.objectPath())
 		}
-		return false, size
+		return lifecycle.NoneAction, size
 	}
 
 	obj, err := o.GetObjectInfo(ctx, i.bucket, i.objectPath(), ObjectOptions{
@@ -901,54 +936,72 @@ func (i *scannerItem) applyLifecycle(ctx context.Context, o ObjectLayer, meta ac
 			if !obj.DeleteMarker { // if this is not a delete marker log and return
 				// Do nothing - heal in the future.
 				logger.LogIf(ctx, err)
-				return false, size
+				return lifecycle.NoneAction, size
 			}
 		case ObjectNotFound, VersionNotFound:
 			// object not found or version not found return 0
-			return false, 0
+			return lifecycle.NoneAction, 0
 		default:
 			// All other errors proceed.
 			logger.LogIf(ctx, err)
-			return false, size
+			return lifecycle.NoneAction, size
 		}
 	}
 
-	action = evalActionFromLifecycle(ctx, *i.lifeCycle, obj, i.debug)
-	if action != lifecycle.NoneAction {
-		applied = applyLifecycleAction(ctx, action, o, obj)
-	}
-
-	if applied {
+	action = evalActionFromLifecycle(ctx, *lc, obj, i.debug)
+	if action != lifecycle.NoneAction && applyLifecycleAction(ctx, action, o, obj) {
 		switch action {
 		case lifecycle.TransitionAction, lifecycle.TransitionVersionAction:
-			return true, size
+			return action, size
 		}
 		// For all other lifecycle actions that remove data
-		return true, 0
+		return action, 0
 	}
 
-	return false, size
+	return lifecycle.NoneAction, size
 }
 
 // applyActions will apply lifecycle checks on to a scanned item.
 // The resulting size on disk will always be returned.
 // The metadata will be compared to consensus on the object layer before any changes are applied.
 // If no metadata is supplied, -1 is returned if no action is taken.
-func (i *scannerItem) applyActions(ctx context.Context, o ObjectLayer, meta actionMeta) int64 {
+// objDeleted is true if the lifecycle removed the scanned object or version.
+func (i *scannerItem) applyActions(ctx context.Context, o ObjectLayer, meta actionMeta) (objDeleted bool, size int64) {
 	applied, size := i.applyLifecycle(ctx, o, meta)
 	// For instance, an applied lifecycle means we remove/transitioned an object
 	// from the current deployment, which means we don't have to call healing
 	// routine even if we are asked to do via heal flag.
-	if !applied && i.heal {
-		size = i.applyHealing(ctx, o, meta)
+	switch applied {
+	case lifecycle.NoneAction:
+		if i.heal {
+			size = i.applyHealing(ctx, o, meta)
+		}
+	case lifecycle.TransitionAction, lifecycle.TransitionVersionAction:
+	default:
+		objDeleted = true
+	}
+	return objDeleted, size
+}
+
+// applyExpiredDeleteMarker applies the lifecycle rules again on the latest
+// delete marker of an object once all of its noncurrent versions were
+// removed during the scan, so that an expired object delete marker is
+// removed in the same cycle rather than the next one.
+func (i *scannerItem) applyExpiredDeleteMarker(ctx context.Context, o ObjectLayer, oi ObjectInfo) {
+	if i.lifeCycle == nil || !oi.DeleteMarker || !oi.IsLatest {
+		return
 	}
-	return size
+	// The marker is the only remaining version, which is verified
+	// against the object layer before it is removed.
+	oi.NumVersions = 1
+	i.applyLifecycle(ctx, o, actionMeta{oi: oi})
 }
 
 func evalActionFromLifecycle(ctx context.Context, lc lifecycle.Lifecycle, obj ObjectInfo, debug bool) (action lifecycle.Action) {
 	lcOpts := lifecycle.ObjectOpts{
 		Name:             obj.Name,
 		UserTags:         obj.UserTags,
+		Size:             obj.Size,
 		ModTime:          obj.ModTime,
 		VersionID:        obj.VersionID,
 		DeleteMarker:     obj.DeleteMarker,
@@ -958,6 +1011,8 @@ func evalActionFromLifecycle(ctx context.Context, lc lifecycle.Lifecycle, obj Ob
 		RestoreOngoing:   obj.RestoreOngoing,
 		RestoreExpires:   obj.RestoreExpires,
 		TransitionStatus: obj.TransitionStatus,
+		AccessTime:       globalAccessTimeTracker.AccessTime(obj),
+		ExpiresAt:        objectExpiresAt(obj),
 	}
 
 	action = lc.ComputeAction(lcOpts)
@@ -996,7 +1051,7 @@ func evalActionFromLifecycle(ctx context.Context, lc lifecycle.Lifecycle, obj Ob
 func applyTransitionAction(ctx context.Context, action lifecycle.Action, objLayer ObjectLayer, obj ObjectInfo) bool {
 	opts := ObjectOptions{}
 	if obj.TransitionStatus == "" {
-		opts.Versioned = globalBucketVersioningSys.Enabled(obj.Bucket)
+		opts.Versioned = globalBucketVersioningSys.PrefixEnabled(obj.Bucket, obj.Name)
 		opts.VersionID = obj.VersionID
 		opts.TransitionStatus = lifecycle.TransitionPending
 		if _, err := objLayer.DeleteObject(ctx, obj.Bucket, obj.Name, opts); err != nil {
@@ -1017,6 +1072,7 @@ func applyExpiryOnTransitionedObject(ctx context.Context, objLayer ObjectLayer,
 	lcOpts := lifecycle.ObjectOpts{
 		Name:             obj.Name,
 		UserTags:         obj.UserTags,
+		Size:             obj.Size,
 		ModTime:          obj.ModTime,
 		VersionID:        obj.VersionID,
 		DeleteMarker:     obj.DeleteMarker,
@@ -1046,7 +1102,7 @@ func applyExpiryOnNonTransitionedObjects(ctx context.Context, objLayer ObjectLay
 		opts.VersionID = obj.VersionID
 	}
 	if opts.VersionID == "" {
-		opts.Versioned = globalBucketVersioningSys.Enabled(obj.Bucket)
+		opts.Versioned = globalBucketVersioningSys.PrefixEnabled(obj.Bucket, obj.Name)
 	}
 
 	obj, err := objLayer.DeleteObject(ctx, obj.Bucket, obj.Name, opts)
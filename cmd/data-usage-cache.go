@@ -52,6 +52,16 @@ type dataUsageEntry struct {
 	Objects          uint64
 	ObjSizes         sizeHistogram
 	ReplicationStats replicationStats
+	// Object versions per storage class, and per remote
+	// tier for the versions transitioned to a tier.
+	StorageClasses map[string]tierStats
+	Tiers          map[string]tierStats
+}
+
+//msgp:tuple tierStats
+type tierStats struct {
+	Size    uint64
+	Objects uint64
 }
 
 //msgp:tuple replicationStats
@@ -90,7 +100,17 @@ type dataUsageEntryV3 struct {
 	Children               dataUsageHashMap
 }
 
-// dataUsageCache contains a cache of data usage entries latest version 4.
+//msgp:tuple dataUsageEntryV4
+type dataUsageEntryV4 struct {
+	Children dataUsageHashMap
+	// These fields do no include any children.
+	Size             int64
+	Objects          uint64
+	ObjSizes         sizeHistogram
+	ReplicationStats replicationStats
+}
+
+// dataUsageCache contains a cache of data usage entries latest version 5.
 type dataUsageCache struct {
 	Info  dataUsageCacheInfo
 	Cache map[string]dataUsageEntry
@@ -111,6 +131,13 @@ type dataUsageCacheV3 struct {
 	Cache map[string]dataUsageEntryV3
 }
 
+// dataUsageCache contains a cache of data usage entries version 4.
+type dataUsageCacheV4 struct {
+	Info  dataUsageCacheInfo
+	Cache map[string]dataUsageEntryV4
+	Disks []string
+}
+
 //msgp:ignore dataUsageEntryInfo
 type dataUsageEntryInfo struct {
 	Name   string
@@ -138,7 +165,8 @@ func (e *dataUsageEntry) addSizes(summary sizeSummary) {
 	e.ReplicationStats.ReplicaSize += uint64(summary.replicaSize)
 	e.ReplicationStats.PendingCount += uint64(summary.pendingCount)
 	e.ReplicationStats.FailedCount += uint64(summary.failedCount)
-
+	e.StorageClasses = mergeTierStats(e.StorageClasses, summary.storageClasses)
+	e.Tiers = mergeTierStats(e.Tiers, summary.tiers)
 }
 
 // merge other data usage entry into this, excluding children.
@@ -151,12 +179,51 @@ func (e *dataUsageEntry) merge(other dataUsageEntry) {
 	e.ReplicationStats.ReplicaSize += other.ReplicationStats.ReplicaSize
 	e.ReplicationStats.PendingCount += other.ReplicationStats.PendingCount
 	e.ReplicationStats.FailedCount += other.ReplicationStats.FailedCount
+	e.StorageClasses = mergeTierStats(e.StorageClasses, other.StorageClasses)
+	e.Tiers = mergeTierStats(e.Tiers, other.Tiers)
 
 	for i, v := range other.ObjSizes[:] {
 		e.ObjSizes[i] += v
 	}
 }
 
+// mergeTierStats returns the sum of the tier stats of a and b.
+// A new map is returned instead of updating a, since copies of
+// an entry share their maps.
+func mergeTierStats(a, b map[string]tierStats) map[string]tierStats {
+	if len(b) == 0 {
+		return a
+	}
+	merged := make(map[string]tierStats, len(a)+len(b))
+	for name, stats := range a {
+		merged[name] = stats
+	}
+	for name, stats := range b {
+		merged[name] = merged[name].add(stats)
+	}
+	return merged
+}
+
+// add returns the sum of both tier stats.
+func (t tierStats) add(other tierStats) tierStats {
+	return tierStats{
+		Size:    t.Size + other.Size,
+		Objects: t.Objects + other.Objects,
+	}
+}
+
+// tierStatsToMap converts tier stats to their admin API representation.
+func tierStatsToMap(stats map[string]tierStats) map[string]madmin.TierStats {
+	if len(stats) == 0 {
+		return nil
+	}
+	m := make(map[string]madmin.TierStats, len(stats))
+	for name, s := range stats {
+		m[name] = madmin.TierStats{Size: s.Size, ObjectsCount: s.Objects}
+	}
+	return m
+}
+
 // mod returns true if the hash mod cycles == cycle.
 // If cycles is 0 false is always returned.
 // If cycles is 1 true is always returned (as expected).
@@ -430,6 +497,8 @@ func (d *dataUsageCache) bucketsUsageInfo(buckets []BucketInfo) map[string]madmi
 			ReplicationFailedCount:  flat.ReplicationStats.FailedCount,
 			ReplicaSize:             flat.ReplicationStats.ReplicaSize,
 			ObjectSizesHistogram:    flat.ObjSizes.toMap(),
+			StorageClasses:          tierStatsToMap(flat.StorageClasses),
+			Tiers:                   tierStatsToMap(flat.Tiers),
 		}
 	}
 	return dst
@@ -453,6 +522,8 @@ func (d *dataUsageCache) bucketUsageInfo(bucket string) madmin.BucketUsageInfo {
 		ReplicationFailedCount:  flat.ReplicationStats.FailedCount,
 		ReplicaSize:             flat.ReplicationStats.ReplicaSize,
 		ObjectSizesHistogram:    flat.ObjSizes.toMap(),
+		StorageClasses:          tierStatsToMap(flat.StorageClasses),
+		Tiers:                   tierStatsToMap(flat.Tiers),
 	}
 }
 
@@ -576,6 +647,7 @@ func (d *dataUsageCache) save(ctx context.Context, store objectIO, name string)
 // Bumping the cache version will drop data from previous versions
 // and write new data with the new version.
 const (
+	dataUsageCacheVerV5 = 5
 	dataUsageCacheVerV4 = 4
 	dataUsageCacheVerV3 = 3
 	dataUsageCacheVerV2 = 2
@@ -585,7 +657,7 @@ const (
 // serialize the contents of the cache.
 func (d *dataUsageCache) serializeTo(dst io.Writer) error {
 	// Add version and compress.
-	_, err := dst.Write([]byte{dataUsageCacheVerV4})
+	_, err := dst.Write([]byte{dataUsageCacheVerV5})
 	if err != nil {
 		return err
 	}
@@ -682,6 +754,30 @@ func (d *dataUsageCache) deserialize(r io.Reader) error {
 			return err
 		}
 		defer dec.Close()
+		dold := &dataUsageCacheV4{}
+		if err = dold.DecodeMsg(msgp.NewReader(dec)); err != nil {
+			return err
+		}
+		d.Info = dold.Info
+		d.Disks = dold.Disks
+		d.Cache = make(map[string]dataUsageEntry, len(dold.Cache))
+		for k, v := range dold.Cache {
+			d.Cache[k] = dataUsageEntry{
+				Size:             v.Size,
+				Objects:          v.Objects,
+				ObjSizes:         v.ObjSizes,
+				Children:         v.Children,
+				ReplicationStats: v.ReplicationStats,
+			}
+		}
+		return nil
+	case dataUsageCacheVerV5:
+		// Zstd compressed.
+		dec, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(2))
+		if err != nil {
+			return err
+		}
+		defer dec.Close()
 
 		return d.DecodeMsg(msgp.NewReader(dec))
 	}
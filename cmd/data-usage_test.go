@@ -24,7 +24,10 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
 	"testing"
+
+	"github.com/minio/minio/pkg/madmin"
 )
 
 type usageTestFile struct {
@@ -691,3 +694,50 @@ func TestDataUsageCacheSerialize(t *testing.T) {
 	}
 
 }
+
+func TestDataUsageTierStats(t *testing.T) {
+	var cache dataUsageCache
+	hot := sizeSummary{}
+	hot.addVersion(context.Background(), ObjectInfo{StorageClass: "STANDARD"}, 100)
+	hot.addVersion(context.Background(), ObjectInfo{StorageClass: "REDUCED_REDUNDANCY"}, 10)
+	hot.addVersion(context.Background(), ObjectInfo{StorageClass: "STANDARD", DeleteMarker: true}, 0)
+	cold := sizeSummary{}
+	cold.addVersion(context.Background(), ObjectInfo{StorageClass: "STANDARD"}, 50)
+
+	var bucket, prefix dataUsageEntry
+	bucket.addSizes(hot)
+	prefix.addSizes(cold)
+	prefix.Tiers = map[string]tierStats{"WARM": {Size: 1000, Objects: 2}}
+	cache.replace("bucket", "", bucket)
+	cache.replace("bucket/prefix", "bucket", prefix)
+
+	got := cache.bucketUsageInfo("bucket")
+	if want := map[string]madmin.TierStats{
+		"STANDARD":           {Size: 150, ObjectsCount: 2},
+		"REDUCED_REDUNDANCY": {Size: 10, ObjectsCount: 1},
+	}; !reflect.DeepEqual(got.StorageClasses, want) {
+		t.Errorf("storage classes mismatch\nwant: %+v\ngot:  %+v", want, got.StorageClasses)
+	}
+	if want := map[string]madmin.TierStats{
+		"WARM": {Size: 1000, ObjectsCount: 2},
+	}; !reflect.DeepEqual(got.Tiers, want) {
+		t.Errorf("tiers mismatch\nwant: %+v\ngot:  %+v", want, got.Tiers)
+	}
+
+	// Flattening must not modify the cached entries.
+	if e := cache.find("bucket"); e.StorageClasses["STANDARD"].Size != 100 || len(e.Tiers) != 0 {
+		t.Errorf("cached entry modified: %+v", e)
+	}
+
+	var buf bytes.Buffer
+	if err := cache.serializeTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var deserialized dataUsageCache
+	if err := deserialized.deserialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deserialized.bucketUsageInfo("bucket"), got) {
+		t.Errorf("deserialize mismatch\nwant: %+v\ngot:  %+v", got, deserialized.bucketUsageInfo("bucket"))
+	}
+}
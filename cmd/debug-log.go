@@ -0,0 +1,127 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// Subsystems whose debug logging can be toggled at runtime.
+const (
+	debugLogIAM         = "iam"
+	debugLogReplication = "replication"
+	debugLogHeal        = "heal"
+	debugLogStorage     = "storage"
+	debugLogScanner     = "scanner"
+	debugLogListing     = "listing"
+)
+
+var (
+	errInvalidLogSubsystem = errors.New("invalid log subsystem")
+	errInvalidLogLevel     = errors.New("invalid log level")
+)
+
+// debugLogSys holds the log level of every subsystem on this server.
+type debugLogSys struct {
+	// levels is never modified after creation,
+	// only the values it points to are.
+	levels map[string]*int32
+}
+
+// globalDebugLog starts with debug logging of all
+// subsystems enabled when _MINIO_SERVER_DEBUG is on.
+var globalDebugLog = newDebugLogSys(serverDebugLog)
+
+func newDebugLogSys(debug bool) *debugLogSys {
+	d := &debugLogSys{levels: make(map[string]*int32)}
+	for _, subsys := range []string{
+		debugLogIAM,
+		debugLogReplication,
+		debugLogHeal,
+		debugLogStorage,
+		debugLogScanner,
+		debugLogListing,
+	} {
+		var level int32
+		if debug {
+			level = 1
+		}
+		d.levels[subsys] = &level
+	}
+	return d
+}
+
+// enabled returns whether debug logging is enabled for the subsystem.
+func (d *debugLogSys) enabled(subsys string) bool {
+	level, ok := d.levels[subsys]
+	return ok && atomic.LoadInt32(level) == 1
+}
+
+// validate returns an error if the log level or subsystem is invalid,
+// an empty subsys being valid as all subsystems.
+func (d *debugLogSys) validate(subsys string, level madmin.LogLevel) error {
+	if level != madmin.LogLevelDebug && level != madmin.LogLevelInfo {
+		return errInvalidLogLevel
+	}
+	if _, ok := d.levels[subsys]; !ok && subsys != "" {
+		return errInvalidLogSubsystem
+	}
+	return nil
+}
+
+// setLevel sets the log level of a subsystem, or of all
+// subsystems when subsys is empty.
+func (d *debugLogSys) setLevel(subsys string, level madmin.LogLevel) error {
+	if err := d.validate(subsys, level); err != nil {
+		return err
+	}
+	var v int32
+	if level == madmin.LogLevelDebug {
+		v = 1
+	}
+	for name, l := range d.levels {
+		if subsys == "" || subsys == name {
+			atomic.StoreInt32(l, v)
+		}
+	}
+	return nil
+}
+
+// getLevels returns the log level of every subsystem on this server.
+func (d *debugLogSys) getLevels() madmin.NodeLogLevels {
+	levels := madmin.NodeLogLevels{
+		Node:       globalLocalNodeName,
+		Subsystems: make(map[string]madmin.LogLevel, len(d.levels)),
+	}
+	for subsys := range d.levels {
+		levels.Subsystems[subsys] = madmin.LogLevelInfo
+		if d.enabled(subsys) {
+			levels.Subsystems[subsys] = madmin.LogLevelDebug
+		}
+	}
+	return levels
+}
+
+// debugLogEnabled returns whether debug logging is
+// currently enabled for the subsystem on this server.
+func debugLogEnabled(subsys string) bool {
+	return globalDebugLog.enabled(subsys)
+}
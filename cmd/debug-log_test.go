@@ -0,0 +1,64 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestDebugLogSetLevel(t *testing.T) {
+	d := newDebugLogSys(false)
+	if d.enabled(debugLogHeal) {
+		t.Fatal("expected debug logging to be disabled")
+	}
+
+	if err := d.setLevel(debugLogHeal, madmin.LogLevelDebug); err != nil {
+		t.Fatal(err)
+	}
+	if !d.enabled(debugLogHeal) || d.enabled(debugLogIAM) {
+		t.Fatal("expected debug logging to be enabled for heal only")
+	}
+
+	if err := d.setLevel("", madmin.LogLevelDebug); err != nil {
+		t.Fatal(err)
+	}
+	for subsys, level := range d.getLevels().Subsystems {
+		if level != madmin.LogLevelDebug {
+			t.Errorf("%s: expected %s, got %s", subsys, madmin.LogLevelDebug, level)
+		}
+	}
+
+	if err := d.setLevel(debugLogHeal, madmin.LogLevelInfo); err != nil {
+		t.Fatal(err)
+	}
+	if d.enabled(debugLogHeal) || !d.enabled(debugLogIAM) {
+		t.Fatal("expected debug logging to be disabled for heal only")
+	}
+
+	if err := d.setLevel("unknown", madmin.LogLevelDebug); err != errInvalidLogSubsystem {
+		t.Errorf("expected %v, got %v", errInvalidLogSubsystem, err)
+	}
+	if err := d.setLevel(debugLogHeal, "trace"); err != errInvalidLogLevel {
+		t.Errorf("expected %v, got %v", errInvalidLogLevel, err)
+	}
+	if d.enabled("unknown") {
+		t.Error("expected unknown subsystem to be disabled")
+	}
+}
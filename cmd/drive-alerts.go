@@ -0,0 +1,206 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/config/drivealert"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/event"
+	"github.com/minio/minio/pkg/madmin"
+	"github.com/minio/minio/pkg/smart"
+)
+
+// How often the configuration is checked while drive
+// alerts are disabled.
+const driveAlertDisabledInterval = time.Minute
+
+var (
+	globalDriveAlertConfig   drivealert.Config
+	globalDriveAlertConfigMu sync.RWMutex
+)
+
+func getDriveAlertConfig() drivealert.Config {
+	globalDriveAlertConfigMu.RLock()
+	defer globalDriveAlertConfigMu.RUnlock()
+	return globalDriveAlertConfig
+}
+
+// driveAlertState is what is remembered of a drive between two checks.
+type driveAlertState struct {
+	errors      uint64
+	bitrot      uint64
+	mediaErrors uint64
+	smartKnown  bool
+	lastAlert   time.Time
+}
+
+// initDriveAlerts starts checking the local drives for signs of
+// upcoming failures, as set in the drive alert configuration.
+func initDriveAlerts(ctx context.Context, objAPI ObjectLayer) {
+	go runDriveAlerts(ctx, objAPI)
+}
+
+func runDriveAlerts(ctx context.Context, objAPI ObjectLayer) {
+	states := make(map[string]*driveAlertState)
+
+	timer := time.NewTimer(driveAlertDisabledInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			cfg := getDriveAlertConfig()
+			if !cfg.Enabled {
+				timer.Reset(driveAlertDisabledInterval)
+				continue
+			}
+			checkDrives(ctx, objAPI, cfg, states)
+			timer.Reset(cfg.Interval)
+		}
+	}
+}
+
+// checkDrives raises an alert for each local drive crossing one of
+// the thresholds since the last check, unless an alert was raised
+// for the drive during the cooldown.
+func checkDrives(ctx context.Context, objAPI ObjectLayer, cfg drivealert.Config, states map[string]*driveAlertState) {
+	storageInfo, _ := objAPI.LocalStorageInfo(ctx)
+	now := UTCNow()
+	for _, disk := range storageInfo.Disks {
+		if disk.Metrics == nil {
+			continue
+		}
+		st, ok := states[disk.Endpoint]
+		if !ok {
+			st = &driveAlertState{}
+			states[disk.Endpoint] = st
+		}
+
+		var smartInfo *smart.Info
+		if cfg.SMART {
+			info, err := getDriveSMARTInfo(ctx, disk.DrivePath)
+			if err == nil {
+				smartInfo = &info
+			} else {
+				logger.LogOnceIf(ctx, fmt.Errorf("Unable to read S.M.A.R.T. data of drive %s: %w", disk.Endpoint, err), "drive-alert-smart-"+disk.Endpoint)
+			}
+		}
+
+		reasons := driveAlertReasons(cfg, st, *disk.Metrics, smartInfo)
+		if len(reasons) == 0 || (!st.lastAlert.IsZero() && now.Sub(st.lastAlert) < cfg.Cooldown) {
+			continue
+		}
+		st.lastAlert = now
+		sendDriveAlert(ctx, cfg, disk, reasons, now)
+	}
+}
+
+// countSince returns the increase of a counter, which starts over
+// when the drive is replaced.
+func countSince(cur, prev uint64) uint64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
+
+// driveAlertReasons returns why the drive is likely to fail and
+// remembers the state of the drive for the next check.
+func driveAlertReasons(cfg drivealert.Config, st *driveAlertState, m madmin.DiskMetrics, smartInfo *smart.Info) (reasons []string) {
+	errs := countSince(m.TotalErrors, st.errors)
+	bitrot := countSince(m.BitrotErrors, st.bitrot)
+	st.errors, st.bitrot = m.TotalErrors, m.BitrotErrors
+
+	// Bitrot errors are also I/O errors, they are only
+	// reported once.
+	if cfg.BitrotThreshold > 0 && bitrot >= cfg.BitrotThreshold {
+		reasons = append(reasons, fmt.Sprintf("%d bitrot errors", bitrot))
+	} else if cfg.ErrorThreshold > 0 && errs >= cfg.ErrorThreshold {
+		reasons = append(reasons, fmt.Sprintf("%d I/O errors", errs))
+	}
+
+	if smartInfo == nil || smartInfo.Nvme == nil {
+		return reasons
+	}
+	nvme := smartInfo.Nvme
+	if nvme.CriticalWarning != "" && nvme.CriticalWarning != "0" {
+		reasons = append(reasons, "S.M.A.R.T. critical warning 0x"+nvme.CriticalWarning)
+	}
+	var spare, spareThreshold int
+	if _, err := fmt.Sscanf(nvme.SpareAvailable, "%d%%", &spare); err == nil {
+		if _, err = fmt.Sscanf(nvme.SpareThreshold, "%d%%", &spareThreshold); err == nil && spare < spareThreshold {
+			reasons = append(reasons, fmt.Sprintf("available spare %s below threshold %s", nvme.SpareAvailable, nvme.SpareThreshold))
+		}
+	}
+	if nvme.MediaAndDataIntegrityErrors != nil {
+		mediaErrors := nvme.MediaAndDataIntegrityErrors.Uint64()
+		// The errors which happened before the server started
+		// were reported by the previous servers.
+		if st.smartKnown && mediaErrors > st.mediaErrors {
+			reasons = append(reasons, fmt.Sprintf("%d media and data integrity errors", mediaErrors-st.mediaErrors))
+		}
+		st.mediaErrors, st.smartKnown = mediaErrors, true
+	}
+	return reasons
+}
+
+// sendDriveAlert logs the alert and sends it to the notification
+// targets of the configuration.
+func sendDriveAlert(ctx context.Context, cfg drivealert.Config, disk madmin.Disk, reasons []string, now time.Time) {
+	reason := strings.Join(reasons, ", ")
+	logger.LogIf(ctx, fmt.Errorf("Drive %s is likely to fail: %s", disk.Endpoint, reason))
+
+	respElements := map[string]string{
+		"x-minio-origin-endpoint": globalMinioEndpoint,
+		"x-minio-drive":           disk.Endpoint,
+		"x-minio-drive-path":      disk.DrivePath,
+		"x-minio-drive-alert":     reason,
+	}
+	if globalDeploymentID != "" {
+		respElements["x-minio-deployment-id"] = globalDeploymentID
+	}
+	globalNotificationSys.SendToTargets(event.Event{
+		EventVersion:      "2.0",
+		EventSource:       "minio:drive",
+		AwsRegion:         globalServerRegion,
+		EventTime:         now.Format(event.AMZTimeFormat),
+		EventName:         event.DriveFailurePredicted,
+		RequestParameters: map[string]string{},
+		ResponseElements:  respElements,
+		S3: event.Metadata{
+			SchemaVersion:   "1.0",
+			ConfigurationID: "Config",
+			Object: event.Object{
+				Key:       url.QueryEscape(disk.Endpoint),
+				Sequencer: fmt.Sprintf("%X", now.UnixNano()),
+			},
+		},
+		Source: event.Source{
+			Host: globalLocalNodeName,
+		},
+	}, cfg.Targets...)
+}
@@ -0,0 +1,52 @@
+// +build linux
+
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/minio/minio/pkg/smart"
+	diskhw "github.com/shirou/gopsutil/v3/disk"
+)
+
+// getDriveSMARTInfo returns the S.M.A.R.T. data of the device
+// mounted at drivePath.
+func getDriveSMARTInfo(ctx context.Context, drivePath string) (smart.Info, error) {
+	parts, err := diskhw.PartitionsWithContext(ctx, false)
+	if err != nil {
+		return smart.Info{}, err
+	}
+
+	var device, mountpoint string
+	for _, part := range parts {
+		if !strings.HasPrefix(part.Device, "/dev/") || len(part.Mountpoint) <= len(mountpoint) {
+			continue
+		}
+		if drivePath == part.Mountpoint || strings.HasPrefix(drivePath, strings.TrimSuffix(part.Mountpoint, "/")+"/") {
+			device, mountpoint = part.Device, part.Mountpoint
+		}
+	}
+	if device == "" {
+		return smart.Info{}, errors.New("no device found")
+	}
+	return smart.GetInfo(device)
+}
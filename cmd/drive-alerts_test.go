@@ -0,0 +1,73 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/minio/minio/cmd/config/drivealert"
+	"github.com/minio/minio/pkg/madmin"
+	"github.com/minio/minio/pkg/smart"
+)
+
+func TestDriveAlertReasons(t *testing.T) {
+	cfg := drivealert.Config{ErrorThreshold: 10, BitrotThreshold: 1, SMART: true}
+	nvme := func(warning, spare string, mediaErrors int64) *smart.Info {
+		return &smart.Info{Nvme: &smart.NvmeInfo{
+			CriticalWarning:             warning,
+			SpareAvailable:              spare,
+			SpareThreshold:              "10%",
+			MediaAndDataIntegrityErrors: big.NewInt(mediaErrors),
+		}}
+	}
+
+	st := &driveAlertState{}
+	testCases := []struct {
+		metrics madmin.DiskMetrics
+		smart   *smart.Info
+		reasons int
+	}{
+		// Errors below the threshold.
+		{madmin.DiskMetrics{TotalErrors: 9}, nil, 0},
+		// Only the errors since the last check are counted.
+		{madmin.DiskMetrics{TotalErrors: 18}, nil, 0},
+		{madmin.DiskMetrics{TotalErrors: 28}, nil, 1},
+		// Bitrot errors are reported once.
+		{madmin.DiskMetrics{TotalErrors: 40, BitrotErrors: 12}, nil, 1},
+		// The counters started over.
+		{madmin.DiskMetrics{TotalErrors: 3}, nil, 0},
+		// Media errors before the first check are ignored.
+		{madmin.DiskMetrics{TotalErrors: 3}, nvme("0", "100%", 5), 0},
+		{madmin.DiskMetrics{TotalErrors: 3}, nvme("0", "100%", 6), 1},
+		{madmin.DiskMetrics{TotalErrors: 3}, nvme("", "9%", 6), 1},
+		{madmin.DiskMetrics{TotalErrors: 3}, nvme("4", "9%", 7), 3},
+	}
+	for i, testCase := range testCases {
+		reasons := driveAlertReasons(cfg, st, testCase.metrics, testCase.smart)
+		if len(reasons) != testCase.reasons {
+			t.Errorf("Test %d: expected %d reasons, got %v", i+1, testCase.reasons, reasons)
+		}
+	}
+
+	// A threshold of zero disables the alert.
+	cfg.ErrorThreshold, cfg.BitrotThreshold = 0, 0
+	if reasons := driveAlertReasons(cfg, &driveAlertState{}, madmin.DiskMetrics{TotalErrors: 100, BitrotErrors: 100}, nil); len(reasons) != 0 {
+		t.Errorf("expected no reasons with disabled thresholds, got %v", reasons)
+	}
+}
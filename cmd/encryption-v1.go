@@ -166,6 +166,38 @@ func rotateKey(oldKey []byte, newKey []byte, bucket, object string, metadata map
 	}
 }
 
+// unsealS3ObjectKey unseals the object key of an SSE-S3 object with
+// GlobalKMS, or with the KMS configured before it if that fails.
+func unsealS3ObjectKey(metadata map[string]string, bucket, object string) (crypto.ObjectKey, error) {
+	if GlobalKMS == nil {
+		return crypto.ObjectKey{}, errKMSNotConfigured
+	}
+	objectKey, err := crypto.S3.UnsealObjectKey(GlobalKMS, metadata, bucket, object)
+	if err != nil && globalOldKMS != nil {
+		if key, oldErr := crypto.S3.UnsealObjectKey(globalOldKMS, metadata, bucket, object); oldErr == nil {
+			return key, nil
+		}
+	}
+	return objectKey, err
+}
+
+// resealS3ObjectKey seals the object key of an SSE-S3 object again
+// with a new data key of the given KMS key, updating metadata. The
+// object data, encrypted with the object key, is left untouched.
+func resealS3ObjectKey(keyID, bucket, object string, metadata map[string]string) error {
+	objectKey, err := unsealS3ObjectKey(metadata, bucket, object)
+	if err != nil {
+		return err
+	}
+	newKey, err := GlobalKMS.GenerateKey(keyID, crypto.Context{bucket: path.Join(bucket, object)})
+	if err != nil {
+		return err
+	}
+	sealedKey := objectKey.Seal(newKey.Plaintext, crypto.GenerateIV(rand.Reader), crypto.S3.String(), bucket, object)
+	crypto.S3.CreateMetadata(metadata, newKey.KeyID, newKey.Ciphertext, sealedKey)
+	return nil
+}
+
 func newEncryptMetadata(key []byte, bucket, object string, metadata map[string]string, sseS3 bool) (crypto.ObjectKey, error) {
 	var sealedKey crypto.SealedKey
 	if sseS3 {
@@ -245,14 +277,17 @@ func EncryptRequest(content io.Reader, r *http.Request, bucket, object string, m
 func decryptObjectInfo(key []byte, bucket, object string, metadata map[string]string) ([]byte, error) {
 	switch kind, _ := crypto.IsEncrypted(metadata); kind {
 	case crypto.S3:
-		var KMS crypto.KMS = GlobalKMS
-		if isCacheEncrypted(metadata) {
-			KMS = globalCacheKMS
+		if !isCacheEncrypted(metadata) {
+			objectKey, err := unsealS3ObjectKey(metadata, bucket, object)
+			if err != nil {
+				return nil, err
+			}
+			return objectKey[:], nil
 		}
-		if KMS == nil {
+		if globalCacheKMS == nil {
 			return nil, errKMSNotConfigured
 		}
-		objectKey, err := crypto.S3.UnsealObjectKey(KMS, metadata, bucket, object)
+		objectKey, err := crypto.S3.UnsealObjectKey(globalCacheKMS, metadata, bucket, object)
 		if err != nil {
 			return nil, err
 		}
@@ -26,6 +26,7 @@ import (
 	"github.com/minio/minio-go/v7/pkg/encrypt"
 	"github.com/minio/minio/cmd/crypto"
 	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/pkg/kms"
 	"github.com/minio/sio"
 )
 
@@ -622,3 +623,53 @@ func TestGetDefaultOpts(t *testing.T) {
 		}
 	}
 }
+
+func TestResealS3ObjectKey(t *testing.T) {
+	defer func(globalKMS, globalOld kms.KMS) {
+		GlobalKMS, globalOldKMS = globalKMS, globalOld
+	}(GlobalKMS, globalOldKMS)
+
+	oldKMS, err := kms.New("old-key", bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKMS, err := kms.New("new-key", bytes.Repeat([]byte{2}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const bucket, object = "bucket", "object"
+	GlobalKMS, globalOldKMS = oldKMS, nil
+	metadata := make(map[string]string)
+	objectKey, err := newEncryptMetadata(nil, bucket, object, metadata, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Object keys sealed before switching KMS are
+	// only readable while the old KMS is configured.
+	GlobalKMS = newKMS
+	if _, err = unsealS3ObjectKey(metadata, bucket, object); err == nil {
+		t.Fatal("expected unsealing with the new KMS to fail")
+	}
+	globalOldKMS = oldKMS
+	if err = resealS3ObjectKey("new-key", bucket, object, metadata); err != nil {
+		t.Fatal(err)
+	}
+
+	globalOldKMS = nil
+	keyID, _, _, err := crypto.S3.ParseMetadata(metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keyID != "new-key" {
+		t.Errorf("expected key ID %q, got %q", "new-key", keyID)
+	}
+	key, err := unsealS3ObjectKey(metadata, bucket, object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != objectKey {
+		t.Error("object key changed when sealed again")
+	}
+}
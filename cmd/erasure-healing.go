@@ -437,10 +437,10 @@ func (er erasureObjects) healObject(ctx context.Context, bucket string, object s
 				partPath := pathJoin(tmpID, dstDataDir, fmt.Sprintf("part.%d", partNumber))
 				if len(inlineBuffers) > 0 {
 					inlineBuffers[i] = bytes.NewBuffer(make([]byte, 0, erasure.ShardFileSize(latestMeta.Size)))
-					writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], DefaultBitrotAlgorithm, erasure.ShardSize())
+					writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], checksumAlgo, erasure.ShardSize())
 				} else {
 					writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, partPath,
-						tillOffset, DefaultBitrotAlgorithm, erasure.ShardSize(), true)
+						tillOffset, checksumAlgo, erasure.ShardSize(), true)
 				}
 			}
 			err = erasure.Heal(ctx, readers, writers, partSize)
@@ -146,6 +146,7 @@ func (er erasureObjects) deleteAll(ctx context.Context, bucket, prefix string) {
 func (er erasureObjects) cleanupStaleUploadsOnDisk(ctx context.Context, disk StorageAPI, expiry time.Duration) {
 	now := time.Now()
 	diskPath := disk.Endpoint().Path
+	multipartCfg := getMultipartConfig()
 
 	readDirFn(pathJoin(diskPath, minioMetaMultipartBucket), func(shaDir string, typ os.FileMode) error {
 		return readDirFn(pathJoin(diskPath, minioMetaMultipartBucket, shaDir), func(uploadIDDir string, typ os.FileMode) error {
@@ -154,8 +155,9 @@ func (er erasureObjects) cleanupStaleUploadsOnDisk(ctx context.Context, disk Sto
 			if err != nil {
 				return nil
 			}
+			bucket, _ := path2BucketObject(fi.Metadata[multipartObjectKey])
 			wait := er.deletedCleanupSleeper.Timer(ctx)
-			if now.Sub(fi.ModTime) > expiry {
+			if multipartCfg.Expired(bucket, fi.ModTime, now) {
 				er.renameAll(ctx, minioMetaMultipartBucket, uploadIDPath)
 			}
 			wait()
@@ -325,6 +327,14 @@ func (er erasureObjects) newMultipartUpload(ctx context.Context, bucket string,
 
 	onlineDisks, partsMetadata = shuffleDisksAndPartsMetadata(onlineDisks, partsMetadata, fi)
 
+	// Record the object and the initiator of the upload, so that
+	// the uploads of all buckets can be listed.
+	opts.UserDefined[multipartObjectKey] = pathJoin(bucket, object)
+	if accessKey := logger.GetReqInfo(ctx).AccessKey; accessKey != "" {
+		opts.UserDefined[multipartInitiatorKey] = accessKey
+	}
+	opts.UserDefined[multipartBitrotKey] = configuredBitrotAlgorithm().String()
+
 	// Fill all the necessary metadata.
 	// Update `xl.meta` content on each disks.
 	for index := range partsMetadata {
@@ -477,13 +487,20 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 	if len(buffer) > int(fi.Erasure.BlockSize) {
 		buffer = buffer[:fi.Erasure.BlockSize]
 	}
+	// Uploads started before the bitrot algorithm was
+	// recorded use the default one.
+	bitrotAlgo := BitrotAlgorithmFromString(fi.Metadata[multipartBitrotKey])
+	if !bitrotAlgo.Available() {
+		bitrotAlgo = DefaultBitrotAlgorithm
+	}
+
 	writers := make([]io.Writer, len(onlineDisks))
 	for i, disk := range onlineDisks {
 		if disk == nil {
 			continue
 		}
 		writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, tmpPartPath,
-			erasure.ShardFileSize(data.Size()), DefaultBitrotAlgorithm, erasure.ShardSize(), false)
+			erasure.ShardFileSize(data.Size()), bitrotAlgo, erasure.ShardSize(), false)
 	}
 
 	n, err := erasure.Encode(ctx, data, writers, buffer, writeQuorum)
@@ -559,7 +576,7 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 		partsMetadata[i].Parts = fi.Parts
 		partsMetadata[i].Erasure.AddChecksumInfo(ChecksumInfo{
 			PartNumber: partID,
-			Algorithm:  DefaultBitrotAlgorithm,
+			Algorithm:  bitrotAlgo,
 			Hash:       bitrotWriterSum(writers[i]),
 		})
 	}
@@ -862,6 +879,11 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 	// Save the consolidated actual size.
 	fi.Metadata[ReservedMetadataPrefix+"actual-size"] = strconv.FormatInt(objectActualSize, 10)
 
+	// Only needed while the upload is in progress.
+	delete(fi.Metadata, multipartObjectKey)
+	delete(fi.Metadata, multipartInitiatorKey)
+	delete(fi.Metadata, multipartBitrotKey)
+
 	// Update all erasure metadata, make sure to not modify fields like
 	// checksum which are different on each disks.
 	for index := range partsMetadata {
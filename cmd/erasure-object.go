@@ -712,6 +712,7 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 			inlineBuffers = make([]*bytes.Buffer, len(onlineDisks))
 		}
 	}
+	bitrotAlgo := configuredBitrotAlgorithm()
 	for i, disk := range onlineDisks {
 		if disk == nil {
 			continue
@@ -719,11 +720,11 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 
 		if len(inlineBuffers) > 0 {
 			inlineBuffers[i] = bytes.NewBuffer(make([]byte, 0, shardFileSize))
-			writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], DefaultBitrotAlgorithm, erasure.ShardSize())
+			writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], bitrotAlgo, erasure.ShardSize())
 			continue
 		}
 		writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, tempErasureObj,
-			shardFileSize, DefaultBitrotAlgorithm, erasure.ShardSize(), false)
+			shardFileSize, bitrotAlgo, erasure.ShardSize(), false)
 	}
 
 	n, erasureErr := erasure.Encode(ctx, data, writers, buffer, writeQuorum)
@@ -761,7 +762,7 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		partsMetadata[i].AddObjectPart(1, "", n, data.ActualSize())
 		partsMetadata[i].Erasure.AddChecksumInfo(ChecksumInfo{
 			PartNumber: 1,
-			Algorithm:  DefaultBitrotAlgorithm,
+			Algorithm:  bitrotAlgo,
 			Hash:       bitrotWriterSum(w),
 		})
 	}
@@ -926,7 +927,14 @@ func (er erasureObjects) DeleteObjects(ctx context.Context, bucket string, objec
 			if uuid == "" {
 				uuid = mustGetUUID()
 			}
-			if opts.Versioned || opts.VersionSuspended {
+			versioned, suspended := opts.Versioned, opts.VersionSuspended
+			if opts.PrefixEnabledFn != nil {
+				// Objects under excluded prefixes behave
+				// as if versioning was suspended.
+				versioned = opts.PrefixEnabledFn(objects[i].ObjectName)
+				suspended = suspended || (opts.Versioned && !versioned)
+			}
+			if versioned || suspended {
 				versions[i] = FileInfo{
 					Name:                          objects[i].ObjectName,
 					ModTime:                       modTime,
@@ -934,7 +942,7 @@ func (er erasureObjects) DeleteObjects(ctx context.Context, bucket string, objec
 					DeleteMarkerReplicationStatus: objects[i].DeleteMarkerReplicationStatus,
 					VersionPurgeStatus:            objects[i].VersionPurgeStatus,
 				}
-				if opts.Versioned {
+				if versioned {
 					versions[i].VersionID = uuid
 				}
 				continue
@@ -171,10 +171,29 @@ func (p serverPoolsAvailableSpace) TotalAvailable() uint64 {
 	return total
 }
 
+// poolInMaintenance - returns true if the pool is in maintenance mode.
+func (z *erasureServerPools) poolInMaintenance(idx int) bool {
+	return globalMaintenanceSys != nil && globalMaintenanceSys.PoolInMaintenance(idx)
+}
+
+// checkPoolWritable - returns an error if the objects of the pool
+// cannot be modified since it is in maintenance mode. Writes to the
+// meta buckets are always allowed, the server cannot function (nor
+// leave maintenance mode) without them.
+func (z *erasureServerPools) checkPoolWritable(bucket string, idx int) error {
+	if isMinioMetaBucketName(bucket) {
+		return nil
+	}
+	if z.poolInMaintenance(idx) {
+		return PoolInMaintenance{Pool: idx}
+	}
+	return nil
+}
+
 // getAvailablePoolIdx will return an index that can hold size bytes.
 // -1 is returned if no serverPools have available space for the size given.
-func (z *erasureServerPools) getAvailablePoolIdx(ctx context.Context, size int64) int {
-	serverPools := z.getServerPoolsAvailableSpace(ctx, size)
+func (z *erasureServerPools) getAvailablePoolIdx(ctx context.Context, bucket string, size int64) int {
+	serverPools := z.getServerPoolsAvailableSpace(ctx, bucket, size)
 	total := serverPools.TotalAvailable()
 	if total == 0 {
 		return -1
@@ -196,7 +215,7 @@ func (z *erasureServerPools) getAvailablePoolIdx(ctx context.Context, size int64
 // getServerPoolsAvailableSpace will return the available space of each pool after storing the content.
 // If there is not enough space the pool will return 0 bytes available.
 // Negative sizes are seen as 0 bytes.
-func (z *erasureServerPools) getServerPoolsAvailableSpace(ctx context.Context, size int64) serverPoolsAvailableSpace {
+func (z *erasureServerPools) getServerPoolsAvailableSpace(ctx context.Context, bucket string, size int64) serverPoolsAvailableSpace {
 	if size < 0 {
 		size = 0
 	}
@@ -226,6 +245,10 @@ func (z *erasureServerPools) getServerPoolsAvailableSpace(ctx context.Context, s
 		if available < uint64(size) {
 			available = 0
 		}
+		// Pools in maintenance mode receive no new objects.
+		if z.checkPoolWritable(bucket, i) != nil {
+			available = 0
+		}
 		if available > 0 {
 			// How much will be left after adding the file.
 			available -= -uint64(size)
@@ -316,18 +339,18 @@ func (z *erasureServerPools) getPoolIdx(ctx context.Context, bucket, object stri
 			// No object exists or its a delete marker,
 			// check objInfo to confirm.
 			if objInfos[i].DeleteMarker && objInfos[i].Name != "" {
-				return i, nil
+				return i, z.checkPoolWritable(bucket, i)
 			}
 			// objInfo is not valid, truly the object doesn't
 			// exist proceed to next pool.
 			continue
 		}
 		// object exists at this pool.
-		return i, nil
+		return i, z.checkPoolWritable(bucket, i)
 	}
 
 	// We multiply the size by 2 to account for erasure coding.
-	idx = z.getAvailablePoolIdx(ctx, size*2)
+	idx = z.getAvailablePoolIdx(ctx, bucket, size*2)
 	if idx < 0 {
 		return -1, toObjectErr(errDiskFull)
 	}
@@ -591,6 +614,14 @@ func (z *erasureServerPools) MakeBucketWithLocation(ctx context.Context, bucket
 }
 
 func (z *erasureServerPools) GetObjectNInfo(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, h http.Header, lockType LockType, opts ObjectOptions) (gr *GetObjectReader, err error) {
+	defer func(ctx context.Context, start time.Time) {
+		logger.AddRequestTiming(ctx, logger.TimingObjectLayer, start)
+		if gr != nil {
+			// Reading the object is accounted to the object layer as well.
+			gr.pReader = logger.NewRequestTimingReader(ctx, gr.pReader, logger.TimingObjectLayer)
+		}
+	}(ctx, time.Now())
+
 	if err = checkGetObjArgs(ctx, bucket, object); err != nil {
 		return nil, err
 	}
@@ -671,6 +702,8 @@ func (z *erasureServerPools) GetObjectNInfo(ctx context.Context, bucket, object
 }
 
 func (z *erasureServerPools) GetObjectInfo(ctx context.Context, bucket, object string, opts ObjectOptions) (objInfo ObjectInfo, err error) {
+	defer logger.AddRequestTiming(ctx, logger.TimingObjectLayer, time.Now())
+
 	if err = checkGetObjArgs(ctx, bucket, object); err != nil {
 		return objInfo, err
 	}
@@ -729,6 +762,8 @@ func (z *erasureServerPools) GetObjectInfo(ctx context.Context, bucket, object s
 
 // PutObject - writes an object to least used erasure pool.
 func (z *erasureServerPools) PutObject(ctx context.Context, bucket string, object string, data *PutObjReader, opts ObjectOptions) (ObjectInfo, error) {
+	defer logger.AddRequestTiming(ctx, logger.TimingObjectLayer, time.Now())
+
 	// Validate put object input args.
 	if err := checkPutObjectArgs(ctx, bucket, object, z); err != nil {
 		return ObjectInfo{}, err
@@ -750,6 +785,8 @@ func (z *erasureServerPools) PutObject(ctx context.Context, bucket string, objec
 }
 
 func (z *erasureServerPools) DeleteObject(ctx context.Context, bucket string, object string, opts ObjectOptions) (objInfo ObjectInfo, err error) {
+	defer logger.AddRequestTiming(ctx, logger.TimingObjectLayer, time.Now())
+
 	if err = checkDelObjArgs(ctx, bucket, object); err != nil {
 		return objInfo, err
 	}
@@ -763,11 +800,16 @@ func (z *erasureServerPools) DeleteObject(ctx context.Context, bucket string, ob
 	if err != nil {
 		return objInfo, err
 	}
+	if err = z.checkPoolWritable(bucket, idx); err != nil {
+		return objInfo, err
+	}
 
 	return z.serverPools[idx].DeleteObject(ctx, bucket, object, opts)
 }
 
 func (z *erasureServerPools) DeleteObjects(ctx context.Context, bucket string, objects []ObjectToDelete, opts ObjectOptions) ([]DeletedObject, []error) {
+	defer logger.AddRequestTiming(ctx, logger.TimingObjectLayer, time.Now())
+
 	derrs := make([]error, len(objects))
 	dobjects := make([]DeletedObject, len(objects))
 	objSets := set.NewStringSet()
@@ -794,6 +836,10 @@ func (z *erasureServerPools) DeleteObjects(ctx context.Context, bucket string, o
 				}
 				return dobjects, derrs
 			}
+			if err = z.checkPoolWritable(bucket, idx); err != nil {
+				derrs[j] = err
+				continue
+			}
 			poolObjIdxMap[idx] = append(poolObjIdxMap[idx], obj)
 			origIndexMap[idx] = append(origIndexMap[idx], j)
 		}
@@ -815,21 +861,32 @@ func (z *erasureServerPools) DeleteObjects(ctx context.Context, bucket string, o
 		return z.serverPools[0].DeleteObjects(ctx, bucket, objects, opts)
 	}
 
+	var wg sync.WaitGroup
 	for idx, pool := range z.serverPools {
 		objs := poolObjIdxMap[idx]
-		orgIndexes := origIndexMap[idx]
-		deletedObjects, errs := pool.DeleteObjects(ctx, bucket, objs, opts)
-		for i, derr := range errs {
-			if derr != nil {
-				derrs[orgIndexes[i]] = derr
-			}
-			dobjects[orgIndexes[i]] = deletedObjects[i]
+		if len(objs) == 0 {
+			continue
 		}
+		wg.Add(1)
+		go func(idx int, pool *erasureSets) {
+			defer wg.Done()
+			orgIndexes := origIndexMap[idx]
+			deletedObjects, errs := pool.DeleteObjects(ctx, bucket, objs, opts)
+			for i, derr := range errs {
+				if derr != nil {
+					derrs[orgIndexes[i]] = derr
+				}
+				dobjects[orgIndexes[i]] = deletedObjects[i]
+			}
+		}(idx, pool)
 	}
+	wg.Wait()
 	return dobjects, derrs
 }
 
 func (z *erasureServerPools) CopyObject(ctx context.Context, srcBucket, srcObject, dstBucket, dstObject string, srcInfo ObjectInfo, srcOpts, dstOpts ObjectOptions) (objInfo ObjectInfo, err error) {
+	defer logger.AddRequestTiming(ctx, logger.TimingObjectLayer, time.Now())
+
 	srcObject = encodeDirObject(srcObject)
 	dstObject = encodeDirObject(dstObject)
 
@@ -895,6 +952,8 @@ func (z *erasureServerPools) ListObjectsV2(ctx context.Context, bucket, prefix,
 }
 
 func (z *erasureServerPools) ListObjectVersions(ctx context.Context, bucket, prefix, marker, versionMarker, delimiter string, maxKeys int) (ListObjectVersionsInfo, error) {
+	defer logger.AddRequestTiming(ctx, logger.TimingObjectLayer, time.Now())
+
 	loi := ListObjectVersionsInfo{}
 	if marker == "" && versionMarker != "" {
 		return loi, NotImplemented{}
@@ -961,6 +1020,8 @@ func maxKeysPlusOne(maxKeys int, addOne bool) int {
 }
 
 func (z *erasureServerPools) ListObjects(ctx context.Context, bucket, prefix, marker, delimiter string, maxKeys int) (ListObjectsInfo, error) {
+	defer logger.AddRequestTiming(ctx, logger.TimingObjectLayer, time.Now())
+
 	var loi ListObjectsInfo
 
 	merged, err := z.listPath(ctx, listPathOptions{
@@ -1027,6 +1088,8 @@ func (z *erasureServerPools) ListMultipartUploads(ctx context.Context, bucket, p
 
 // Initiate a new multipart upload on a hashedSet based on object name.
 func (z *erasureServerPools) NewMultipartUpload(ctx context.Context, bucket, object string, opts ObjectOptions) (string, error) {
+	defer logger.AddRequestTiming(ctx, logger.TimingObjectLayer, time.Now())
+
 	if err := checkNewMultipartArgs(ctx, bucket, object, z); err != nil {
 		return "", err
 	}
@@ -1044,12 +1107,15 @@ func (z *erasureServerPools) NewMultipartUpload(ctx context.Context, bucket, obj
 		// create the new multipart in the same pool, this will avoid
 		// creating two multiparts uploads in two different pools
 		if len(result.Uploads) != 0 {
+			if err = z.checkPoolWritable(bucket, idx); err != nil {
+				return "", err
+			}
 			return z.serverPools[idx].NewMultipartUpload(ctx, bucket, object, opts)
 		}
 	}
 
 	// We multiply the size by 2 to account for erasure coding.
-	idx := z.getAvailablePoolIdx(ctx, (1<<30)*2)
+	idx := z.getAvailablePoolIdx(ctx, bucket, (1<<30)*2)
 	if idx < 0 {
 		return "", toObjectErr(errDiskFull)
 	}
@@ -1069,6 +1135,8 @@ func (z *erasureServerPools) CopyObjectPart(ctx context.Context, srcBucket, srcO
 
 // PutObjectPart - writes part of an object to hashedSet based on the object name.
 func (z *erasureServerPools) PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int, data *PutObjReader, opts ObjectOptions) (PartInfo, error) {
+	defer logger.AddRequestTiming(ctx, logger.TimingObjectLayer, time.Now())
+
 	if err := checkPutObjectPartArgs(ctx, bucket, object, z); err != nil {
 		return PartInfo{}, err
 	}
@@ -1077,9 +1145,12 @@ func (z *erasureServerPools) PutObjectPart(ctx context.Context, bucket, object,
 		return z.serverPools[0].PutObjectPart(ctx, bucket, object, uploadID, partID, data, opts)
 	}
 
-	for _, pool := range z.serverPools {
+	for idx, pool := range z.serverPools {
 		_, err := pool.GetMultipartInfo(ctx, bucket, object, uploadID, opts)
 		if err == nil {
+			if err = z.checkPoolWritable(bucket, idx); err != nil {
+				return PartInfo{}, err
+			}
 			return pool.PutObjectPart(ctx, bucket, object, uploadID, partID, data, opts)
 		}
 		switch err.(type) {
@@ -1164,9 +1235,12 @@ func (z *erasureServerPools) AbortMultipartUpload(ctx context.Context, bucket, o
 		return z.serverPools[0].AbortMultipartUpload(ctx, bucket, object, uploadID, opts)
 	}
 
-	for _, pool := range z.serverPools {
+	for idx, pool := range z.serverPools {
 		_, err := pool.GetMultipartInfo(ctx, bucket, object, uploadID, opts)
 		if err == nil {
+			if err = z.checkPoolWritable(bucket, idx); err != nil {
+				return err
+			}
 			return pool.AbortMultipartUpload(ctx, bucket, object, uploadID, opts)
 		}
 		switch err.(type) {
@@ -1185,6 +1259,8 @@ func (z *erasureServerPools) AbortMultipartUpload(ctx context.Context, bucket, o
 
 // CompleteMultipartUpload - completes a pending multipart transaction, on hashedSet based on object name.
 func (z *erasureServerPools) CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, uploadedParts []CompletePart, opts ObjectOptions) (objInfo ObjectInfo, err error) {
+	defer logger.AddRequestTiming(ctx, logger.TimingObjectLayer, time.Now())
+
 	if err = checkCompleteMultipartArgs(ctx, bucket, object, z); err != nil {
 		return objInfo, err
 	}
@@ -1193,9 +1269,12 @@ func (z *erasureServerPools) CompleteMultipartUpload(ctx context.Context, bucket
 		return z.serverPools[0].CompleteMultipartUpload(ctx, bucket, object, uploadID, uploadedParts, opts)
 	}
 
-	for _, pool := range z.serverPools {
+	for idx, pool := range z.serverPools {
 		_, err := pool.GetMultipartInfo(ctx, bucket, object, uploadID, opts)
 		if err == nil {
+			if err = z.checkPoolWritable(bucket, idx); err != nil {
+				return objInfo, err
+			}
 			return pool.CompleteMultipartUpload(ctx, bucket, object, uploadID, uploadedParts, opts)
 		}
 	}
@@ -1698,6 +1777,98 @@ func (z *erasureServerPools) ReadHealth(ctx context.Context) bool {
 	return true
 }
 
+// PoolsStatus - returns drive, capacity and quorum status of every
+// erasure set across all pools, healState is used to report drives
+// which are currently being healed.
+func (z *erasureServerPools) PoolsStatus(ctx context.Context, healState madmin.BgHealState) []madmin.PoolStatus {
+	parity := z.BackendInfo().StandardSCParity
+
+	healDisks := make(map[string]struct{}, len(healState.HealDisks))
+	for _, disk := range healState.HealDisks {
+		healDisks[disk] = struct{}{}
+	}
+	healSets := make(map[[2]int]madmin.SetStatus, len(healState.Sets))
+	for _, set := range healState.Sets {
+		healSets[[2]int{set.PoolIndex, set.SetIndex}] = set
+	}
+
+	pools := make([]madmin.PoolStatus, len(z.serverPools))
+	for poolIdx, pool := range z.serverPools {
+		sets := make([]madmin.ErasureSetStatus, len(pool.sets))
+		g := errgroup.WithNErrs(len(pool.sets))
+		for setIdx := range pool.sets {
+			setIdx := setIdx
+			g.Go(func() error {
+				info, _ := pool.sets[setIdx].StorageInfo(ctx)
+				sets[setIdx] = getErasureSetStatus(poolIdx, setIdx, info.Disks, parity, healDisks, healSets[[2]int{poolIdx, setIdx}])
+				return nil
+			}, setIdx)
+		}
+		g.Wait()
+
+		status := madmin.PoolStatus{
+			PoolIndex:   poolIdx,
+			Maintenance: z.poolInMaintenance(poolIdx),
+			Sets:        sets,
+		}
+		for i, set := range sets {
+			status.DrivesOnline += set.DrivesOnline
+			status.DrivesOffline += set.DrivesOffline
+			status.DrivesHealing += set.DrivesHealing
+			status.TotalSpace += set.TotalSpace
+			status.UsedSpace += set.UsedSpace
+			status.AvailableSpace += set.AvailableSpace
+			if i == 0 || set.ReadTolerance < status.ReadTolerance {
+				status.ReadTolerance = set.ReadTolerance
+			}
+			if i == 0 || set.WriteTolerance < status.WriteTolerance {
+				status.WriteTolerance = set.WriteTolerance
+			}
+		}
+		pools[poolIdx] = status
+	}
+	return pools
+}
+
+// getErasureSetStatus - computes the status of an erasure set from the
+// information of its disks and the configured parity.
+func getErasureSetStatus(poolIdx, setIdx int, disks []madmin.Disk, parity int, healDisks map[string]struct{}, healSet madmin.SetStatus) madmin.ErasureSetStatus {
+	readQuorum := len(disks) - parity
+	writeQuorum := readQuorum
+	if readQuorum == parity {
+		writeQuorum++
+	}
+
+	status := madmin.ErasureSetStatus{
+		PoolIndex:   poolIdx,
+		SetIndex:    setIdx,
+		ReadQuorum:  readQuorum,
+		WriteQuorum: writeQuorum,
+		HealStatus:  healSet.HealStatus,
+	}
+	for _, disk := range disks {
+		if disk.State != madmin.DriveStateOk {
+			status.DrivesOffline++
+			continue
+		}
+		status.DrivesOnline++
+		status.TotalSpace += disk.TotalSpace
+		status.UsedSpace += disk.UsedSpace
+		status.AvailableSpace += disk.AvailableSpace
+		if _, ok := healDisks[disk.Endpoint]; ok || disk.Healing {
+			status.DrivesHealing++
+		}
+	}
+	for _, disk := range healSet.Disks {
+		if disk.HealInfo != nil {
+			status.Healing = append(status.Healing, *disk.HealInfo)
+		}
+	}
+	status.ReadTolerance = status.DrivesOnline - readQuorum
+	status.WriteTolerance = status.DrivesOnline - writeQuorum
+	return status
+}
+
 // Health - returns current status of the object layer health,
 // provides if write access exists across sets, additionally
 // can be used to query scenarios if health may be lost
@@ -1796,6 +1967,9 @@ func (z *erasureServerPools) PutObjectMetadata(ctx context.Context, bucket, obje
 	if err != nil {
 		return ObjectInfo{}, err
 	}
+	if err = z.checkPoolWritable(bucket, idx); err != nil {
+		return ObjectInfo{}, err
+	}
 
 	return z.serverPools[idx].PutObjectMetadata(ctx, bucket, object, opts)
 }
@@ -1812,6 +1986,9 @@ func (z *erasureServerPools) PutObjectTags(ctx context.Context, bucket, object s
 	if err != nil {
 		return ObjectInfo{}, err
 	}
+	if err = z.checkPoolWritable(bucket, idx); err != nil {
+		return ObjectInfo{}, err
+	}
 
 	return z.serverPools[idx].PutObjectTags(ctx, bucket, object, tags, opts)
 }
@@ -1827,6 +2004,9 @@ func (z *erasureServerPools) DeleteObjectTags(ctx context.Context, bucket, objec
 	if err != nil {
 		return ObjectInfo{}, err
 	}
+	if err = z.checkPoolWritable(bucket, idx); err != nil {
+		return ObjectInfo{}, err
+	}
 
 	return z.serverPools[idx].DeleteObjectTags(ctx, bucket, object, opts)
 }
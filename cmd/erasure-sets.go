@@ -298,7 +298,7 @@ func (s *erasureSets) monitorAndConnectEndpoints(ctx context.Context, monitorInt
 			// Reset the timer once fired for required interval.
 			monitor.Reset(monitorInterval)
 
-			if serverDebugLog {
+			if debugLogEnabled(debugLogStorage) {
 				console.Debugln("running disk monitoring")
 			}
 
@@ -499,6 +499,10 @@ type auditObjectOp struct {
 	Disks []string `json:"disks"`
 }
 
+// auditObjectErasureSetMu - serializes the updates of the erasure set
+// tag of requests, bulk deletes touch several pools concurrently.
+var auditObjectErasureSetMu sync.Mutex
+
 func auditObjectErasureSet(ctx context.Context, object string, set *erasureObjects) {
 	if len(logger.AuditTargets) == 0 {
 		return
@@ -512,6 +516,9 @@ func auditObjectErasureSet(ctx context.Context, object string, set *erasureObjec
 		Disks: set.getEndpoints(),
 	}
 
+	auditObjectErasureSetMu.Lock()
+	defer auditObjectErasureSetMu.Unlock()
+
 	var objectErasureSetTag map[string]auditObjectOp
 	reqInfo := logger.GetReqInfo(ctx)
 	for _, kv := range reqInfo.GetTags() {
@@ -931,14 +938,27 @@ func (s *erasureSets) DeleteObjects(ctx context.Context, bucket string, objects
 	}
 
 	// Invoke bulk delete on objects per set and save
-	// the result of the delete operation
+	// the result of the delete operation, the sets do
+	// not share drives and are deleted from in parallel.
+	var wg sync.WaitGroup
+	for _, objsGroup := range objSetMap {
+		wg.Add(1)
+		go func(objsGroup []delObj) {
+			defer wg.Done()
+			set := s.sets[objsGroup[0].setIndex]
+			dobjects, errs := set.DeleteObjects(ctx, bucket, toNames(objsGroup), opts)
+			for i, obj := range objsGroup {
+				delErrs[obj.origIndex] = errs[i]
+				delObjects[obj.origIndex] = dobjects[i]
+			}
+		}(objsGroup)
+	}
+	wg.Wait()
+
 	for _, objsGroup := range objSetMap {
-		set := s.getHashedSet(objsGroup[0].object.ObjectName)
-		dobjects, errs := set.DeleteObjects(ctx, bucket, toNames(objsGroup), opts)
-		for i, obj := range objsGroup {
-			delErrs[obj.origIndex] = errs[i]
-			delObjects[obj.origIndex] = dobjects[i]
-			if errs[i] == nil {
+		set := s.sets[objsGroup[0].setIndex]
+		for _, obj := range objsGroup {
+			if delErrs[obj.origIndex] == nil {
 				auditObjectErasureSet(ctx, obj.object.ObjectName, set)
 			}
 		}
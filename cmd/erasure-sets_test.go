@@ -18,11 +18,13 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/minio/minio/pkg/madmin"
 )
 
 var testUUID = uuid.MustParse("f5c58c61-7175-4018-ab5e-a94fe9c2de4e")
@@ -230,3 +232,52 @@ func TestHashedLayer(t *testing.T) {
 		}
 	}
 }
+
+// Tests computing the quorum tolerance of an erasure set.
+func TestGetErasureSetStatus(t *testing.T) {
+	newDisks := func(online, offline int) []madmin.Disk {
+		var disks []madmin.Disk
+		for i := 0; i < online; i++ {
+			disks = append(disks, madmin.Disk{
+				Endpoint:   fmt.Sprintf("http://server%d/disk", i),
+				State:      madmin.DriveStateOk,
+				TotalSpace: 100,
+				UsedSpace:  40,
+			})
+		}
+		for i := 0; i < offline; i++ {
+			disks = append(disks, madmin.Disk{State: madmin.DriveStateOffline})
+		}
+		return disks
+	}
+
+	testCases := []struct {
+		disks          []madmin.Disk
+		parity         int
+		readTolerance  int
+		writeTolerance int
+	}{
+		{newDisks(16, 0), 4, 4, 4},
+		{newDisks(14, 2), 4, 2, 2},
+		{newDisks(4, 0), 2, 2, 1},
+		{newDisks(2, 2), 2, 0, -1},
+		{newDisks(10, 6), 4, -2, -2},
+	}
+
+	for i, testCase := range testCases {
+		status := getErasureSetStatus(0, 1, testCase.disks, testCase.parity,
+			map[string]struct{}{"http://server0/disk": {}}, madmin.SetStatus{})
+		if status.ReadTolerance != testCase.readTolerance {
+			t.Errorf("Test %d: expected read tolerance %d, got %d", i+1, testCase.readTolerance, status.ReadTolerance)
+		}
+		if status.WriteTolerance != testCase.writeTolerance {
+			t.Errorf("Test %d: expected write tolerance %d, got %d", i+1, testCase.writeTolerance, status.WriteTolerance)
+		}
+		if status.DrivesHealing != 1 {
+			t.Errorf("Test %d: expected 1 healing drive, got %d", i+1, status.DrivesHealing)
+		}
+		if status.TotalSpace != uint64(status.DrivesOnline)*100 {
+			t.Errorf("Test %d: expected total space %d, got %d", i+1, status.DrivesOnline*100, status.TotalSpace)
+		}
+	}
+}
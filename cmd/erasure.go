@@ -205,12 +205,24 @@ func getDisksInfo(disks []StorageAPI, endpoints []string) (disksInfo []madmin.Di
 				}
 			}
 			di.Metrics = &madmin.DiskMetrics{
-				APILatencies: make(map[string]string),
-				APICalls:     make(map[string]uint64),
+				APILatencies:   make(map[string]string),
+				APICalls:       make(map[string]uint64),
+				ReadLatencies:  make(map[string]time.Duration, len(info.Metrics.ReadLatencies)),
+				WriteLatencies: make(map[string]time.Duration, len(info.Metrics.WriteLatencies)),
+				QueueDepth:     info.Metrics.QueueDepth,
+				Utilization:    info.Metrics.Utilization,
+				TotalErrors:    info.Metrics.TotalErrors,
+				BitrotErrors:   info.Metrics.BitrotErrors,
 			}
 			for k, v := range info.Metrics.APILatencies {
 				di.Metrics.APILatencies[k] = v
 			}
+			for k, v := range info.Metrics.ReadLatencies {
+				di.Metrics.ReadLatencies[k] = time.Duration(v)
+			}
+			for k, v := range info.Metrics.WriteLatencies {
+				di.Metrics.WriteLatencies[k] = time.Duration(v)
+			}
 			for k, v := range info.Metrics.APICalls {
 				di.Metrics.APICalls[k] = v
 			}
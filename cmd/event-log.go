@@ -0,0 +1,357 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/minio/minio/cmd/config"
+	"github.com/minio/minio/cmd/config/eventlog"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/event"
+	"github.com/minio/minio/pkg/kms"
+)
+
+const (
+	// The events of a bucket are kept under this prefix of the
+	// meta bucket, one object per node and flush.
+	eventLogPrefix = "event-log"
+	eventLogSuffix = ".json"
+
+	// Time format of the first event of an event log object,
+	// the objects of a bucket list in the order of their events.
+	eventLogTimeFormat = "20060102T150405Z"
+
+	// How often the events emitted by a node are saved.
+	eventLogFlushInterval = time.Minute
+
+	// Events are saved early once this many are pending, and
+	// dropped once twice as many are.
+	eventLogMaxPending = 10000
+
+	// How often the expired events are removed.
+	eventLogCleanupInterval = time.Hour
+)
+
+var (
+	globalEventLogConfig   eventlog.Config
+	globalEventLogConfigMu sync.RWMutex
+
+	globalEventLog = newEventLog()
+)
+
+var errEventReplayNoSuchTarget = AdminError{
+	Code:       "XMinioAdminNoSuchNotificationTarget",
+	Message:    "The specified notification target does not exist",
+	StatusCode: http.StatusNotFound,
+}
+
+func getEventLogConfig() eventlog.Config {
+	globalEventLogConfigMu.RLock()
+	defer globalEventLogConfigMu.RUnlock()
+	return globalEventLogConfig
+}
+
+func eventLogBucketPrefix(bucket string) string {
+	return path.Join(eventLogPrefix, bucket) + SlashSeparator
+}
+
+// eventLog keeps the bucket events emitted by this node until they
+// are saved.
+type eventLog struct {
+	mu      sync.Mutex
+	pending map[string][]event.Event
+	count   int
+	flushCh chan struct{}
+}
+
+func newEventLog() *eventLog {
+	return &eventLog{
+		pending: make(map[string][]event.Event),
+		flushCh: make(chan struct{}, 1),
+	}
+}
+
+// add logs an event emitted for bucket, when the event log is enabled.
+func (l *eventLog) add(bucket string, ev event.Event) {
+	if !getEventLogConfig().Enabled {
+		return
+	}
+
+	l.mu.Lock()
+	if l.count >= 2*eventLogMaxPending {
+		l.mu.Unlock()
+		logger.LogOnceIf(GlobalContext, errors.New("event log is full, events are not logged"), "event-log-full")
+		return
+	}
+	l.pending[bucket] = append(l.pending[bucket], ev)
+	l.count++
+	full := l.count >= eventLogMaxPending
+	l.mu.Unlock()
+
+	if full {
+		select {
+		case l.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// take returns the pending events and starts over.
+func (l *eventLog) take() map[string][]event.Event {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	pending := l.pending
+	l.pending = make(map[string][]event.Event)
+	l.count = 0
+	return pending
+}
+
+// initEventLog starts saving the bucket events emitted by this node
+// and removing the expired ones, as set in the event log configuration.
+func initEventLog(ctx context.Context, objAPI ObjectLayer) {
+	go globalEventLog.run(ctx, objAPI)
+}
+
+func (l *eventLog) run(ctx context.Context, objAPI ObjectLayer) {
+	flushTicker := time.NewTicker(eventLogFlushInterval)
+	defer flushTicker.Stop()
+	cleanupTicker := time.NewTicker(eventLogCleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-flushTicker.C:
+			l.flush(ctx, objAPI)
+		case <-l.flushCh:
+			l.flush(ctx, objAPI)
+		case <-cleanupTicker.C:
+			logger.LogIf(ctx, cleanupEventLog(ctx, objAPI, getEventLogConfig()))
+		}
+	}
+}
+
+// flush saves the pending events, it returns the last error after
+// logging all of them.
+func (l *eventLog) flush(ctx context.Context, objAPI ObjectLayer) (err error) {
+	for bucket, events := range l.take() {
+		if serr := saveEventLog(ctx, objAPI, bucket, events); serr != nil {
+			logger.LogIf(ctx, serr)
+			err = serr
+		}
+	}
+	return err
+}
+
+// saveEventLog saves events of bucket as JSON lines, encrypted with
+// the KMS when one is configured.
+func saveEventLog(ctx context.Context, objAPI ObjectLayer, bucket string, events []event.Event) error {
+	first, err := time.Parse(event.AMZTimeFormat, events[0].EventTime)
+	if err != nil {
+		first = UTCNow()
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, ev := range events {
+		if err = enc.Encode(ev); err != nil {
+			return err
+		}
+	}
+
+	data := buf.Bytes()
+	name := fmt.Sprintf("%s-%s%s", first.UTC().Format(eventLogTimeFormat), mustGetUUID(), eventLogSuffix)
+	logFile := path.Join(eventLogPrefix, bucket, name)
+	if GlobalKMS != nil {
+		data, err = config.EncryptBytes(GlobalKMS, data, kms.Context{
+			minioMetaBucket: path.Join(minioMetaBucket, logFile),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return saveConfig(ctx, objAPI, logFile, data)
+}
+
+// eventLogTime returns the time of the first event of an event log
+// object.
+func eventLogTime(name string) (time.Time, bool) {
+	name = path.Base(name)
+	if !strings.HasSuffix(name, eventLogSuffix) || len(name) < len(eventLogTimeFormat) {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(eventLogTimeFormat, name[:len(eventLogTimeFormat)])
+	return t, err == nil
+}
+
+// eventLogExpired returns whether all the events of an event log
+// object are older than retention.
+func eventLogExpired(name string, now time.Time, retention time.Duration) bool {
+	t, ok := eventLogTime(name)
+	if !ok {
+		return false
+	}
+	return now.Sub(t) > retention+eventLogFlushInterval
+}
+
+// cleanupEventLog removes the expired events of all buckets, including
+// removed ones. All the events are removed once the event log is disabled.
+func cleanupEventLog(ctx context.Context, objAPI ObjectLayer, cfg eventlog.Config) error {
+	locker := objAPI.NewNSLock(minioMetaBucket, "event-log-cleanup")
+	lkctx, err := locker.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		// Another node is taking care of it.
+		return nil
+	}
+	defer locker.Unlock()
+
+	var retention time.Duration
+	if cfg.Enabled {
+		retention = cfg.Retention
+	}
+
+	objInfoCh := make(chan ObjectInfo)
+	if err = objAPI.Walk(lkctx, minioMetaBucket, eventLogPrefix+SlashSeparator, objInfoCh, ObjectOptions{}); err != nil {
+		return err
+	}
+	now := UTCNow()
+	for obj := range objInfoCh {
+		if !eventLogExpired(obj.Name, now, retention) {
+			continue
+		}
+		if err = deleteConfig(lkctx, objAPI, obj.Name); err != nil && err != errConfigNotFound {
+			logger.LogIf(ctx, err)
+		}
+	}
+	return nil
+}
+
+// readEventLog returns the events saved in an event log object.
+func readEventLog(ctx context.Context, objAPI ObjectLayer, logFile string) ([]event.Event, error) {
+	data, err := readConfig(ctx, objAPI, logFile)
+	if err != nil {
+		return nil, err
+	}
+	if GlobalKMS != nil && !utf8.Valid(data) {
+		data, err = config.DecryptBytes(GlobalKMS, data, kms.Context{
+			minioMetaBucket: path.Join(minioMetaBucket, logFile),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var events []event.Event
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for dec.More() {
+		var ev event.Event
+		if err = dec.Decode(&ev); err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// walkEventLog calls fn with the logged events of bucket emitted
+// between start and end, oldest first. The events emitted by different
+// nodes within the same minute may be walked out of order.
+func walkEventLog(ctx context.Context, objAPI ObjectLayer, bucket string, start, end time.Time, fn func(ev event.Event) error) error {
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(ctx, minioMetaBucket, eventLogBucketPrefix(bucket), objInfoCh, ObjectOptions{}); err != nil {
+		return err
+	}
+	var logFiles []string
+	for obj := range objInfoCh {
+		t, ok := eventLogTime(obj.Name)
+		// An object holds the events of one flush interval
+		// from its first event.
+		if !ok || t.After(end) || t.Add(eventLogFlushInterval).Before(start) {
+			continue
+		}
+		logFiles = append(logFiles, obj.Name)
+	}
+	sort.Strings(logFiles)
+
+	for _, logFile := range logFiles {
+		events, err := readEventLog(ctx, objAPI, logFile)
+		if err != nil {
+			if err == errConfigNotFound {
+				// Expired in the meantime.
+				continue
+			}
+			return err
+		}
+
+		for _, ev := range events {
+			if err = ctx.Err(); err != nil {
+				return err
+			}
+			t, err := time.Parse(event.AMZTimeFormat, ev.EventTime)
+			if err != nil || t.Before(start) || t.After(end) {
+				continue
+			}
+			if err = fn(ev); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// replayEvents sends the logged events of bucket emitted between start
+// and end for the objects under prefix again to the target, oldest
+// first. Only the events the notification rules of the bucket send to
+// the target are replayed. The events emitted by different nodes
+// within the same minute may be replayed out of order.
+func replayEvents(ctx context.Context, objAPI ObjectLayer, bucket, prefix string, start, end time.Time, targetID event.TargetID) (replayed int, err error) {
+	if !globalNotificationSys.targetList.Exists(targetID) {
+		return 0, errEventReplayNoSuchTarget
+	}
+
+	err = walkEventLog(ctx, objAPI, bucket, start, end, func(ev event.Event) error {
+		objectName, err := url.QueryUnescape(ev.S3.Object.Key)
+		if err != nil || !strings.HasPrefix(objectName, prefix) {
+			return nil
+		}
+		ok, err := globalNotificationSys.Replay(bucket, objectName, ev, targetID)
+		if err != nil {
+			return err
+		}
+		if ok {
+			replayed++
+		}
+		return nil
+	})
+	return replayed, err
+}
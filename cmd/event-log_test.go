@@ -0,0 +1,85 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/minio/cmd/config/eventlog"
+	"github.com/minio/minio/pkg/event"
+)
+
+func TestEventLogExpired(t *testing.T) {
+	now := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	name := func(age time.Duration) string {
+		return "event-log/bucket/" + now.Add(-age).Format(eventLogTimeFormat) + "-" + mustGetUUID() + eventLogSuffix
+	}
+
+	testCases := []struct {
+		name      string
+		retention time.Duration
+		expired   bool
+	}{
+		{name(time.Hour), 24 * time.Hour, false},
+		// The object may hold events up to a minute newer.
+		{name(24*time.Hour + 30*time.Second), 24 * time.Hour, false},
+		{name(25 * time.Hour), 24 * time.Hour, true},
+		// Everything expires once the event log is disabled.
+		{name(2 * time.Minute), 0, true},
+		{"event-log/bucket/unknown.json", 0, false},
+		{"event-log/bucket/" + now.Format(eventLogTimeFormat), 0, false},
+	}
+	for i, testCase := range testCases {
+		if expired := eventLogExpired(testCase.name, now, testCase.retention); expired != testCase.expired {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expired, expired)
+		}
+	}
+}
+
+func TestEventLogAdd(t *testing.T) {
+	defer func(cfg eventlog.Config) {
+		globalEventLogConfig = cfg
+	}(globalEventLogConfig)
+
+	l := newEventLog()
+	globalEventLogConfig = eventlog.Config{}
+	l.add("bucket", event.Event{})
+	if pending := l.take(); len(pending) != 0 {
+		t.Fatalf("expected no events while disabled, got %v", pending)
+	}
+
+	globalEventLogConfig = eventlog.Config{Enabled: true, Retention: time.Hour}
+	for i := 0; i < eventLogMaxPending; i++ {
+		l.add("bucket", event.Event{})
+	}
+	select {
+	case <-l.flushCh:
+	default:
+		t.Fatal("expected an early flush")
+	}
+	l.add("other", event.Event{})
+
+	pending := l.take()
+	if len(pending["bucket"]) != eventLogMaxPending || len(pending["other"]) != 1 {
+		t.Fatalf("unexpected pending events: %d, %d", len(pending["bucket"]), len(pending["other"]))
+	}
+	if pending = l.take(); len(pending) != 0 {
+		t.Fatalf("expected no events after take, got %d buckets", len(pending))
+	}
+}
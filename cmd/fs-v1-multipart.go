@@ -32,6 +32,7 @@ import (
 	jsoniter "github.com/json-iterator/go"
 	"github.com/minio/minio/cmd/logger"
 	xioutil "github.com/minio/minio/pkg/ioutil"
+	"github.com/minio/minio/pkg/lock"
 	"github.com/minio/minio/pkg/trie"
 )
 
@@ -233,6 +234,10 @@ func (fs *FSObjects) NewMultipartUpload(ctx context.Context, bucket, object stri
 	// Initialize fs.json values.
 	fsMeta := newFSMetaV1()
 	fsMeta.Meta = opts.UserDefined
+	if fsMeta.Meta == nil {
+		fsMeta.Meta = make(map[string]string)
+	}
+	fsMeta.Meta[multipartObjectKey] = pathJoin(bucket, object)
 
 	fsMetaBytes, err := json.Marshal(fsMeta)
 	if err != nil {
@@ -715,34 +720,37 @@ func (fs *FSObjects) CompleteMultipartUpload(ctx context.Context, bucket string,
 	}
 	defer destLock.Unlock()
 
-	bucketMetaDir := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix)
-	fsMetaPath := pathJoin(bucketMetaDir, bucket, object, fs.metaJSONFile)
-	metaFile, err := fs.rwPool.Write(fsMetaPath)
-	var freshFile bool
-	if err != nil {
-		if !errors.Is(err, errFileNotFound) {
-			logger.LogIf(ctx, err)
-			return oi, toObjectErr(err, bucket, object)
-		}
-		metaFile, err = fs.rwPool.Create(fsMetaPath)
+	var metaFile *lock.LockedFile
+	if !fs.xattrMeta {
+		bucketMetaDir := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix)
+		fsMetaPath := pathJoin(bucketMetaDir, bucket, object, fs.metaJSONFile)
+		metaFile, err = fs.rwPool.Write(fsMetaPath)
+		var freshFile bool
 		if err != nil {
-			logger.LogIf(ctx, err)
-			return oi, toObjectErr(err, bucket, object)
-		}
-		freshFile = true
+			if !errors.Is(err, errFileNotFound) {
+				logger.LogIf(ctx, err)
+				return oi, toObjectErr(err, bucket, object)
+			}
+			metaFile, err = fs.rwPool.Create(fsMetaPath)
+			if err != nil {
+				logger.LogIf(ctx, err)
+				return oi, toObjectErr(err, bucket, object)
+			}
+			freshFile = true
+		}
+		defer metaFile.Close()
+		defer func() {
+			// Remove meta file when CompleteMultipart encounters
+			// any error and it is a fresh file.
+			//
+			// We should preserve the `fs.json` of any
+			// existing object
+			if e != nil && freshFile {
+				tmpDir := pathJoin(fs.fsPath, minioMetaTmpBucket, fs.fsUUID)
+				fsRemoveMeta(ctx, bucketMetaDir, fsMetaPath, tmpDir)
+			}
+		}()
 	}
-	defer metaFile.Close()
-	defer func() {
-		// Remove meta file when CompleteMultipart encounters
-		// any error and it is a fresh file.
-		//
-		// We should preserve the `fs.json` of any
-		// existing object
-		if e != nil && freshFile {
-			tmpDir := pathJoin(fs.fsPath, minioMetaTmpBucket, fs.fsUUID)
-			fsRemoveMeta(ctx, bucketMetaDir, fsMetaPath, tmpDir)
-		}
-	}()
 
 	// Read saved fs metadata for ongoing multipart.
 	fsMetaBuf, err := xioutil.ReadFile(pathJoin(uploadIDDir, fs.metaJSONFile))
@@ -762,7 +770,14 @@ func (fs *FSObjects) CompleteMultipartUpload(ctx context.Context, bucket string,
 	fsMeta.Meta["etag"] = s3MD5
 	// Save consolidated actual size.
 	fsMeta.Meta[ReservedMetadataPrefix+"actual-size"] = strconv.FormatInt(objectActualSize, 10)
-	if _, err = fsMeta.WriteTo(metaFile); err != nil {
+	delete(fsMeta.Meta, multipartObjectKey)
+	if fs.xattrMeta {
+		// Metadata is renamed along with the object.
+		err = fs.writeMetaXattr(ctx, bucket, object, appendFilePath, fsMeta)
+	} else {
+		_, err = fsMeta.WriteTo(metaFile)
+	}
+	if err != nil {
 		logger.LogIf(ctx, err)
 		return oi, toObjectErr(err, bucket, object)
 	}
@@ -846,10 +861,26 @@ func (fs *FSObjects) AbortMultipartUpload(ctx context.Context, bucket, object, u
 	return nil
 }
 
-// Removes multipart uploads if any older than `expiry` duration
-// on all buckets for every `cleanupInterval`, this function is
-// blocking and should be run in a go-routine.
-func (fs *FSObjects) cleanupStaleUploads(ctx context.Context, cleanupInterval, expiry time.Duration) {
+// Returns the bucket recorded in fs.json of the multipart upload
+// at uploadIDDir, empty if it cannot be read.
+func (fs *FSObjects) multipartUploadBucket(uploadIDDir string) string {
+	fsMetaBytes, err := xioutil.ReadFile(pathJoin(uploadIDDir, fs.metaJSONFile))
+	if err != nil {
+		return ""
+	}
+	var fsMeta fsMetaV1
+	var json = jsoniter.ConfigCompatibleWithStandardLibrary
+	if err = json.Unmarshal(fsMetaBytes, &fsMeta); err != nil {
+		return ""
+	}
+	bucket, _ := path2BucketObject(fsMeta.Meta[multipartObjectKey])
+	return bucket
+}
+
+// Removes multipart uploads if any older than the configured
+// multipart expiry on all buckets for every `cleanupInterval`,
+// this function is blocking and should be run in a go-routine.
+func (fs *FSObjects) cleanupStaleUploads(ctx context.Context, cleanupInterval time.Duration) {
 	timer := time.NewTimer(cleanupInterval)
 	defer timer.Stop()
 
@@ -862,6 +893,7 @@ func (fs *FSObjects) cleanupStaleUploads(ctx context.Context, cleanupInterval, e
 			timer.Reset(cleanupInterval)
 
 			now := time.Now()
+			multipartCfg := getMultipartConfig()
 			entries, err := readDir(pathJoin(fs.fsPath, minioMetaMultipartBucket))
 			if err != nil {
 				continue
@@ -882,7 +914,11 @@ func (fs *FSObjects) cleanupStaleUploads(ctx context.Context, cleanupInterval, e
 					if err != nil {
 						continue
 					}
-					if now.Sub(fi.ModTime()) > expiry {
+					var bucket string
+					if len(multipartCfg.Exclude) > 0 {
+						bucket = fs.multipartUploadBucket(pathJoin(fs.fsPath, minioMetaMultipartBucket, entry, uploadID))
+					}
+					if multipartCfg.Expired(bucket, fi.ModTime(), now) {
 						fsRemoveAll(ctx, pathJoin(fs.fsPath, minioMetaMultipartBucket, entry, uploadID))
 						// It is safe to ignore any directory not empty error (in case there were multiple uploadIDs on the same object)
 						fsRemoveDir(ctx, pathJoin(fs.fsPath, minioMetaMultipartBucket, entry))
@@ -48,11 +48,21 @@ func TestFSCleanupMultipartUploadsInRoutine(t *testing.T) {
 		t.Fatal("Unexpected err: ", err)
 	}
 
+	globalMultipartConfigMu.Lock()
+	savedMultipartConfig := globalMultipartConfig
+	globalMultipartConfig.AbortAfter = 0
+	globalMultipartConfigMu.Unlock()
+	defer func() {
+		globalMultipartConfigMu.Lock()
+		globalMultipartConfig = savedMultipartConfig
+		globalMultipartConfigMu.Unlock()
+	}()
+
 	var cleanupWg sync.WaitGroup
 	cleanupWg.Add(1)
 	go func() {
 		defer cleanupWg.Done()
-		fs.cleanupStaleUploads(ctx, time.Millisecond, 0)
+		fs.cleanupStaleUploads(ctx, time.Millisecond)
 	}()
 
 	// Wait for 100ms such that - we have given enough time for
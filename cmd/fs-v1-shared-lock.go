@@ -0,0 +1,377 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	pathutil "path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+)
+
+const (
+	// Lease files of the shared namespace locks are kept
+	// in .minio.sys/locks of the backend filesystem.
+	fsSharedLocksDir = "locks"
+
+	fsSharedLockWriter          = "writer"
+	fsSharedLockReaderPrefix    = "reader."
+	fsSharedLockTombstonePrefix = "expired."
+
+	// DefaultFSSharedLockLease is how long a lease file is valid
+	// unless it is refreshed by the server holding the lock.
+	DefaultFSSharedLockLease = 30 * time.Second
+
+	// Leases are considered expired this much later, the modification
+	// time of the lease files is set by the NFS server or by the other
+	// servers, whose clocks may be off.
+	fsSharedLockClockSkew = 5 * time.Second
+
+	fsSharedLockRetryInterval = 50 * time.Millisecond
+)
+
+// fsSharedLocker - namespace locks shared between all servers using the
+// same backend filesystem, e.g. several NAS gateways on the same NFS
+// export. A lock is held by creating a lease file, which is refreshed
+// while the lock is held and taken over by others once it expires.
+type fsSharedLocker struct {
+	dir       string
+	lease     time.Duration
+	clockSkew time.Duration
+}
+
+// fsLease - content of a lease file, to find out who holds a lock.
+type fsLease struct {
+	Node   string `json:"node"`
+	OpsID  string `json:"opsID"`
+	Source string `json:"source"`
+}
+
+func newFSSharedLocker(fsPath string, lease time.Duration) (*fsSharedLocker, error) {
+	dir := pathJoin(fsPath, minioMetaBucket, fsSharedLocksDir)
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, err
+	}
+	return &fsSharedLocker{dir: dir, lease: lease, clockSkew: fsSharedLockClockSkew}, nil
+}
+
+// resourceDir - returns the directory of the lease files of a resource.
+func (l *fsSharedLocker) resourceDir(resource string) string {
+	sum := sha256.Sum256([]byte(resource))
+	return pathJoin(l.dir, hex.EncodeToString(sum[:]))
+}
+
+// createLease - creates the lease file, failing if it exists.
+func (l *fsSharedLocker) createLease(leasePath string, lease fsLease) error {
+	data, err := json.Marshal(lease)
+	if err != nil {
+		return err
+	}
+	for {
+		if err = os.MkdirAll(pathutil.Dir(leasePath), 0777); err != nil {
+			return err
+		}
+		var f *os.File
+		f, err = os.OpenFile(leasePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0666)
+		// The directory is removed by the last lock holder.
+		if osIsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			os.Remove(leasePath)
+		}
+		return err
+	}
+}
+
+// readLease - returns the content and the file info of a lease file.
+// The content is empty while the lease file is being created.
+func (l *fsSharedLocker) readLease(leasePath string) (lease fsLease, fi os.FileInfo, err error) {
+	f, err := os.Open(leasePath)
+	if err != nil {
+		return lease, nil, err
+	}
+	defer f.Close()
+	if fi, err = f.Stat(); err != nil {
+		return lease, nil, err
+	}
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return lease, nil, err
+	}
+	if len(data) > 0 {
+		// A malformed lease is taken over once expired.
+		json.Unmarshal(data, &lease)
+	}
+	return lease, fi, nil
+}
+
+func (l *fsSharedLocker) leaseExpired(fi os.FileInfo) bool {
+	return time.Since(fi.ModTime()) > l.lease+l.clockSkew
+}
+
+// leaseAlive - returns whether the lease file exists and has not
+// expired, removing it if it has.
+func (l *fsSharedLocker) leaseAlive(leasePath string) bool {
+	lease, fi, err := l.readLease(leasePath)
+	if err != nil {
+		return !osIsNotExist(err)
+	}
+	if !l.leaseExpired(fi) {
+		return true
+	}
+	return !l.expireLease(leasePath, lease.OpsID)
+}
+
+// expireLease - removes the expired lease file of opsID, returns
+// whether it is gone. Several servers may find the same expired lease,
+// and one of them may have taken it over already: the lease is renamed
+// to a unique tombstone first, which only one of them succeeds in, and
+// is put back if it is not the expired lease of opsID anymore.
+func (l *fsSharedLocker) expireLease(leasePath, opsID string) bool {
+	tombstone := pathJoin(pathutil.Dir(leasePath), fsSharedLockTombstonePrefix+mustGetUUID())
+	if err := os.Rename(leasePath, tombstone); err != nil {
+		return osIsNotExist(err)
+	}
+	defer os.Remove(tombstone)
+
+	lease, fi, err := l.readLease(tombstone)
+	if err != nil {
+		return true
+	}
+	if lease.OpsID != opsID || !l.leaseExpired(fi) {
+		// Unlike rename, link does not replace
+		// a lease file created meanwhile.
+		if err = os.Link(tombstone, leasePath); err != nil {
+			logger.LogIf(GlobalContext, fmt.Errorf("Unable to restore lock lease %s: %w", leasePath, err))
+		}
+		return false
+	}
+	return true
+}
+
+// readersAlive - returns whether a read lock is held on the resource.
+func (l *fsSharedLocker) readersAlive(dir string) bool {
+	entries, err := readDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry, fsSharedLockReaderPrefix) && l.leaseAlive(pathJoin(dir, entry)) {
+			return true
+		}
+	}
+	return false
+}
+
+// lock - blocks until the lease file of the resource is created or the
+// timeout has occurred. Writers hold their lease file while waiting for
+// the readers to finish, which keeps new readers from taking the lock.
+func (l *fsSharedLocker) lock(ctx context.Context, resource string, lease fsLease, readLock bool, timeout time.Duration) (leasePath string, locked bool) {
+	dir := l.resourceDir(resource)
+	writer := pathJoin(dir, fsSharedLockWriter)
+	deadline := time.Now().Add(timeout)
+
+	var owned bool
+	for {
+		if readLock {
+			reader := pathJoin(dir, fsSharedLockReaderPrefix+lease.OpsID)
+			if !l.leaseAlive(writer) {
+				err := l.createLease(reader, lease)
+				if err == nil {
+					// A writer may have taken the lock meanwhile.
+					if !l.leaseAlive(writer) {
+						return reader, true
+					}
+					os.Remove(reader)
+				} else {
+					logger.LogIf(ctx, err)
+				}
+			}
+		} else {
+			if !owned {
+				err := l.createLease(writer, lease)
+				switch {
+				case err == nil:
+					owned = true
+				case osIsExist(err):
+					l.leaseAlive(writer)
+				default:
+					logger.LogIf(ctx, err)
+				}
+			}
+			if owned && !l.readersAlive(dir) {
+				return writer, true
+			}
+		}
+
+		if time.Now().After(deadline) || ctx.Err() != nil {
+			if owned {
+				os.Remove(writer)
+			}
+			return "", false
+		}
+		time.Sleep(fsSharedLockRetryInterval/2 + time.Duration(rand.Int63n(int64(fsSharedLockRetryInterval))))
+	}
+}
+
+// refresh - refreshes the lease file until done is closed, cancels
+// the operation if the lease is lost.
+func (l *fsSharedLocker) refresh(leasePath, opsID string, cancel context.CancelFunc, done <-chan struct{}) {
+	ticker := time.NewTicker(l.lease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			lease, _, err := l.readLease(leasePath)
+			if err == nil && lease.OpsID != opsID {
+				err = fmt.Errorf("lease taken over by %s", lease.Node)
+			}
+			if err == nil {
+				now := time.Now()
+				err = os.Chtimes(leasePath, now, now)
+			}
+			if err != nil {
+				logger.LogIf(GlobalContext, fmt.Errorf("Unable to refresh lock lease %s: %w", leasePath, err))
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// unlock - removes the lease file and the directory of the
+// resource if it is the last lease.
+func (l *fsSharedLocker) unlock(leasePath string) {
+	os.Remove(leasePath)
+	os.Remove(pathutil.Dir(leasePath))
+}
+
+// fsSharedLockInstance - takes the local namespace lock, then the
+// shared lock of each resource.
+type fsSharedLockInstance struct {
+	ns     *nsLockMap
+	locker *fsSharedLocker
+	volume string
+	paths  []string
+	opsID  string
+
+	leases []string
+	done   chan struct{}
+	cancel context.CancelFunc
+}
+
+func (li *fsSharedLockInstance) lock(ctx context.Context, timeout *dynamicTimeout, lockSource string, readLock bool) (context.Context, error) {
+	start := UTCNow()
+	lease := fsLease{Node: globalLocalNodeName, OpsID: li.opsID, Source: lockSource}
+	newCtx, cancel := context.WithCancel(ctx)
+
+	leases := make([]string, 0, len(li.paths))
+	for _, path := range li.paths {
+		locked := li.ns.lock(ctx, li.volume, path, lockSource, li.opsID, readLock, timeout.Timeout())
+		if locked {
+			var leasePath string
+			leasePath, locked = li.locker.lock(ctx, pathJoin(li.volume, path), lease, readLock, timeout.Timeout())
+			if locked {
+				leases = append(leases, leasePath)
+			} else {
+				li.ns.unlock(li.volume, path, readLock)
+			}
+		}
+		if !locked {
+			timeout.LogFailure()
+			for j := range leases {
+				li.locker.unlock(leases[j])
+				li.ns.unlock(li.volume, li.paths[j], readLock)
+			}
+			cancel()
+			return ctx, OperationTimedOut{}
+		}
+	}
+	timeout.LogSuccess(UTCNow().Sub(start))
+
+	li.leases = leases
+	li.done = make(chan struct{})
+	li.cancel = cancel
+	for _, leasePath := range leases {
+		go li.locker.refresh(leasePath, li.opsID, cancel, li.done)
+	}
+	return newCtx, nil
+}
+
+func (li *fsSharedLockInstance) unlock(readLock bool) {
+	close(li.done)
+	li.cancel()
+	for i, leasePath := range li.leases {
+		li.locker.unlock(leasePath)
+		li.ns.unlock(li.volume, li.paths[i], readLock)
+	}
+	li.leases = nil
+}
+
+// GetLock - block until write lock is taken or timeout has occurred.
+func (li *fsSharedLockInstance) GetLock(ctx context.Context, timeout *dynamicTimeout) (context.Context, error) {
+	return li.lock(ctx, timeout, getSource(2), false)
+}
+
+// Unlock - block until write lock is released.
+func (li *fsSharedLockInstance) Unlock() {
+	li.unlock(false)
+}
+
+// GetRLock - block until read lock is taken or timeout has occurred.
+func (li *fsSharedLockInstance) GetRLock(ctx context.Context, timeout *dynamicTimeout) (context.Context, error) {
+	return li.lock(ctx, timeout, getSource(2), true)
+}
+
+// RUnlock - block until read lock is released.
+func (li *fsSharedLockInstance) RUnlock() {
+	li.unlock(true)
+}
+
+// newLock - returns a shared lock instance for the given volume and paths.
+func (l *fsSharedLocker) newLock(ns *nsLockMap, volume string, paths ...string) RWLocker {
+	paths = append([]string(nil), paths...)
+	sort.Strings(paths)
+	return &fsSharedLockInstance{
+		ns:     ns,
+		locker: l,
+		volume: volume,
+		paths:  paths,
+		opsID:  mustGetUUID(),
+	}
+}
@@ -0,0 +1,173 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+// Tests that the shared locks of two servers using
+// the same backend filesystem exclude each other.
+func TestFSSharedLocks(t *testing.T) {
+	fsPath, err := ioutil.TempDir("", "minio-shared-lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(fsPath)
+
+	// Two servers with their own local namespace locks.
+	locker1, err := newFSSharedLocker(fsPath, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	locker2, err := newFSSharedLocker(fsPath, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns1, ns2 := newNSLock(false), newNSLock(false)
+
+	ctx := context.Background()
+	timeout := func() *dynamicTimeout {
+		return newDynamicTimeout(200*time.Millisecond, 200*time.Millisecond)
+	}
+
+	lk1 := locker1.newLock(ns1, "bucket", "object")
+	if _, err = lk1.GetLock(ctx, timeout()); err != nil {
+		t.Fatal("Unable to take the write lock", err)
+	}
+
+	lk2 := locker2.newLock(ns2, "bucket", "object")
+	if _, err = lk2.GetRLock(ctx, timeout()); err == nil {
+		t.Fatal("Expected the read lock to time out while the write lock is held")
+	}
+	if _, err = lk2.GetLock(ctx, timeout()); err == nil {
+		t.Fatal("Expected the write lock to time out while the write lock is held")
+	}
+
+	// Other objects are not locked.
+	lk3 := locker2.newLock(ns2, "bucket", "other-object")
+	if _, err = lk3.GetLock(ctx, timeout()); err != nil {
+		t.Fatal("Unable to take the write lock of another object", err)
+	}
+	lk3.Unlock()
+
+	lk1.Unlock()
+	if _, err = lk2.GetRLock(ctx, timeout()); err != nil {
+		t.Fatal("Unable to take the read lock after unlock", err)
+	}
+
+	// Read locks are shared.
+	lk4 := locker1.newLock(ns1, "bucket", "object")
+	if _, err = lk4.GetRLock(ctx, timeout()); err != nil {
+		t.Fatal("Unable to take a second read lock", err)
+	}
+	if _, err = lk1.GetLock(ctx, timeout()); err == nil {
+		t.Fatal("Expected the write lock to time out while read locks are held")
+	}
+	lk2.RUnlock()
+	lk4.RUnlock()
+}
+
+// Tests that the lock of a server which stopped
+// refreshing its lease is taken over.
+func TestFSSharedLockExpiry(t *testing.T) {
+	fsPath, err := ioutil.TempDir("", "minio-shared-lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(fsPath)
+
+	locker, err := newFSSharedLocker(fsPath, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	locker.clockSkew = 100 * time.Millisecond
+
+	ctx := context.Background()
+	if _, locked := locker.lock(ctx, "bucket/object", fsLease{OpsID: "1"}, false, time.Second); !locked {
+		t.Fatal("Unable to take the write lock")
+	}
+
+	// The first lease is never refreshed.
+	start := time.Now()
+	leasePath, locked := locker.lock(ctx, "bucket/object", fsLease{OpsID: "2"}, false, time.Second)
+	if !locked {
+		t.Fatal("Expected the expired lease to be taken over")
+	}
+	if time.Since(start) < 200*time.Millisecond {
+		t.Fatal("Expected the lease to be taken over only after it expired")
+	}
+	locker.unlock(leasePath)
+}
+
+// Tests that an expired lease is only removed by one server, and
+// not once it was taken over or refreshed by another server.
+func TestFSSharedLockExpireLease(t *testing.T) {
+	fsPath, err := ioutil.TempDir("", "minio-shared-lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(fsPath)
+
+	locker, err := newFSSharedLocker(fsPath, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leasePath := pathJoin(locker.resourceDir("bucket/object"), fsSharedLockWriter)
+	expired := time.Now().Add(-2 * time.Minute)
+
+	// Another server took the expired lease of "1" over meanwhile.
+	if err = locker.createLease(leasePath, fsLease{OpsID: "2"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chtimes(leasePath, expired, expired); err != nil {
+		t.Fatal(err)
+	}
+	if locker.expireLease(leasePath, "1") {
+		t.Fatal("Expected the lease of another operation to be kept")
+	}
+
+	// The expired lease was refreshed meanwhile.
+	if err = os.Chtimes(leasePath, time.Now(), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if locker.expireLease(leasePath, "2") {
+		t.Fatal("Expected the refreshed lease to be kept")
+	}
+	if lease, _, err := locker.readLease(leasePath); err != nil || lease.OpsID != "2" {
+		t.Fatalf("Expected the lease to be restored, got %v, %v", lease, err)
+	}
+
+	if err = os.Chtimes(leasePath, expired, expired); err != nil {
+		t.Fatal(err)
+	}
+	if locker.leaseAlive(leasePath) {
+		t.Fatal("Expected the expired lease to be removed")
+	}
+	entries, err := readDir(locker.resourceDir("bucket/object"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("Expected no lease files to be left, got %v", entries)
+	}
+}
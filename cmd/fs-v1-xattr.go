@@ -0,0 +1,163 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+
+	humanize "github.com/dustin/go-humanize"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/minio/minio/cmd/logger"
+	xioutil "github.com/minio/minio/pkg/ioutil"
+)
+
+// Extended attribute of the object files holding their metadata,
+// instead of `fs.json`, when MINIO_FS_XATTR_METADATA is enabled.
+const fsMetaXattr = "user.minio.fs.json"
+
+// Size of the value set when checking for extended attributes support,
+// enough for the metadata of regular objects including the 2 KiB of
+// user-defined metadata S3 allows. Larger metadata, e.g. the parts of
+// multipart objects, falls back to `fs.json`.
+const fsMetaXattrProbeSize = 3 * humanize.KiByte
+
+var (
+	errXattrNotFound     = errors.New("extended attribute not found")
+	errXattrNotSupported = errors.New("extended attributes are not supported by the filesystem")
+	errXattrTooLarge     = errors.New("extended attribute value is too large for the filesystem")
+)
+
+// checkFSXattrSupport - verifies that user extended attributes
+// can be set on the files of the backend filesystem.
+func checkFSXattrSupport(fsPath, fsUUID string) error {
+	probePath := pathJoin(fsPath, minioMetaTmpBucket, fsUUID, mustGetUUID())
+	f, err := os.Create(probePath)
+	if err != nil {
+		return err
+	}
+	f.Close()
+	defer os.Remove(probePath)
+
+	if err = fsSetXattr(probePath, fsMetaXattr, bytes.Repeat([]byte{'x'}, fsMetaXattrProbeSize)); err != nil {
+		return err
+	}
+	return fsRemoveXattr(probePath, fsMetaXattr)
+}
+
+// writeMetaXattr - saves the metadata of bucket/object in the extended
+// attribute of filePath, the object file or its temporary file, the
+// caller must hold the object lock. Metadata too large for an extended
+// attribute is saved in the `fs.json` of the object instead, which
+// readMetaXattr falls back to.
+func (fs *FSObjects) writeMetaXattr(ctx context.Context, bucket, object, filePath string, fsMeta fsMetaV1) error {
+	var json = jsoniter.ConfigCompatibleWithStandardLibrary
+	fsMetaBuf, err := json.Marshal(fsMeta)
+	if err != nil {
+		return err
+	}
+	if err = fsSetXattr(filePath, fsMetaXattr, fsMetaBuf); err != errXattrTooLarge {
+		return err
+	}
+
+	// A previous extended attribute would take precedence.
+	if err = fsRemoveXattr(filePath, fsMetaXattr); err != nil && err != errXattrNotFound {
+		return err
+	}
+	tmpPath := pathJoin(fs.fsPath, minioMetaTmpBucket, fs.fsUUID, mustGetUUID())
+	defer fsRemoveFile(ctx, tmpPath)
+	if _, err = fsCreateFile(ctx, tmpPath, bytes.NewReader(fsMetaBuf), int64(len(fsMetaBuf))); err != nil {
+		return err
+	}
+	fsMetaPath := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, bucket, object, fs.metaJSONFile)
+	return fsRenameFile(ctx, tmpPath, fsMetaPath)
+}
+
+// readMetaXattr - reads the metadata from the extended attribute of
+// the object file. Objects without one, i.e. pre-existing data, fall
+// back to their `fs.json` if any, else to the default metadata.
+func (fs *FSObjects) readMetaXattr(ctx context.Context, bucket, object string) (fsMetaV1, error) {
+	fsMetaBuf, err := fsGetXattr(pathJoin(fs.fsPath, bucket, object), fsMetaXattr)
+	if err == errXattrNotFound {
+		fsMetaPath := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, bucket, object, fs.metaJSONFile)
+		fsMetaBuf, err = xioutil.ReadFile(fsMetaPath)
+		if err != nil {
+			// Ignore if `fs.json` is not available, this is true for pre-existing data.
+			return fs.defaultFsJSON(object), nil
+		}
+	}
+	if err != nil {
+		if err != errFileNotFound {
+			logger.LogIf(ctx, err)
+		}
+		return fsMetaV1{}, err
+	}
+
+	var fsMeta fsMetaV1
+	var json = jsoniter.ConfigCompatibleWithStandardLibrary
+	if err = json.Unmarshal(fsMetaBuf, &fsMeta); err != nil || !isFSMetaValid(fsMeta.Version) {
+		// For any error to read fsMeta, set default ETag and proceed.
+		return fs.defaultFsJSON(object), nil
+	}
+	if fsMeta.Meta == nil {
+		fsMeta.Meta = make(map[string]string)
+	}
+	return fsMeta, nil
+}
+
+// getObjectInfoXattr - constructs ObjectInfo from the metadata
+// in the extended attribute of the object file.
+func (fs *FSObjects) getObjectInfoXattr(ctx context.Context, bucket, object string) (oi ObjectInfo, err error) {
+	fsMeta, err := fs.readMetaXattr(ctx, bucket, object)
+	if err != nil {
+		return oi, err
+	}
+
+	// Stat the file to get file size.
+	fi, err := fsStatFile(ctx, pathJoin(fs.fsPath, bucket, object))
+	if err != nil {
+		return oi, err
+	}
+
+	return fsMeta.ToObjectInfo(bucket, object, fi), nil
+}
+
+// updateMetaXattr - applies the update to the metadata in the extended
+// attribute of the object file, the caller must hold the object lock.
+func (fs *FSObjects) updateMetaXattr(ctx context.Context, bucket, object string, update func(fsMeta *fsMetaV1)) (oi ObjectInfo, err error) {
+	fsMeta, err := fs.readMetaXattr(ctx, bucket, object)
+	if err != nil {
+		return oi, err
+	}
+
+	update(&fsMeta)
+
+	fsObjPath := pathJoin(fs.fsPath, bucket, object)
+	if err = fs.writeMetaXattr(ctx, bucket, object, fsObjPath, fsMeta); err != nil {
+		logger.LogIf(ctx, err)
+		return oi, err
+	}
+
+	fi, err := fsStatFile(ctx, fsObjPath)
+	if err != nil {
+		return oi, err
+	}
+
+	return fsMeta.ToObjectInfo(bucket, object, fi), nil
+}
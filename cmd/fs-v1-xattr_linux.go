@@ -0,0 +1,71 @@
+// +build linux
+
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+func xattrErr(err error) error {
+	switch err {
+	case unix.ENODATA:
+		return errXattrNotFound
+	case unix.ENOTSUP:
+		return errXattrNotSupported
+	case unix.E2BIG, unix.ENOSPC:
+		// ext4 reports values larger than a block as ENOSPC.
+		return errXattrTooLarge
+	case unix.ENOENT, unix.ENOTDIR:
+		return errFileNotFound
+	case unix.EACCES:
+		return errFileAccessDenied
+	}
+	return err
+}
+
+// fsGetXattr - returns the value of the extended attribute of the file.
+func fsGetXattr(filePath, name string) ([]byte, error) {
+	for {
+		// Find out the size of the value first.
+		size, err := unix.Getxattr(filePath, name, nil)
+		if err != nil {
+			return nil, xattrErr(err)
+		}
+		buf := make([]byte, size)
+		n, err := unix.Getxattr(filePath, name, buf)
+		if err == unix.ERANGE {
+			// The value grew meanwhile.
+			continue
+		}
+		if err != nil {
+			return nil, xattrErr(err)
+		}
+		return buf[:n], nil
+	}
+}
+
+// fsSetXattr - sets the extended attribute of the file.
+func fsSetXattr(filePath, name string, value []byte) error {
+	return xattrErr(unix.Setxattr(filePath, name, value, 0))
+}
+
+// fsRemoveXattr - removes the extended attribute of the file.
+func fsRemoveXattr(filePath, name string) error {
+	return xattrErr(unix.Removexattr(filePath, name))
+}
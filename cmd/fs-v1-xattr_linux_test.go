@@ -0,0 +1,166 @@
+// +build linux
+
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	humanize "github.com/dustin/go-humanize"
+	xhttp "github.com/minio/minio/cmd/http"
+)
+
+// Tests that object metadata is kept in extended attributes
+// of the object files when xattr metadata is enabled.
+func TestFSXattrMetadata(t *testing.T) {
+	disk := filepath.Join(globalTestTmpDir, "minio-"+nextSuffix())
+	defer os.RemoveAll(disk)
+
+	obj := initFSObjects(disk, t)
+	fs := obj.(*FSObjects)
+	if err := checkFSXattrSupport(fs.fsPath, fs.fsUUID); err != nil {
+		t.Skip("Extended attributes are not supported by the test filesystem", err)
+	}
+	fs.xattrMeta = true
+
+	bucketName := "bucket"
+	objectName := "1/2/object"
+	if err := obj.MakeBucketWithLocation(GlobalContext, bucketName, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := ObjectOptions{UserDefined: map[string]string{"x-amz-meta-key": "value"}}
+	objInfo, err := obj.PutObject(GlobalContext, bucketName, objectName, mustGetPutObjReader(t, bytes.NewReader([]byte("abcd")), int64(len("abcd")), "", ""), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fsMetaPath := pathJoin(disk, minioMetaBucket, bucketMetaPrefix, bucketName, objectName, fs.metaJSONFile)
+	if _, err = os.Stat(fsMetaPath); !os.IsNotExist(err) {
+		t.Fatal("Expected no fs.json to be created", err)
+	}
+
+	oi, err := obj.GetObjectInfo(GlobalContext, bucketName, objectName, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oi.ETag != objInfo.ETag || oi.UserDefined["x-amz-meta-key"] != "value" {
+		t.Fatalf("Unexpected object info %#v", oi)
+	}
+
+	if _, err = obj.PutObjectTags(GlobalContext, bucketName, objectName, "key=value", ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	oi, err = obj.GetObjectInfo(GlobalContext, bucketName, objectName, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oi.UserTags != "key=value" || oi.ETag != objInfo.ETag {
+		t.Fatalf("Unexpected object info %#v", oi)
+	}
+
+	// Objects without the extended attribute fall back to `fs.json`.
+	if err = fsRemoveXattr(pathJoin(disk, bucketName, objectName), fsMetaXattr); err != nil {
+		t.Fatal(err)
+	}
+	oi, err = obj.GetObjectInfo(GlobalContext, bucketName, objectName, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oi.ETag != defaultEtag || oi.UserDefined[xhttp.AmzObjectTagging] != "" {
+		t.Fatalf("Unexpected object info %#v", oi)
+	}
+
+	if _, err = obj.DeleteObject(GlobalContext, bucketName, objectName, ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = obj.GetObjectInfo(GlobalContext, bucketName, objectName, ObjectOptions{}); !isSameType(err, ObjectNotFound{}) {
+		t.Fatal("Unexpected error: ", err)
+	}
+}
+
+// Tests that metadata too large for an extended attribute,
+// e.g. of multipart objects with many parts, falls back to
+// `fs.json`.
+func TestFSXattrMetadataTooLarge(t *testing.T) {
+	disk := filepath.Join(globalTestTmpDir, "minio-"+nextSuffix())
+	defer os.RemoveAll(disk)
+
+	obj := initFSObjects(disk, t)
+	fs := obj.(*FSObjects)
+	if err := checkFSXattrSupport(fs.fsPath, fs.fsUUID); err != nil {
+		t.Skip("Extended attributes are not supported by the test filesystem", err)
+	}
+	fs.xattrMeta = true
+
+	bucketName := "bucket"
+	objectName := "object"
+	if err := obj.MakeBucketWithLocation(GlobalContext, bucketName, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := obj.PutObject(GlobalContext, bucketName, objectName, mustGetPutObjReader(t, bytes.NewReader([]byte("abcd")), int64(len("abcd")), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Well beyond the 64 KiB limit of extended attributes.
+	parts := make([]ObjectPartInfo, globalMaxPartID)
+	for i := range parts {
+		parts[i] = ObjectPartInfo{Number: i + 1, ETag: mustGetUUID(), Size: 5 * humanize.MiByte, ActualSize: 5 * humanize.MiByte}
+	}
+	if _, err := fs.updateMetaXattr(GlobalContext, bucketName, objectName, func(fsMeta *fsMetaV1) {
+		fsMeta.Parts = parts
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	fsMetaPath := pathJoin(disk, minioMetaBucket, bucketMetaPrefix, bucketName, objectName, fs.metaJSONFile)
+	if _, err := os.Stat(fsMetaPath); err != nil {
+		t.Fatal("Expected fs.json to be created", err)
+	}
+	oi, err := obj.GetObjectInfo(GlobalContext, bucketName, objectName, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(oi.Parts) != len(parts) || oi.Parts[len(parts)-1].ETag != parts[len(parts)-1].ETag {
+		t.Fatalf("Expected %d parts, got %d", len(parts), len(oi.Parts))
+	}
+
+	// Metadata fitting again goes back to the extended attribute.
+	if _, err = fs.updateMetaXattr(GlobalContext, bucketName, objectName, func(fsMeta *fsMetaV1) {
+		fsMeta.Parts = nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	oi, err = obj.GetObjectInfo(GlobalContext, bucketName, objectName, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(oi.Parts) != 0 {
+		t.Fatalf("Expected no parts, got %d", len(oi.Parts))
+	}
+
+	if _, err = obj.DeleteObject(GlobalContext, bucketName, objectName, ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(fsMetaPath); !os.IsNotExist(err) {
+		t.Fatal("Expected fs.json to be removed", err)
+	}
+}
@@ -0,0 +1,33 @@
+// +build !linux
+
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+// Extended attributes are only supported on linux.
+
+func fsGetXattr(filePath, name string) ([]byte, error) {
+	return nil, errXattrNotSupported
+}
+
+func fsSetXattr(filePath, name string, value []byte) error {
+	return errXattrNotSupported
+}
+
+func fsRemoveXattr(filePath, name string) error {
+	return errXattrNotSupported
+}
@@ -81,6 +81,14 @@ type FSObjects struct {
 
 	// To manage the appendRoutine go-routines
 	nsMutex *nsLockMap
+
+	// Namespace locks shared with the other servers
+	// using the same backend filesystem, if enabled.
+	sharedLocks *fsSharedLocker
+
+	// Object metadata is stored in extended attributes
+	// of the object files instead of `fs.json`.
+	xattrMeta bool
 }
 
 // Represents the background append file.
@@ -118,6 +126,20 @@ func initMetaVolumeFS(fsPath, fsUUID string) error {
 
 // NewFSObjectLayer - initialize new fs object layer.
 func NewFSObjectLayer(fsPath string) (ObjectLayer, error) {
+	return newFSObjectLayer(fsPath, 0)
+}
+
+// NewSharedFSObjectLayer - initialize new fs object layer which shares
+// its namespace locks with the other servers using the same backend
+// filesystem through lease files valid for the given duration.
+func NewSharedFSObjectLayer(fsPath string, lockLease time.Duration) (ObjectLayer, error) {
+	if lockLease <= 0 {
+		return nil, errInvalidArgument
+	}
+	return newFSObjectLayer(fsPath, lockLease)
+}
+
+func newFSObjectLayer(fsPath string, lockLease time.Duration) (ObjectLayer, error) {
 	ctx := GlobalContext
 	if fsPath == "" {
 		return nil, errInvalidArgument
@@ -149,6 +171,19 @@ func NewFSObjectLayer(fsPath string) (ObjectLayer, error) {
 		return nil, err
 	}
 
+	var sharedLocks *fsSharedLocker
+	if lockLease > 0 {
+		if sharedLocks, err = newFSSharedLocker(fsPath, lockLease); err != nil {
+			return nil, err
+		}
+	}
+
+	if globalFSXattrMeta {
+		if err = checkFSXattrSupport(fsPath, fsUUID); err != nil {
+			return nil, config.ErrUnableToWriteInBackend(err).Hint("MINIO_FS_XATTR_METADATA requires a filesystem with user extended attributes enabled")
+		}
+	}
+
 	// Initialize `format.json`, this function also returns.
 	rlk, err := initFormatFS(ctx, fsPath)
 	if err != nil {
@@ -167,6 +202,8 @@ func NewFSObjectLayer(fsPath string) (ObjectLayer, error) {
 		listPool:      NewTreeWalkPool(globalLookupTimeout),
 		appendFileMap: make(map[string]*fsAppendFile),
 		diskMount:     mountinfo.IsLikelyMountPoint(fsPath),
+		sharedLocks:   sharedLocks,
+		xattrMeta:     globalFSXattrMeta,
 	}
 
 	// Once the filesystem has initialized hold the read lock for
@@ -175,7 +212,7 @@ func NewFSObjectLayer(fsPath string) (ObjectLayer, error) {
 	// or cause changes on backend format.
 	fs.fsFormatRlk = rlk
 
-	go fs.cleanupStaleUploads(ctx, GlobalStaleUploadsCleanupInterval, GlobalStaleUploadsExpiry)
+	go fs.cleanupStaleUploads(ctx, GlobalStaleUploadsCleanupInterval)
 	go intDataUpdateTracker.start(ctx, fsPath)
 
 	// Return successfully initialized object layer.
@@ -184,6 +221,9 @@ func NewFSObjectLayer(fsPath string) (ObjectLayer, error) {
 
 // NewNSLock - initialize a new namespace RWLocker instance.
 func (fs *FSObjects) NewNSLock(bucket string, objects ...string) RWLocker {
+	if fs.sharedLocks != nil {
+		return fs.sharedLocks.newLock(fs.nsMutex, bucket, objects...)
+	}
 	// lockers are explicitly 'nil' for FS mode since there are only local lockers
 	return fs.nsMutex.NewNSLock(nil, bucket, objects...)
 }
@@ -283,13 +323,13 @@ func (fs *FSObjects) NSScanner(ctx context.Context, bf *bloomFilter, updates cha
 		cache.Info.BloomFilter = nil
 
 		if cache.root() == nil {
-			if intDataUpdateTracker.debug {
+			if debugLogEnabled(debugLogScanner) {
 				logger.Info(color.Green("NSScanner:") + " No root added. Adding empty")
 			}
 			cache.replace(cache.Info.Name, dataUsageRoot, dataUsageEntry{})
 		}
 		if cache.Info.LastUpdate.After(bCache.Info.LastUpdate) {
-			if intDataUpdateTracker.debug {
+			if debugLogEnabled(debugLogScanner) {
 				logger.Info(color.Green("NSScanner:")+" Saving bucket %q cache with %d entries", b.Name, len(cache.Cache))
 			}
 			logger.LogIf(ctx, cache.save(ctx, fs, path.Join(b.Name, dataUsageCacheName)))
@@ -299,7 +339,7 @@ func (fs *FSObjects) NSScanner(ctx context.Context, bf *bloomFilter, updates cha
 		cl := cache.clone()
 		entry := cl.flatten(*cl.root())
 		totalCache.replace(cl.Info.Name, dataUsageRoot, entry)
-		if intDataUpdateTracker.debug {
+		if debugLogEnabled(debugLogScanner) {
 			logger.Info(color.Green("NSScanner:")+" Saving totals cache with %d entries", len(totalCache.Cache))
 		}
 		totalCache.Info.LastUpdate = time.Now()
@@ -320,7 +360,7 @@ func (fs *FSObjects) scanBucket(ctx context.Context, bucket string, cache dataUs
 	// Check if the current bucket has a configured lifecycle policy
 	lc, err := globalLifecycleSys.Get(bucket)
 	if err == nil && lc.HasActiveRules("", true) {
-		if intDataUpdateTracker.debug {
+		if debugLogEnabled(debugLogScanner) {
 			logger.Info(color.Green("scanBucket:") + " lifecycle: Active rules found")
 		}
 		cache.Info.lifeCycle = lc
@@ -329,9 +369,20 @@ func (fs *FSObjects) scanBucket(ctx context.Context, bucket string, cache dataUs
 	// Load bucket info.
 	cache, err = scanDataFolder(ctx, fs.fsPath, cache, func(item scannerItem) (sizeSummary, error) {
 		bucket, object := item.bucket, item.objectPath()
-		fsMetaBytes, err := xioutil.ReadFile(pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, bucket, object, fs.metaJSONFile))
+		var fsMetaBytes []byte
+		var err error
+		if fs.xattrMeta {
+			fsMetaBytes, err = fsGetXattr(item.Path, fsMetaXattr)
+			if err == errXattrNotFound {
+				// Pre-existing data, fall back to `fs.json`.
+				err = nil
+			}
+		}
+		if len(fsMetaBytes) == 0 && err == nil {
+			fsMetaBytes, err = xioutil.ReadFile(pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, bucket, object, fs.metaJSONFile))
+		}
 		if err != nil && !osIsNotExist(err) {
-			if intDataUpdateTracker.debug {
+			if debugLogEnabled(debugLogScanner) {
 				logger.Info(color.Green("scanBucket:")+" object return unexpected error: %v/%v: %w", item.bucket, item.objectPath(), err)
 			}
 			return sizeSummary{}, errSkipFile
@@ -352,14 +403,14 @@ func (fs *FSObjects) scanBucket(ctx context.Context, bucket string, cache dataUs
 		// Stat the file.
 		fi, fiErr := os.Stat(item.Path)
 		if fiErr != nil {
-			if intDataUpdateTracker.debug {
+			if debugLogEnabled(debugLogScanner) {
 				logger.Info(color.Green("scanBucket:")+" object path missing: %v: %w", item.Path, fiErr)
 			}
 			return sizeSummary{}, errSkipFile
 		}
 
 		oi := fsMeta.ToObjectInfo(bucket, object, fi)
-		sz := item.applyActions(ctx, fs, actionMeta{oi: oi})
+		_, sz := item.applyActions(ctx, fs, actionMeta{oi: oi})
 		if sz >= 0 {
 			return sizeSummary{totalSize: sz}, nil
 		}
@@ -625,6 +676,14 @@ func (fs *FSObjects) CopyObject(ctx context.Context, srcBucket, srcObject, dstBu
 		return oi, toObjectErr(err, srcBucket)
 	}
 
+	if cpSrcDstSame && srcInfo.metadataOnly && fs.xattrMeta {
+		oi, err = fs.updateMetaXattr(ctx, srcBucket, srcObject, func(fsMeta *fsMetaV1) {
+			fsMeta.Meta = cloneMSS(srcInfo.UserDefined)
+			fsMeta.Meta["etag"] = srcInfo.ETag
+		})
+		return oi, toObjectErr(err, srcBucket, srcObject)
+	}
+
 	if cpSrcDstSame && srcInfo.metadataOnly {
 		fsMetaPath := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, srcBucket, srcObject, fs.metaJSONFile)
 		wlk, err := fs.rwPool.Write(fsMetaPath)
@@ -731,7 +790,7 @@ func (fs *FSObjects) GetObjectNInfo(ctx context.Context, bucket, object string,
 	}
 	// Take a rwPool lock for NFS gateway type deployment
 	rwPoolUnlocker := func() {}
-	if bucket != minioMetaBucket && lockType != noLock {
+	if bucket != minioMetaBucket && lockType != noLock && !fs.xattrMeta {
 		fsMetaPath := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, bucket, object, fs.metaJSONFile)
 		_, err = fs.rwPool.Open(fsMetaPath)
 		if err != nil && err != errFileNotFound {
@@ -801,7 +860,7 @@ func (fs *FSObjects) getObject(ctx context.Context, bucket, object string, offse
 		return toObjectErr(err, bucket, object)
 	}
 
-	if bucket != minioMetaBucket {
+	if bucket != minioMetaBucket && !fs.xattrMeta {
 		fsMetaPath := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, bucket, object, fs.metaJSONFile)
 		if lock {
 			_, err = fs.rwPool.Open(fsMetaPath)
@@ -888,6 +947,10 @@ func (fs *FSObjects) getObjectInfoNoFSLock(ctx context.Context, bucket, object s
 		return fsMeta.ToObjectInfo(bucket, object, fi), nil
 	}
 
+	if fs.xattrMeta {
+		return fs.getObjectInfoXattr(ctx, bucket, object)
+	}
+
 	fsMetaPath := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, bucket, object, fs.metaJSONFile)
 	// Read `fs.json` to perhaps contend with
 	// parallel Put() operations.
@@ -943,6 +1006,10 @@ func (fs *FSObjects) getObjectInfo(ctx context.Context, bucket, object string) (
 		return fsMeta.ToObjectInfo(bucket, object, fi), nil
 	}
 
+	if fs.xattrMeta {
+		return fs.getObjectInfoXattr(ctx, bucket, object)
+	}
+
 	fsMetaPath := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, bucket, object, fs.metaJSONFile)
 	// Read `fs.json` to perhaps contend with
 	// parallel Put() operations.
@@ -1136,7 +1203,7 @@ func (fs *FSObjects) putObject(ctx context.Context, bucket string, object string
 	}
 
 	var wlk *lock.LockedFile
-	if bucket != minioMetaBucket {
+	if bucket != minioMetaBucket && !fs.xattrMeta {
 		bucketMetaDir := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix)
 		fsMetaPath := pathJoin(bucketMetaDir, bucket, object, fs.metaJSONFile)
 		wlk, err = fs.rwPool.Write(fsMetaPath)
@@ -1181,6 +1248,12 @@ func (fs *FSObjects) putObject(ctx context.Context, bucket string, object string
 		return ObjectInfo{}, toObjectErr(err, bucket, object)
 	}
 	fsMeta.Meta["etag"] = r.MD5CurrentHexString()
+	// Save the checksums verified while reading the data.
+	for key := range trailerChecksums {
+		if value, ok := opts.UserDefined[key]; ok {
+			fsMeta.Meta[key] = value
+		}
+	}
 
 	// Should return IncompleteBody{} error when reader has fewer
 	// bytes than specified in request header.
@@ -1188,13 +1261,21 @@ func (fs *FSObjects) putObject(ctx context.Context, bucket string, object string
 		return ObjectInfo{}, IncompleteBody{Bucket: bucket, Object: object}
 	}
 
+	if bucket != minioMetaBucket && fs.xattrMeta {
+		// Metadata is renamed along with the object.
+		if err = fs.writeMetaXattr(ctx, bucket, object, fsTmpObjPath, fsMeta); err != nil {
+			logger.LogIf(ctx, err)
+			return ObjectInfo{}, toObjectErr(err, bucket, object)
+		}
+	}
+
 	// Entire object was written to the temp location, now it's safe to rename it to the actual location.
 	fsNSObjPath := pathJoin(fs.fsPath, bucket, object)
 	if err = fsRenameFile(ctx, fsTmpObjPath, fsNSObjPath); err != nil {
 		return ObjectInfo{}, toObjectErr(err, bucket, object)
 	}
 
-	if bucket != minioMetaBucket {
+	if bucket != minioMetaBucket && !fs.xattrMeta {
 		// Write FS metadata after a successful namespace operation.
 		if _, err = fsMeta.WriteTo(wlk); err != nil {
 			return ObjectInfo{}, toObjectErr(err, bucket, object)
@@ -1274,7 +1355,7 @@ func (fs *FSObjects) DeleteObject(ctx context.Context, bucket, object string, op
 
 	minioMetaBucketDir := pathJoin(fs.fsPath, minioMetaBucket)
 	fsMetaPath := pathJoin(minioMetaBucketDir, bucketMetaPrefix, bucket, object, fs.metaJSONFile)
-	if bucket != minioMetaBucket {
+	if bucket != minioMetaBucket && !fs.xattrMeta {
 		rwlk, err = fs.rwPool.Write(fsMetaPath)
 		if err != nil && err != errFileNotFound {
 			logger.LogIf(ctx, err)
@@ -1296,7 +1377,8 @@ func (fs *FSObjects) DeleteObject(ctx context.Context, bucket, object string, op
 	}
 
 	if bucket != minioMetaBucket {
-		// Delete the metadata object.
+		// Delete the metadata object, it may be left
+		// over from pre-existing data in xattr mode.
 		err = fsDeleteFile(ctx, minioMetaBucketDir, fsMetaPath)
 		if err != nil && err != errFileNotFound {
 			return objInfo, toObjectErr(err, bucket, object)
@@ -1350,6 +1432,14 @@ func (fs *FSObjects) isObjectDir(bucket, prefix string) bool {
 // getObjectETag is a helper function, which returns only the md5sum
 // of the file on the disk.
 func (fs *FSObjects) getObjectETag(ctx context.Context, bucket, entry string, lock bool) (string, error) {
+	if fs.xattrMeta {
+		fsMeta, err := fs.readMetaXattr(ctx, bucket, entry)
+		if err != nil {
+			return "", toObjectErr(err, bucket, entry)
+		}
+		return extractETag(fsMeta.Meta), nil
+	}
+
 	fsMetaPath := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, bucket, entry, fs.metaJSONFile)
 
 	var reader io.Reader
@@ -1462,6 +1552,27 @@ func (fs *FSObjects) PutObjectTags(ctx context.Context, bucket, object string, t
 		}
 	}
 
+	if fs.xattrMeta {
+		// Lock the object, there is no `fs.json` to synchronize on.
+		lk := fs.NewNSLock(bucket, object)
+		ctx, err := lk.GetLock(ctx, globalOperationTimeout)
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		defer lk.Unlock()
+
+		oi, err := fs.updateMetaXattr(ctx, bucket, object, func(fsMeta *fsMetaV1) {
+			// clean fsMeta.Meta of tag key, before updating the new tags
+			delete(fsMeta.Meta, xhttp.AmzObjectTagging)
+
+			// Do not update for empty tags
+			if tags != "" {
+				fsMeta.Meta[xhttp.AmzObjectTagging] = tags
+			}
+		})
+		return oi, toObjectErr(err, bucket, object)
+	}
+
 	fsMetaPath := pathJoin(fs.fsPath, minioMetaBucket, bucketMetaPrefix, bucket, object, fs.metaJSONFile)
 	fsMeta := fsMetaV1{}
 	wlk, err := fs.rwPool.Write(fsMetaPath)
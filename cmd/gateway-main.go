@@ -262,8 +262,9 @@ func StartGateway(ctx *cli.Context, gw Gateway) {
 	// Add server metrics router
 	registerMetricsRouter(router)
 
-	// Register web router when its enabled.
-	if globalBrowserEnabled {
+	// Register web router when its enabled, unless it is
+	// served on its own address.
+	if globalBrowserEnabled && globalBrowserAddr == "" {
 		logger.FatalIf(registerWebRouter(router), "Unable to configure web browser")
 	}
 
@@ -290,6 +291,7 @@ func StartGateway(ctx *cli.Context, gw Gateway) {
 	globalObjLayerMutex.Lock()
 	globalHTTPServer = httpServer
 	globalObjLayerMutex.Unlock()
+	startBrowserServer()
 
 	signal.Notify(globalOSSignalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT)
 
@@ -349,6 +351,7 @@ func StartGateway(ctx *cli.Context, gw Gateway) {
 			logger.Fatal(err, "Unable to list buckets")
 		}
 		initFederatorBackend(buckets, newObject)
+		initBucketDNSHealth(GlobalContext)
 	}
 
 	// Verify if object layer supports
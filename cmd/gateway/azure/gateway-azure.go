@@ -42,6 +42,7 @@ import (
 	"github.com/minio/cli"
 	miniogopolicy "github.com/minio/minio-go/v7/pkg/policy"
 	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/cmd/config"
 	"github.com/minio/minio/cmd/logger"
 	"github.com/minio/minio/pkg/auth"
 	"github.com/minio/minio/pkg/bucket/policy"
@@ -58,6 +59,10 @@ const (
 	azureMarkerPrefix             = "{minio}"
 	metadataPartNamePrefix        = minio.GatewayMinioSysTmp + "multipart/v1/%s.%x"
 	maxPartsCount                 = 10000
+
+	// Metadata of the blobs which are the directories of
+	// hierarchical namespace (ADLS Gen2) accounts.
+	azureHNSFolderMetadata = "hdi_isfolder"
 )
 
 var (
@@ -95,6 +100,13 @@ EXAMPLES:
      {{.Prompt}} {{.EnvVarSetCommand}} MINIO_CACHE_WATERMARK_HIGH{{.AssignmentOperator}}85
      {{.Prompt}} {{.HelpName}}
 
+  3. Start minio gateway server for Azure Blob Storage backend authenticating with a SAS token.
+     {{.Prompt}} {{.EnvVarSetCommand}} MINIO_ROOT_USER{{.AssignmentOperator}}accesskey
+     {{.Prompt}} {{.EnvVarSetCommand}} MINIO_ROOT_PASSWORD{{.AssignmentOperator}}secretkey
+     {{.Prompt}} {{.EnvVarSetCommand}} AZURE_STORAGE_ACCOUNT{{.AssignmentOperator}}azureaccountname
+     {{.Prompt}} {{.EnvVarSetCommand}} AZURE_STORAGE_SAS_TOKEN{{.AssignmentOperator}}"sv=2019-12-12&ss=b&srt=sco&sp=rwdlac&se=2022-01-01T00:00:00Z&sig=..."
+     {{.Prompt}} {{.HelpName}}
+
 `
 
 	minio.RegisterGatewayCommand(cli.Command{
@@ -141,15 +153,19 @@ func (g *Azure) Name() string {
 func (g *Azure) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, error) {
 	var err error
 
+	sasToken := strings.TrimPrefix(env.Get("AZURE_STORAGE_SAS_TOKEN", ""), "?")
+	accountName := env.Get("AZURE_STORAGE_ACCOUNT", creds.AccessKey)
+
 	// Override credentials from the Azure storage environment variables if specified
-	if acc, key := env.Get("AZURE_STORAGE_ACCOUNT", creds.AccessKey), env.Get("AZURE_STORAGE_KEY", creds.SecretKey); acc != "" && key != "" {
+	if acc, key := accountName, env.Get("AZURE_STORAGE_KEY", creds.SecretKey); sasToken == "" && acc != "" && key != "" {
 		creds, err = auth.CreateCredentials(acc, key)
 		if err != nil {
 			return nil, err
 		}
+		accountName = creds.AccessKey
 	}
 
-	endpointURL, err := parseStorageEndpoint(g.host, creds.AccessKey)
+	endpointURL, err := parseStorageEndpoint(g.host, accountName)
 	if err != nil {
 		return nil, err
 	}
@@ -168,12 +184,22 @@ func (g *Azure) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, erro
 		return nil, err
 	}
 
-	credential, err := azblob.NewSharedKeyCredential(creds.AccessKey, creds.SecretKey)
-	if err != nil {
-		if _, ok := err.(base64.CorruptInputError); ok {
-			return &azureObjects{}, errors.New("invalid Azure credentials")
+	var credential azblob.Credential
+	if sasToken != "" {
+		// Requests are authorized by the SAS token in their URL.
+		if err = parseSASToken(sasToken); err != nil {
+			return &azureObjects{}, err
+		}
+		endpointURL.RawQuery = sasToken
+		credential = azblob.NewAnonymousCredential()
+	} else {
+		credential, err = azblob.NewSharedKeyCredential(creds.AccessKey, creds.SecretKey)
+		if err != nil {
+			if _, ok := err.(base64.CorruptInputError); ok {
+				return &azureObjects{}, errors.New("invalid Azure credentials")
+			}
+			return &azureObjects{}, err
 		}
-		return &azureObjects{}, err
 	}
 
 	metrics := minio.NewMetrics()
@@ -203,14 +229,63 @@ func (g *Azure) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, erro
 
 	client := azblob.NewServiceURL(*endpointURL, pipeline)
 
+	hns, err := azureHierarchicalNamespace(minio.GlobalContext, client)
+	if err != nil {
+		return nil, err
+	}
+
 	return &azureObjects{
 		endpoint:   endpointURL,
 		httpClient: httpClient,
 		client:     client,
 		metrics:    metrics,
+		hns:        hns,
 	}, nil
 }
 
+// parseSASToken validates the SAS token, given as the query string
+// of the URL it authorizes.
+func parseSASToken(sasToken string) error {
+	values, err := url.ParseQuery(sasToken)
+	if err != nil {
+		return fmt.Errorf("invalid AZURE_STORAGE_SAS_TOKEN: %w", err)
+	}
+	if values.Get("sig") == "" {
+		return errors.New("invalid AZURE_STORAGE_SAS_TOKEN: missing signature")
+	}
+	return nil
+}
+
+// azureHierarchicalNamespace returns whether the storage account has a
+// hierarchical namespace (ADLS Gen2), from MINIO_AZURE_HIERARCHICAL_NAMESPACE
+// or else from the account information if allowed by the credentials.
+func azureHierarchicalNamespace(ctx context.Context, client azblob.ServiceURL) (bool, error) {
+	if v := env.Get("MINIO_AZURE_HIERARCHICAL_NAMESPACE", ""); v != "" {
+		hns, err := config.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("invalid MINIO_AZURE_HIERARCHICAL_NAMESPACE: %w", err)
+		}
+		return hns, nil
+	}
+	resp, err := client.GetAccountInfo(ctx)
+	if err != nil {
+		logger.LogIf(ctx, fmt.Errorf("unable to find out if the storage account has a hierarchical namespace, set MINIO_AZURE_HIERARCHICAL_NAMESPACE: %w", err))
+		return false, nil
+	}
+	return resp.Response().Header.Get("x-ms-is-hns-enabled") == "true", nil
+}
+
+// isAzureHNSFolder returns whether the blob metadata is
+// of a directory of a hierarchical namespace account.
+func isAzureHNSFolder(meta azblob.Metadata) bool {
+	for k, v := range meta {
+		if strings.EqualFold(k, azureHNSFolderMetadata) {
+			return strings.EqualFold(v, "true")
+		}
+	}
+	return false
+}
+
 func parseStorageEndpoint(host string, accountName string) (*url.URL, error) {
 	var endpoint string
 
@@ -420,6 +495,9 @@ type azureObjects struct {
 	httpClient *http.Client
 	metrics    *minio.BackendMetrics
 	client     azblob.ServiceURL // Azure sdk client
+	// Storage account with a hierarchical namespace (ADLS Gen2),
+	// where directories are blobs on their own.
+	hns bool
 }
 
 // Convert azure errors to minio object layer errors.
@@ -677,6 +755,7 @@ func (a *azureObjects) ListObjects(ctx context.Context, bucket, prefix, marker,
 		resp, err := containerURL.ListBlobsHierarchySegment(ctx, azureListMarker, delimiter, azblob.ListBlobsSegmentOptions{
 			Prefix:     prefix,
 			MaxResults: int32(maxKeys),
+			Details:    azblob.BlobListingDetails{Metadata: a.hns},
 		})
 		if err != nil {
 			return result, azureToObjectError(err, bucket, prefix)
@@ -687,6 +766,10 @@ func (a *azureObjects) ListObjects(ctx context.Context, bucket, prefix, marker,
 				// We filter out minio.GatewayMinioSysTmp entries in the recursive listing.
 				continue
 			}
+			if a.hns && isAzureHNSFolder(blob.Metadata) {
+				// Directories are listed as prefixes only.
+				continue
+			}
 			if !isAzureMarker(marker) && blob.Name <= marker {
 				// If the application used ListObjectsV1 style marker then we
 				// skip all the entries till we reach the marker.
@@ -845,6 +928,9 @@ func (a *azureObjects) GetObjectInfo(ctx context.Context, bucket, object string,
 	if err != nil {
 		return objInfo, azureToObjectError(err, bucket, object)
 	}
+	if a.hns && isAzureHNSFolder(blob.NewMetadata()) {
+		return objInfo, minio.ObjectNotFound{Bucket: bucket, Object: object}
+	}
 
 	realETag := string(blob.ETag())
 
@@ -988,12 +1074,22 @@ func (a *azureObjects) CopyObject(ctx context.Context, srcBucket, srcObject, des
 // equivalent `BlobURL.Delete`.
 func (a *azureObjects) DeleteObject(ctx context.Context, bucket, object string, opts minio.ObjectOptions) (minio.ObjectInfo, error) {
 	blob := a.client.NewContainerURL(bucket).NewBlobURL(object)
-	_, err := blob.Delete(ctx, azblob.DeleteSnapshotsOptionNone, azblob.BlobAccessConditions{})
-	if err != nil {
+	var err error
+	if a.hns {
+		// Directories are not objects, they are never deleted
+		// by their name.
+		_, err = a.GetObjectInfo(ctx, bucket, object, opts)
+	}
+	if err == nil {
+		_, err = blob.Delete(ctx, azblob.DeleteSnapshotsOptionNone, azblob.BlobAccessConditions{})
 		err = azureToObjectError(err, bucket, object)
+	}
+	if err != nil {
 		if !errors.Is(err, minio.ObjectNotFound{Bucket: bucket, Object: object}) {
 			return minio.ObjectInfo{}, err
 		}
+	} else if a.hns {
+		a.deleteEmptyHNSFolders(ctx, bucket, object)
 	}
 	return minio.ObjectInfo{
 		Bucket: bucket,
@@ -1001,6 +1097,25 @@ func (a *azureObjects) DeleteObject(ctx context.Context, bucket, object string,
 	}, nil
 }
 
+// deleteEmptyHNSFolders deletes the directories of the object left
+// empty once it is deleted. Hierarchical namespace accounts keep them,
+// which lists them as prefixes without objects unlike S3.
+func (a *azureObjects) deleteEmptyHNSFolders(ctx context.Context, bucket, object string) {
+	containerURL := a.client.NewContainerURL(bucket)
+	for dir := path.Dir(object); dir != "." && dir != minio.SlashSeparator; dir = path.Dir(dir) {
+		resp, err := containerURL.ListBlobsHierarchySegment(ctx, azblob.Marker{}, minio.SlashSeparator, azblob.ListBlobsSegmentOptions{
+			Prefix:     dir + minio.SlashSeparator,
+			MaxResults: 1,
+		})
+		if err != nil || len(resp.Segment.BlobItems) > 0 || len(resp.Segment.BlobPrefixes) > 0 {
+			return
+		}
+		if _, err = containerURL.NewBlobURL(dir).Delete(ctx, azblob.DeleteSnapshotsOptionNone, azblob.BlobAccessConditions{}); err != nil {
+			return
+		}
+	}
+}
+
 func (a *azureObjects) DeleteObjects(ctx context.Context, bucket string, objects []minio.ObjectToDelete, opts minio.ObjectOptions) ([]minio.DeletedObject, []error) {
 	errs := make([]error, len(objects))
 	dobjects := make([]minio.DeletedObject, len(objects))
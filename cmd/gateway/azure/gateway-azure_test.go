@@ -55,6 +55,41 @@ func TestParseStorageEndpoint(t *testing.T) {
 	}
 }
 
+func TestParseSASToken(t *testing.T) {
+	testCases := []struct {
+		sasToken  string
+		expectErr bool
+	}{
+		{"sv=2019-12-12&ss=b&srt=sco&sp=rwdlac&se=2022-01-01T00:00:00Z&sig=c2lnbmF0dXJl", false},
+		{"sv=2019-12-12&ss=b&srt=sco&sp=rwdlac&se=2022-01-01T00:00:00Z", true},
+		{"sig=%zz", true},
+	}
+	for i, testCase := range testCases {
+		err := parseSASToken(testCase.sasToken)
+		if (err != nil) != testCase.expectErr {
+			t.Errorf("Test %d: Expected error %v, got %v", i+1, testCase.expectErr, err)
+		}
+	}
+}
+
+func TestIsAzureHNSFolder(t *testing.T) {
+	testCases := []struct {
+		meta     azblob.Metadata
+		expected bool
+	}{
+		{nil, false},
+		{azblob.Metadata{"md5sum": "abc"}, false},
+		{azblob.Metadata{"hdi_isfolder": "true"}, true},
+		{azblob.Metadata{"Hdi_isfolder": "True"}, true},
+		{azblob.Metadata{"hdi_isfolder": "false"}, false},
+	}
+	for i, testCase := range testCases {
+		if got := isAzureHNSFolder(testCase.meta); got != testCase.expected {
+			t.Errorf("Test %d: Expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
+
 // Test canonical metadata.
 func TestS3MetaToAzureProperties(t *testing.T) {
 	headers := map[string]string{
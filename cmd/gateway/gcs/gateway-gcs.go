@@ -34,6 +34,7 @@ import (
 	"strings"
 	"time"
 
+	"cloud.google.com/go/compute/metadata"
 	"cloud.google.com/go/storage"
 	humanize "github.com/dustin/go-humanize"
 	"github.com/minio/cli"
@@ -103,9 +104,11 @@ FLAGS:
   {{end}}{{end}}
 PROJECTID:
   optional GCS project-id expected GOOGLE_APPLICATION_CREDENTIALS env is not set
+  and the project of the GCE/GKE instance is not to be used
 
 GOOGLE_APPLICATION_CREDENTIALS:
   path to credentials.json, generated it from here https://developers.google.com/identity/protocols/application-default-credentials
+  optional on GCE and on GKE with workload identity, the credentials are then obtained from the metadata server
 
 EXAMPLES:
   1. Start minio gateway server for GCS backend
@@ -125,6 +128,11 @@ EXAMPLES:
      {{.Prompt}} {{.EnvVarSetCommand}} MINIO_CACHE_WATERMARK_HIGH{{.AssignmentOperator}}85
      {{.Prompt}} {{.EnvVarSetCommand}} MINIO_CACHE_QUOTA{{.AssignmentOperator}}90
      {{.Prompt}} {{.HelpName}} mygcsprojectid
+
+  3. Start minio gateway server for GCS backend on GKE with workload identity
+     {{.Prompt}} {{.EnvVarSetCommand}} MINIO_ROOT_USER{{.AssignmentOperator}}accesskey
+     {{.Prompt}} {{.EnvVarSetCommand}} MINIO_ROOT_PASSWORD{{.AssignmentOperator}}secretkey
+     {{.Prompt}} {{.HelpName}}
 `
 
 	minio.RegisterGatewayCommand(cli.Command{
@@ -139,7 +147,7 @@ EXAMPLES:
 // Handler for 'minio gateway gcs' command line.
 func gcsGatewayMain(ctx *cli.Context) {
 	projectID := ctx.Args().First()
-	if projectID == "" && os.Getenv("GOOGLE_APPLICATION_CREDENTIALS") == "" {
+	if projectID == "" && os.Getenv("GOOGLE_APPLICATION_CREDENTIALS") == "" && !metadata.OnGCE() {
 		logger.LogIf(minio.GlobalContext, errGCSProjectIDNotFound, logger.Application)
 		cli.ShowCommandHelpAndExit(ctx, minio.GCSBackendGateway, 1)
 	}
@@ -170,8 +178,12 @@ func (g *GCS) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, error)
 	var err error
 	if g.projectID == "" {
 		// If project ID is not provided on command line, we figure it out
-		// from the credentials.json file.
-		g.projectID, err = gcsParseProjectID(env.Get("GOOGLE_APPLICATION_CREDENTIALS", ""))
+		// from the credentials.json file, or else from the metadata server.
+		if credsFile := env.Get("GOOGLE_APPLICATION_CREDENTIALS", ""); credsFile != "" {
+			g.projectID, err = gcsParseProjectID(credsFile)
+		} else {
+			g.projectID, err = metadata.ProjectID()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -184,7 +196,9 @@ func (g *GCS) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, error)
 		Metrics:   metrics,
 	}
 
-	// Initialize a GCS client.
+	// Initialize a GCS client. Without GOOGLE_APPLICATION_CREDENTIALS the
+	// client obtains and refreshes the tokens of the service account of the
+	// GCE instance, or of the GKE workload identity, from the metadata server.
 	// Send user-agent in this format for Google to obtain usage insights while participating in the
 	// Google Cloud Technology Partners (https://cloud.google.com/partners/)
 	client, err := storage.NewClient(ctx, option.WithUserAgent(fmt.Sprintf("MinIO/%s (GPN:MinIO;)", minio.Version)))
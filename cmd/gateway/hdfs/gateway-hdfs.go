@@ -80,6 +80,11 @@ EXAMPLES:
      {{.Prompt}} {{.EnvVarSetCommand}} MINIO_CACHE_WATERMARK_LOW{{.AssignmentOperator}}75
      {{.Prompt}} {{.EnvVarSetCommand}} MINIO_CACHE_WATERMARK_HIGH{{.AssignmentOperator}}85
      {{.Prompt}} {{.HelpName}} hdfs://namenode:8200
+
+  3. Start minio gateway server for HDFS with HA namenodes, failing over between them
+     {{.Prompt}} {{.EnvVarSetCommand}} MINIO_ROOT_USER{{.AssignmentOperator}}accesskey
+     {{.Prompt}} {{.EnvVarSetCommand}} MINIO_ROOT_PASSWORD{{.AssignmentOperator}}secretkey
+     {{.Prompt}} {{.HelpName}} hdfs://namenode1:8200 hdfs://namenode2:8200
 `
 
 	minio.RegisterGatewayCommand(cli.Command{
@@ -157,6 +162,49 @@ func getKerberosClient() (*krb.Client, error) {
 	return krb.NewFromCCache(ccache, cfg)
 }
 
+// kerberosRelogin logs in again from the keytab every half ticket
+// lifetime, so that the namenode connections, which authenticate when
+// they are established again e.g. after a failover, never find the
+// ticket expired.
+func kerberosRelogin(ctx context.Context, login func() error, lifetime time.Duration) {
+	if lifetime <= 0 {
+		return
+	}
+	ticker := time.NewTicker(lifetime / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := login(); err != nil {
+				logger.LogIf(ctx, fmt.Errorf("unable to renew kerberos ticket from keytab: %w", err))
+			}
+		}
+	}
+}
+
+// namenodeAddresses returns the RPC addresses of the namenodes of the
+// HA nameservice configured in hdfs-site.xml, or host if it is not
+// a nameservice. Requests fail over between these namenodes.
+func namenodeAddresses(conf hadoopconf.HadoopConf, host string) []string {
+	var addresses []string
+	for _, nn := range strings.Split(conf["dfs.ha.namenodes."+host], ",") {
+		nn = strings.TrimSpace(nn)
+		if nn == "" {
+			continue
+		}
+		if address := conf["dfs.namenode.rpc-address."+host+"."+nn]; address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		return []string{host}
+	}
+	return addresses
+}
+
 // NewGatewayLayer returns hdfs gatewaylayer.
 func (g *HDFS) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, error) {
 	dialFunc := (&net.Dialer{
@@ -178,6 +226,7 @@ func (g *HDFS) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, error
 	var commonPath string
 	if len(opts.Addresses) == 0 {
 		var addresses []string
+		seen := make(map[string]bool)
 		for _, s := range g.args {
 			u, err := xnet.ParseURL(s)
 			if err != nil {
@@ -192,7 +241,12 @@ func (g *HDFS) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, error
 			if commonPath == "" {
 				commonPath = u.Path
 			}
-			addresses = append(addresses, u.Host)
+			for _, address := range namenodeAddresses(hconfig, u.Host) {
+				if !seen[address] {
+					seen[address] = true
+					addresses = append(addresses, address)
+				}
+			}
 		}
 		opts.Addresses = addresses
 	}
@@ -207,6 +261,14 @@ func (g *HDFS) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, error
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize kerberos client: %s", err)
 		}
+		// Tickets from a ccache can't be renewed without the
+		// password, only those from a keytab.
+		if opts.KerberosClient.Credentials.HasKeytab() {
+			if err = opts.KerberosClient.Login(); err != nil {
+				return nil, fmt.Errorf("unable to login with kerberos keytab: %s", err)
+			}
+			go kerberosRelogin(minio.GlobalContext, opts.KerberosClient.Login, opts.KerberosClient.Config.LibDefaults.TicketLifetime)
+		}
 	} else {
 		opts.User = env.Get("HADOOP_USER_NAME", u.Username)
 	}
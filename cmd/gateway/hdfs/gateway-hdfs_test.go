@@ -0,0 +1,127 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hdfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/colinmarc/hdfs/v2/hadoopconf"
+)
+
+// writeHadoopConf writes the given properties as a hadoop
+// configuration file named name in dir.
+func writeHadoopConf(t *testing.T, dir, name string, props map[string]string) {
+	data := "<configuration>\n"
+	for k, v := range props {
+		data += fmt.Sprintf("  <property><name>%s</name><value>%s</value></property>\n", k, v)
+	}
+	data += "</configuration>\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNamenodeAddresses(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hdfs-conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeHadoopConf(t, dir, "core-site.xml", map[string]string{
+		"fs.defaultFS": "hdfs://cluster1",
+	})
+	writeHadoopConf(t, dir, "hdfs-site.xml", map[string]string{
+		"dfs.nameservices":                       "cluster1,cluster2,cluster3",
+		"dfs.ha.namenodes.cluster1":              "nn1, nn2",
+		"dfs.namenode.rpc-address.cluster1.nn1":  "namenode1:8020",
+		"dfs.namenode.rpc-address.cluster1.nn2":  "namenode2:8020",
+		"dfs.ha.namenodes.cluster2":              "nn1,nn2",
+		"dfs.namenode.rpc-address.cluster2.nn2":  "namenode4:8020",
+		"dfs.namenode.rpc-address.cluster3.nn1":  "namenode5:8020",
+		"dfs.namenode.http-address.cluster1.nn1": "namenode1:9870",
+	})
+	conf, err := hadoopconf.Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		host      string
+		addresses []string
+	}{
+		// A single namenode.
+		{"namenode:8020", []string{"namenode:8020"}},
+		// The namenodes of an HA nameservice.
+		{"cluster1", []string{"namenode1:8020", "namenode2:8020"}},
+		// Namenodes without an RPC address are skipped.
+		{"cluster2", []string{"namenode4:8020"}},
+		// A nameservice without HA namenodes.
+		{"cluster3", []string{"cluster3"}},
+		// A nameservice missing from the configuration.
+		{"cluster4", []string{"cluster4"}},
+	}
+	for i, testCase := range testCases {
+		if addresses := namenodeAddresses(conf, testCase.host); !reflect.DeepEqual(addresses, testCase.addresses) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.addresses, addresses)
+		}
+	}
+
+	// Without any configuration the host is used as is.
+	if addresses := namenodeAddresses(nil, "cluster1"); !reflect.DeepEqual(addresses, []string{"cluster1"}) {
+		t.Errorf("expected [cluster1], got %v", addresses)
+	}
+}
+
+func TestKerberosRelogin(t *testing.T) {
+	// Failed logins are retried at the next interval.
+	var logins int32
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		kerberosRelogin(ctx, func() error {
+			if atomic.AddInt32(&logins, 1) == 3 {
+				cancel()
+			}
+			return errors.New("KDC unreachable")
+		}, 10*time.Millisecond)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the relogin to stop once canceled")
+	}
+	if n := atomic.LoadInt32(&logins); n < 3 {
+		t.Errorf("expected at least 3 logins, got %d", n)
+	}
+
+	// Tickets without a lifetime are not renewed.
+	kerberosRelogin(context.Background(), func() error {
+		t.Error("expected no login")
+		return nil
+	}, 0)
+}
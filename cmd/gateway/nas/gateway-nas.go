@@ -18,13 +18,24 @@ package nas
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/minio/cli"
 	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/cmd/config"
 	"github.com/minio/minio/pkg/auth"
+	"github.com/minio/minio/pkg/env"
 	"github.com/minio/minio/pkg/madmin"
 )
 
+// Environment variables to run several NAS gateways on the same
+// shared filesystem.
+const (
+	EnvNASSharedLocks      = "MINIO_GATEWAY_NAS_SHARED_LOCKS"
+	EnvNASSharedLocksLease = "MINIO_GATEWAY_NAS_SHARED_LOCKS_LEASE"
+)
+
 func init() {
 	const nasGatewayTemplate = `NAME:
   {{.HelpName}} - {{.Usage}}
@@ -54,6 +65,12 @@ EXAMPLES:
      {{.Prompt}} {{.EnvVarSetCommand}} MINIO_CACHE_WATERMARK_LOW{{.AssignmentOperator}}75
      {{.Prompt}} {{.EnvVarSetCommand}} MINIO_CACHE_WATERMARK_HIGH{{.AssignmentOperator}}85
      {{.Prompt}} {{.HelpName}} /shared/nasvol
+
+  3. Start minio gateway server for NAS on each of several nodes sharing the same NAS mount
+     {{.Prompt}} {{.EnvVarSetCommand}} MINIO_ROOT_USER{{.AssignmentOperator}}accesskey
+     {{.Prompt}} {{.EnvVarSetCommand}} MINIO_ROOT_PASSWORD{{.AssignmentOperator}}secretkey
+     {{.Prompt}} {{.EnvVarSetCommand}} MINIO_GATEWAY_NAS_SHARED_LOCKS{{.AssignmentOperator}}on
+     {{.Prompt}} {{.HelpName}} /shared/nasvol
 `
 
 	minio.RegisterGatewayCommand(cli.Command{
@@ -87,8 +104,25 @@ func (g *NAS) Name() string {
 
 // NewGatewayLayer returns nas gatewaylayer.
 func (g *NAS) NewGatewayLayer(creds auth.Credentials) (minio.ObjectLayer, error) {
-	var err error
-	newObject, err := minio.NewFSObjectLayer(g.path)
+	sharedLocks, err := config.ParseBool(env.Get(EnvNASSharedLocks, config.EnableOff))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid %s value: %w", EnvNASSharedLocks, err)
+	}
+
+	var newObject minio.ObjectLayer
+	if sharedLocks {
+		// Namespace locks are taken through lease files on the
+		// NAS mount, shared by all gateways using it.
+		lease := minio.DefaultFSSharedLockLease
+		if v := env.Get(EnvNASSharedLocksLease, ""); v != "" {
+			if lease, err = time.ParseDuration(v); err != nil || lease <= 0 {
+				return nil, fmt.Errorf("Invalid %s value: %s", EnvNASSharedLocksLease, v)
+			}
+		}
+		newObject, err = minio.NewSharedFSObjectLayer(g.path, lease)
+	} else {
+		newObject, err = minio.NewFSObjectLayer(g.path)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -338,7 +338,7 @@ func parseAmzDateHeader(req *http.Request) (time.Time, APIErrorCode) {
 func setTimeValidityHandler(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		aType := getRequestAuthType(r)
-		if aType == authTypeSigned || aType == authTypeSignedV2 || aType == authTypeStreamingSigned {
+		if aType == authTypeSigned || aType == authTypeSignedV2 || aType == authTypeStreamingSigned || aType == authTypeStreamingUnsignedTrailer {
 			// Verify if date headers are set, if not reject the request
 			amzDate, errCode := parseAmzDateHeader(r)
 			if errCode != ErrNone {
@@ -492,13 +492,12 @@ func setBucketForwardingHandler(h http.Handler) http.Handler {
 				if globalIsTLS {
 					r.URL.Scheme = "https"
 				}
-				r.URL.Host = getHostFromSrv(sr)
 				// Make sure we remove any existing headers before
 				// proxying the request to another node.
 				for k := range w.Header() {
 					w.Header().Del(k)
 				}
-				globalForwarder.ServeHTTP(w, r)
+				globalForwarder.ServeHTTPWithRetry(w, r, getHostsSlice(sr))
 				return
 			}
 			h.ServeHTTP(w, r)
@@ -547,13 +546,12 @@ func setBucketForwardingHandler(h http.Handler) http.Handler {
 			if globalIsTLS {
 				r.URL.Scheme = "https"
 			}
-			r.URL.Host = getHostFromSrv(sr)
 			// Make sure we remove any existing headers before
 			// proxying the request to another node.
 			for k := range w.Header() {
 				w.Header().Del(k)
 			}
-			globalForwarder.ServeHTTP(w, r)
+			globalForwarder.ServeHTTPWithRetry(w, r, getHostsSlice(sr))
 			return
 		}
 		h.ServeHTTP(w, r)
@@ -84,6 +84,7 @@ func getBackgroundHealStatus(ctx context.Context, o ObjectLayer) (madmin.BgHealS
 	}
 	status := madmin.BgHealState{
 		ScannedItemsCount: bgSeq.getScannedItemsCount(),
+		ScanRate:          bgSeq.getScanRate(),
 	}
 
 	if o == nil {
@@ -188,7 +189,7 @@ func (er *erasureObjects) healErasureSet(ctx context.Context, buckets []BucketIn
 			}
 		}
 
-		if serverDebugLog {
+		if debugLogEnabled(debugLogHeal) {
 			console.Debugf(color.Green("healDisk:")+" healing bucket %s content on erasure set %d\n", bucket.Name, tracker.SetIndex+1)
 		}
 
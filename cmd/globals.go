@@ -162,6 +162,13 @@ var (
 	globalLifecycleSys       *LifecycleSys
 	globalBucketSSEConfigSys *BucketSSEConfigSys
 	globalBucketTargetSys    *BucketTargetSys
+	globalBatchJobsSys       *BatchJobsSys
+	globalTenantSys          *TenantSys
+	globalMaintenanceSys     *MaintenanceSys
+	globalBreakGlassSys      *BreakGlassSys
+	// globalAccessTimeTracker records when objects are read,
+	// for the lifecycle transitions based on the last access.
+	globalAccessTimeTracker = newAccessTimeTracker()
 	// globalAPIConfig controls S3 API requests throttling,
 	// healthcheck readiness deadlines and cors settings.
 	globalAPIConfig = apiConfig{listQuorum: 3}
@@ -178,6 +185,12 @@ var (
 
 	globalTLSCerts *certs.Manager
 
+	// Address and certificate of the web browser, when it
+	// is served apart from the S3 API.
+	globalBrowserAddr       string
+	globalBrowserTLSCerts   *certs.Manager
+	globalBrowserHTTPServer *xhttp.Server
+
 	globalHTTPServer        *xhttp.Server
 	globalHTTPServerErrorCh = make(chan error)
 	globalOSSignalCh        = make(chan os.Signal, 1)
@@ -206,6 +219,9 @@ var (
 	// Global HTTP request statisitics
 	globalHTTPStats = newHTTPStats()
 
+	// S3 requests being served, which operators can cancel
+	globalInflightRequests = newInflightRequests()
+
 	// Time when the server is started
 	globalBootTime = UTCNow()
 
@@ -214,6 +230,9 @@ var (
 	// Hold the old server credentials passed by the environment
 	globalOldCred auth.Credentials
 
+	// Emergency root credential, sealed unless explicitly enabled
+	globalBreakGlassCred auth.Credentials
+
 	// Indicates if config is to be encrypted
 	globalConfigEncrypted bool
 
@@ -248,6 +267,10 @@ var (
 	// GlobalKMS initialized KMS configuration
 	GlobalKMS kms.KMS
 
+	// KMS previously configured, only used to unseal SSE-S3
+	// object keys sealed before switching to GlobalKMS.
+	globalOldKMS kms.KMS
+
 	// Auto-Encryption, if enabled, turns any non-SSE-C request
 	// into an SSE-S3 request. If enabled a valid, non-empty KMS
 	// configuration must be present.
@@ -284,6 +307,10 @@ var (
 	// If writes to FS backend should be O_SYNC.
 	globalFSOSync bool
 
+	// If object metadata of FS backend is stored in extended
+	// attributes of the object files instead of `fs.json`.
+	globalFSXattrMeta bool
+
 	globalProxyEndpoints []ProxyEndpoint
 
 	globalInternodeTransport http.RoundTripper
@@ -23,6 +23,7 @@ import (
 
 	"github.com/minio/minio/cmd/config/api"
 	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/handlers"
 	"github.com/minio/minio/pkg/sys"
 )
 
@@ -38,6 +39,7 @@ type apiConfig struct {
 	// total drives per erasure set across pools.
 	totalDriveCount    int
 	replicationWorkers int
+	presignedExpiryMax time.Duration
 }
 
 func (t *apiConfig) init(cfg api.Config, setDriveCounts []int) {
@@ -85,6 +87,8 @@ func (t *apiConfig) init(cfg api.Config, setDriveCounts []int) {
 		globalReplicationPool.Resize(cfg.ReplicationWorkers)
 	}
 	t.replicationWorkers = cfg.ReplicationWorkers
+	t.presignedExpiryMax = cfg.PresignedExpiryMax
+	handlers.SetTrustedProxies(cfg.TrustedProxies)
 }
 
 func (t *apiConfig) getListQuorum() int {
@@ -135,6 +139,17 @@ func (t *apiConfig) getRequestsPool() (chan struct{}, time.Duration) {
 // maxClients throttles the S3 API calls
 func maxClients(f http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		// Requests exceeding the limits of their bucket are rejected
+		// before they wait for the requests pool.
+		release, ok := throttleBucketRequest(r)
+		if !ok {
+			writeErrorResponse(r.Context(), w,
+				errorCodes.ToAPIErr(ErrSlowDown),
+				r.URL, guessIsBrowserReq(r))
+			return
+		}
+		defer release()
+
 		pool, deadline := globalAPIConfig.getRequestsPool()
 		if pool == nil {
 			f.ServeHTTP(w, r)
@@ -171,3 +186,23 @@ func (t *apiConfig) getReplicationWorkers() int {
 
 	return t.replicationWorkers
 }
+
+func (t *apiConfig) getPresignedExpiryMax() time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.presignedExpiryMax == 0 {
+		return api.MaxPresignedExpiry
+	}
+
+	return t.presignedExpiryMax
+}
+
+// getConfiguredPresignedExpiryMax returns presigned_expiry_max only
+// when it was set explicitly, zero otherwise.
+func (t *apiConfig) getConfiguredPresignedExpiryMax() time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.presignedExpiryMax
+}
@@ -390,6 +390,9 @@ func collectAPIStats(api string, f http.HandlerFunc) http.HandlerFunc {
 		globalHTTPStats.currentS3Requests.Inc(api)
 		defer globalHTTPStats.currentS3Requests.Dec(api)
 
+		w, r, done := globalInflightRequests.begin(api, w, r)
+		defer done()
+
 		statsWriter := logger.NewResponseWriter(w)
 
 		f.ServeHTTP(statsWriter, r)
@@ -0,0 +1,250 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/minio/minio/cmd/config"
+	"github.com/minio/minio/cmd/config/diagnostics"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/kms"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// Health reports are kept under this prefix of the meta bucket.
+	healthReportsPrefix = "diagnostics/health-reports"
+	healthReportSuffix  = ".json"
+
+	// Time format of the report names, which list in the
+	// order the reports were collected.
+	healthReportTimeFormat = "20060102T150405Z"
+
+	// How often a node checks whether a report is due.
+	healthReportCheckInterval = 5 * time.Minute
+
+	// Maximum time spent collecting one report.
+	healthReportDeadline = time.Hour
+)
+
+var (
+	globalDiagnosticsConfig   diagnostics.Config
+	globalDiagnosticsConfigMu sync.RWMutex
+)
+
+var errHealthReportNotFound = AdminError{
+	Code:       "XMinioAdminNoSuchHealthReport",
+	Message:    "The specified health report does not exist",
+	StatusCode: http.StatusNotFound,
+}
+
+func getDiagnosticsConfig() diagnostics.Config {
+	globalDiagnosticsConfigMu.RLock()
+	defer globalDiagnosticsConfigMu.RUnlock()
+	return globalDiagnosticsConfig
+}
+
+func healthReportPath(name string) string {
+	return path.Join(healthReportsPrefix, name)
+}
+
+// initHealthReports starts collecting health reports as set in
+// the diagnostics configuration.
+func initHealthReports(ctx context.Context, objAPI ObjectLayer) {
+	go runHealthReports(ctx, objAPI)
+}
+
+func runHealthReports(ctx context.Context, objAPI ObjectLayer) {
+	timer := time.NewTimer(healthReportCheckInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			if cfg := getDiagnosticsConfig(); cfg.Enabled {
+				logger.LogIf(ctx, collectHealthReportIfDue(ctx, objAPI, cfg))
+			}
+			timer.Reset(healthReportCheckInterval)
+		}
+	}
+}
+
+// collectHealthReportIfDue collects a new health report if the latest
+// one is older than the interval, then removes the expired reports.
+// The lock is shared with the health info admin API, a report is not
+// collected while an operator runs the diagnostics.
+func collectHealthReportIfDue(ctx context.Context, objAPI ObjectLayer, cfg diagnostics.Config) error {
+	locker := objAPI.NewNSLock(minioMetaBucket, "health-check-in-progress")
+	lkctx, err := locker.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		// Another node is taking care of it.
+		return nil
+	}
+	defer locker.Unlock()
+
+	reports, err := listHealthReports(lkctx, objAPI)
+	if err != nil {
+		return err
+	}
+	now := UTCNow()
+	if len(reports) == 0 || now.Sub(reports[len(reports)-1].Created) >= cfg.Interval {
+		info, err := saveHealthReport(lkctx, objAPI, cfg, now)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, info)
+	}
+
+	for _, r := range expiredHealthReports(reports, now, cfg.Retention) {
+		if err = deleteConfig(lkctx, objAPI, healthReportPath(r.Name)); err != nil && err != errConfigNotFound {
+			logger.LogIf(ctx, err)
+		}
+	}
+	return nil
+}
+
+// expiredHealthReports returns the reports older than retention, the
+// latest report is always kept. reports are sorted oldest first.
+func expiredHealthReports(reports []madmin.HealthReportInfo, now time.Time, retention time.Duration) []madmin.HealthReportInfo {
+	if len(reports) == 0 {
+		return nil
+	}
+	var expired []madmin.HealthReportInfo
+	for _, r := range reports[:len(reports)-1] {
+		if now.Sub(r.Created) < retention {
+			break
+		}
+		expired = append(expired, r)
+	}
+	return expired
+}
+
+// listHealthReports returns the health reports kept, oldest first.
+func listHealthReports(ctx context.Context, objAPI ObjectLayer) ([]madmin.HealthReportInfo, error) {
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(ctx, minioMetaBucket, healthReportsPrefix+SlashSeparator, objInfoCh, ObjectOptions{}); err != nil {
+		return nil, err
+	}
+
+	reports := []madmin.HealthReportInfo{}
+	for obj := range objInfoCh {
+		name := path.Base(obj.Name)
+		if !strings.HasSuffix(name, healthReportSuffix) {
+			continue
+		}
+		reports = append(reports, madmin.HealthReportInfo{
+			Name:    name,
+			Created: obj.ModTime,
+			Size:    obj.Size,
+		})
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Name < reports[j].Name
+	})
+	return reports, nil
+}
+
+// saveHealthReport collects the system information and the server
+// configuration of all the servers, and the performance of drives
+// and network if set in cfg. Reports are encrypted with the KMS as
+// the server configuration is, when one is configured.
+func saveHealthReport(ctx context.Context, objAPI ObjectLayer, cfg diagnostics.Config, now time.Time) (info madmin.HealthReportInfo, err error) {
+	query := url.Values{}
+	for _, d := range []madmin.HealthDataType{
+		madmin.HealthDataTypeMinioConfig,
+		madmin.HealthDataTypeSysCPU,
+		madmin.HealthDataTypeSysDiskHw,
+		madmin.HealthDataTypeSysOsInfo,
+		madmin.HealthDataTypeSysMem,
+		madmin.HealthDataTypeSysProcess,
+	} {
+		query.Set(string(d), "true")
+	}
+	if cfg.Perf {
+		query.Set(string(madmin.HealthDataTypePerfDrive), "true")
+		query.Set(string(madmin.HealthDataTypePerfNet), "true")
+	}
+
+	deadlinedCtx, cancel := context.WithTimeout(ctx, healthReportDeadline)
+	defer cancel()
+
+	// The address of the local server is taken from the request
+	// in single server deployments.
+	r := &http.Request{Host: globalMinioAddr}
+	healthInfo := collectHealthInfo(deadlinedCtx, objAPI, r, query, func(madmin.HealthInfo) {})
+	healthInfo.TimeStamp = now
+
+	data, err := json.Marshal(healthInfo)
+	if err != nil {
+		return info, err
+	}
+
+	name := now.Format(healthReportTimeFormat) + healthReportSuffix
+	reportFile := healthReportPath(name)
+	if GlobalKMS != nil {
+		data, err = config.EncryptBytes(GlobalKMS, data, kms.Context{
+			minioMetaBucket: path.Join(minioMetaBucket, reportFile),
+		})
+		if err != nil {
+			return info, err
+		}
+	}
+	if err = saveConfig(ctx, objAPI, reportFile, data); err != nil {
+		return info, err
+	}
+	return madmin.HealthReportInfo{
+		Name:    name,
+		Created: now,
+		Size:    int64(len(data)),
+	}, nil
+}
+
+// readHealthReport returns the health report name.
+func readHealthReport(ctx context.Context, objAPI ObjectLayer, name string) ([]byte, error) {
+	if !strings.HasSuffix(name, healthReportSuffix) || strings.Contains(name, SlashSeparator) {
+		return nil, errHealthReportNotFound
+	}
+
+	reportFile := healthReportPath(name)
+	data, err := readConfig(ctx, objAPI, reportFile)
+	if err != nil {
+		if err == errConfigNotFound {
+			err = errHealthReportNotFound
+		}
+		return nil, err
+	}
+
+	if GlobalKMS != nil && !utf8.Valid(data) {
+		data, err = config.DecryptBytes(GlobalKMS, data, kms.Context{
+			minioMetaBucket: path.Join(minioMetaBucket, reportFile),
+		})
+	}
+	return data, err
+}
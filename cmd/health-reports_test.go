@@ -0,0 +1,56 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestExpiredHealthReports(t *testing.T) {
+	now := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	report := func(age time.Duration) madmin.HealthReportInfo {
+		created := now.Add(-age)
+		return madmin.HealthReportInfo{
+			Name:    created.Format(healthReportTimeFormat) + healthReportSuffix,
+			Created: created,
+		}
+	}
+
+	testCases := []struct {
+		reports []madmin.HealthReportInfo
+		expired int
+	}{
+		{nil, 0},
+		// The latest report is kept even if expired.
+		{[]madmin.HealthReportInfo{report(100 * time.Hour)}, 0},
+		{[]madmin.HealthReportInfo{report(100 * time.Hour), report(50 * time.Hour)}, 1},
+		{[]madmin.HealthReportInfo{report(100 * time.Hour), report(80 * time.Hour), report(time.Hour)}, 2},
+		{[]madmin.HealthReportInfo{report(100 * time.Hour), report(10 * time.Hour), report(time.Hour)}, 1},
+		{[]madmin.HealthReportInfo{report(20 * time.Hour), report(10 * time.Hour)}, 0},
+	}
+
+	for i, testCase := range testCases {
+		expired := expiredHealthReports(testCase.reports, now, 48*time.Hour)
+		if len(expired) != testCase.expired {
+			t.Errorf("Test %d: expected %d expired reports, got %d", i+1, testCase.expired, len(expired))
+		}
+	}
+}
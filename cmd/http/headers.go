@@ -109,6 +109,7 @@ const (
 	AmzCredential           = "X-Amz-Credential"
 	AmzSecurityToken        = "X-Amz-Security-Token"
 	AmzDecodedContentLength = "X-Amz-Decoded-Content-Length"
+	AmzTrailer              = "X-Amz-Trailer"
 
 	AmzMetaUnencryptedContentLength = "X-Amz-Meta-X-Amz-Unencrypted-Content-Length"
 	AmzMetaUnencryptedContentMD5    = "X-Amz-Meta-X-Amz-Unencrypted-Content-Md5"
@@ -169,6 +170,13 @@ const (
 	MinIOSourceProxyRequest = "X-Minio-Source-Proxy-Request"
 	// Header indicates that this request is a replication request to create a REPLICA
 	MinIOSourceReplicationRequest = "X-Minio-Source-Replication-Request"
+
+	// Header limits the bandwidth of a server-side copy, e.g. "10MiB" per second
+	MinIOCopyBandwidth = "X-Minio-Copy-Bandwidth"
+
+	// Header sets the number of seconds after which the object written
+	// is deleted, e.g. "3600" to keep it for an hour
+	MinIOExpirationSeconds = "X-Minio-Expiration-Seconds"
 )
 
 // Common http query params S3 API
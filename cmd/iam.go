@@ -32,6 +32,9 @@ import (
 	"github.com/minio/minio-go/v7/pkg/set"
 	"github.com/minio/minio/cmd/logger"
 	"github.com/minio/minio/pkg/auth"
+	"github.com/minio/minio/pkg/bucket/policy/condition"
+	"github.com/minio/minio/pkg/color"
+	"github.com/minio/minio/pkg/console"
 	iampolicy "github.com/minio/minio/pkg/iam/policy"
 	"github.com/minio/minio/pkg/madmin"
 )
@@ -455,6 +458,10 @@ func (sys *IAMSys) Load(ctx context.Context, store IAMStorageAPI) error {
 	isMinIOUsersSys := sys.usersSysType == MinIOUsersSysType
 	store.runlock()
 
+	if debugLogEnabled(debugLogIAM) {
+		console.Debugln(color.Green("iam:") + " loading users, groups and policies")
+	}
+
 	if err := store.loadPolicyDocs(ctx, iamPolicyDocsMap); err != nil {
 		return err
 	}
@@ -905,6 +912,7 @@ func (sys *IAMSys) ListUsers() (map[string]madmin.UserInfo, error) {
 					}
 					return madmin.AccountDisabled
 				}(),
+				Tenant: v.Tenant,
 			}
 		}
 	}
@@ -1003,6 +1011,7 @@ func (sys *IAMSys) GetUserInfo(name string) (u madmin.UserInfo, err error) {
 			return madmin.AccountDisabled
 		}(),
 		MemberOf: sys.iamUserGroupMemberships[name].ToSlice(),
+		Tenant:   cred.Tenant,
 	}, nil
 
 }
@@ -1322,6 +1331,12 @@ func (sys *IAMSys) CreateUser(accessKey string, uinfo madmin.UserInfo) error {
 		return errIAMActionNotAllowed
 	}
 
+	// Users never move to another tenant.
+	tenant := uinfo.Tenant
+	if ok {
+		tenant = cr.Tenant
+	}
+
 	u := newUserIdentity(auth.Credentials{
 		AccessKey: accessKey,
 		SecretKey: uinfo.SecretKey,
@@ -1331,6 +1346,7 @@ func (sys *IAMSys) CreateUser(accessKey string, uinfo madmin.UserInfo) error {
 			}
 			return auth.AccountOff
 		}(),
+		Tenant: tenant,
 	})
 
 	if err := sys.store.saveUserIdentity(context.Background(), accessKey, regularUser, u); err != nil {
@@ -1412,6 +1428,27 @@ func (sys *IAMSys) loadUserFromStore(accessKey string) {
 	sys.store.unlock()
 }
 
+// TenantOf - returns the tenant of accessKey, which is the tenant of
+// the parent user for temporary credentials and service accounts.
+// Identities which do not belong to a tenant return "".
+func (sys *IAMSys) TenantOf(accessKey string) string {
+	if !sys.Initialized() {
+		return ""
+	}
+
+	sys.store.rlock()
+	defer sys.store.runlock()
+
+	cred, ok := sys.iamUsersMap[accessKey]
+	if !ok {
+		return ""
+	}
+	if cred.Tenant == "" && cred.ParentUser != "" {
+		cred = sys.iamUsersMap[cred.ParentUser]
+	}
+	return cred.Tenant
+}
+
 // GetUser - get user credentials
 func (sys *IAMSys) GetUser(accessKey string) (cred auth.Credentials, ok bool) {
 	if !sys.Initialized() {
@@ -2140,6 +2177,51 @@ func (sys *IAMSys) GetCombinedPolicy(policies ...string) iampolicy.Policy {
 	return combinedPolicy
 }
 
+// RefersExistingObjectTag - returns whether the policies of the account,
+// or the session policy in claims, may have s3:ExistingObjectTag conditions.
+func (sys *IAMSys) RefersExistingObjectTag(accountName string, groups []string, claims map[string]interface{}) bool {
+	// The arguments OPA evaluates are not known.
+	if globalPolicyOPA != nil {
+		return true
+	}
+
+	if !sys.Initialized() || accountName == "" {
+		return false
+	}
+
+	if spolicy, ok := claims[iampolicy.SessionPolicyName].(string); ok &&
+		strings.Contains(spolicy, string(condition.S3ExistingObjectTag)) {
+		return true
+	}
+
+	// Temporary credentials and service accounts are allowed
+	// by the policies of their parent user.
+	names := []string{accountName}
+	if ok, parentUser, _ := sys.IsTempUser(accountName); ok {
+		names = append(names, parentUser)
+	} else if ok, parentUser, _ := sys.IsServiceAccount(accountName); ok {
+		names = append(names, parentUser)
+	}
+
+	var policies []string
+	for _, name := range names {
+		ps, err := sys.PolicyDBGet(name, false, groups...)
+		if err == nil {
+			policies = append(policies, ps...)
+		}
+	}
+	if claimPolicies, ok := iampolicy.GetPoliciesFromClaims(claims, iamPolicyClaimNameOpenID()); ok {
+		policies = append(policies, claimPolicies.ToSlice()...)
+	}
+
+	for _, statement := range sys.GetCombinedPolicy(policies...).Statements {
+		if conditionsReferExistingObjectTag(statement.Conditions) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsAllowed - checks given policy args is allowed to continue the Rest API.
 func (sys *IAMSys) IsAllowed(args iampolicy.Args) bool {
 	// If opa is configured, use OPA always.
@@ -2156,6 +2238,20 @@ func (sys *IAMSys) IsAllowed(args iampolicy.Args) bool {
 		return true
 	}
 
+	// Identities of a tenant are confined to the tenant, its
+	// root user needs no policy inside of it. Checks for explicit
+	// denials only, such as changing one's own password, are not
+	// confined.
+	if tenant := sys.TenantOf(args.AccountName); tenant != "" {
+		isRoot := globalTenantSys.IsRoot(tenant, args.AccountName)
+		if !args.DenyOnly && !isAllowedTenant(tenant, isRoot, args) {
+			return false
+		}
+		if isRoot {
+			return true
+		}
+	}
+
 	// If the credential is temporary, perform STS related checks.
 	ok, parentUser, err := sys.IsTempUser(args.AccountName)
 	if err != nil {
@@ -0,0 +1,186 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/mux"
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/pkg/handlers"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+var errInflightRequestNotFound = AdminError{
+	Code:       "XMinioAdminRequestNotFound",
+	Message:    "The specified request is not in progress on any server",
+	StatusCode: http.StatusNotFound,
+}
+
+// inflightRequest - an S3 request being served by this server.
+type inflightRequest struct {
+	// Accessed atomically, kept first for the 64-bit alignment.
+	bytesReceived int64
+	bytesSent     int64
+
+	info   madmin.InflightRequest
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// inflightRequests - keeps track of the S3 requests being served by
+// this server so that operators can list them and cancel runaway ones.
+type inflightRequests struct {
+	mu   sync.Mutex
+	reqs map[string]*inflightRequest
+}
+
+func newInflightRequests() *inflightRequests {
+	return &inflightRequests{
+		reqs: make(map[string]*inflightRequest),
+	}
+}
+
+// begin - registers the request, the returned request and response
+// writer must be used to serve it, and done must be called after.
+func (t *inflightRequests) begin(api string, w http.ResponseWriter, r *http.Request) (http.ResponseWriter, *http.Request, func()) {
+	id := w.Header().Get(xhttp.AmzRequestID)
+	if id == "" {
+		return w, r, func() {}
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	req := &inflightRequest{
+		info: madmin.InflightRequest{
+			ID:         id,
+			Node:       globalLocalNodeName,
+			API:        api,
+			RemoteHost: handlers.GetSourceIP(r),
+			StartTime:  UTCNow(),
+		},
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	vars := mux.Vars(r)
+	req.info.Bucket = vars["bucket"]
+	req.info.Object = likelyUnescapeGeneric(vars["object"], url.PathUnescape)
+	if cred := getReqAccessCred(r, globalServerRegion); cred.ParentUser != "" {
+		req.info.AccessKey = cred.ParentUser
+	} else {
+		req.info.AccessKey = cred.AccessKey
+	}
+
+	t.mu.Lock()
+	t.reqs[id] = req
+	t.mu.Unlock()
+
+	r = r.WithContext(ctx)
+	if r.Body != nil {
+		r.Body = &inflightReader{ReadCloser: r.Body, req: req}
+	}
+	return &inflightWriter{ResponseWriter: w, req: req}, r, func() {
+		t.mu.Lock()
+		if t.reqs[id] == req {
+			delete(t.reqs, id)
+		}
+		t.mu.Unlock()
+		cancel()
+	}
+}
+
+// List - returns the requests being served by this server.
+func (t *inflightRequests) List() []madmin.InflightRequest {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := UTCNow()
+	reqs := make([]madmin.InflightRequest, 0, len(t.reqs))
+	for _, req := range t.reqs {
+		info := req.info
+		info.Elapsed = now.Sub(info.StartTime)
+		info.BytesReceived = atomic.LoadInt64(&req.bytesReceived)
+		info.BytesSent = atomic.LoadInt64(&req.bytesSent)
+		reqs = append(reqs, info)
+	}
+	return reqs
+}
+
+// Cancel - cancels the context of the request served by this server,
+// returns false if there is no such request.
+func (t *inflightRequests) Cancel(id string) bool {
+	t.mu.Lock()
+	req, ok := t.reqs[id]
+	t.mu.Unlock()
+	if ok {
+		req.cancel()
+	}
+	return ok
+}
+
+// inflightReader - counts the bytes of the request body read, and
+// fails the reads once the request is canceled.
+type inflightReader struct {
+	io.ReadCloser
+	req *inflightRequest
+}
+
+func (r *inflightReader) Read(p []byte) (int, error) {
+	if err := r.req.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := r.ReadCloser.Read(p)
+	atomic.AddInt64(&r.req.bytesReceived, int64(n))
+	return n, err
+}
+
+// inflightWriter - counts the bytes of the response body written, and
+// fails the writes once the request is canceled, so that handlers
+// which do not check the context stop streaming the response.
+type inflightWriter struct {
+	http.ResponseWriter
+	req *inflightRequest
+}
+
+func (w *inflightWriter) Write(p []byte) (int, error) {
+	if err := w.req.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := w.ResponseWriter.Write(p)
+	atomic.AddInt64(&w.req.bytesSent, int64(n))
+	return n, err
+}
+
+func (w *inflightWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// sortInflightRequests - sorts the requests, the longest running first.
+func sortInflightRequests(reqs []madmin.InflightRequest) {
+	sort.Slice(reqs, func(i, j int) bool {
+		return reqs[i].Elapsed > reqs[j].Elapsed
+	})
+}
@@ -0,0 +1,70 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	xhttp "github.com/minio/minio/cmd/http"
+)
+
+func TestInflightRequests(t *testing.T) {
+	reqs := newInflightRequests()
+
+	r := httptest.NewRequest(http.MethodPut, "/bucket/object", strings.NewReader("hello"))
+	rec := httptest.NewRecorder()
+	rec.Header().Set(xhttp.AmzRequestID, "16A2F0B3C4D5E6F7")
+
+	w, r, done := reqs.begin("PutObject", rec, r)
+	if _, err := ioutil.ReadAll(r.Body); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("hello world")); err != nil {
+		t.Fatal(err)
+	}
+
+	list := reqs.List()
+	if len(list) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(list))
+	}
+	if list[0].API != "PutObject" || list[0].BytesReceived != 5 || list[0].BytesSent != 11 {
+		t.Fatalf("unexpected request %#v", list[0])
+	}
+
+	if reqs.Cancel("unknown") {
+		t.Fatal("expected an unknown request not to be canceled")
+	}
+	if !reqs.Cancel("16A2F0B3C4D5E6F7") {
+		t.Fatal("expected the request to be canceled")
+	}
+	if r.Context().Err() == nil {
+		t.Fatal("expected the request context to be canceled")
+	}
+	if _, err := w.Write([]byte("more")); err == nil {
+		t.Fatal("expected writes to fail once the request is canceled")
+	}
+
+	done()
+	if len(reqs.List()) != 0 {
+		t.Fatal("expected no request once it is done")
+	}
+}
@@ -17,8 +17,13 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -27,6 +32,12 @@ import (
 	"github.com/minio/minio/pkg/event"
 )
 
+// ListenNotificationHandler - streams the events of a bucket, or of
+// all buckets. The events of several buckets are streamed by repeating
+// the bucket query parameter on the root path, several prefixes and
+// suffixes may be repeated as well. A listener which reconnects with
+// the eventTime and sequencer of the last event it received as cursor
+// is sent the events it missed first, read from the event log.
 func (api objectAPIHandlers) ListenNotificationHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "ListenNotification")
 
@@ -49,75 +60,53 @@ func (api objectAPIHandlers) ListenNotificationHandler(w http.ResponseWriter, r
 		return
 	}
 
+	values := r.URL.Query()
+
 	vars := mux.Vars(r)
 	bucketName := vars["bucket"]
+	if bucketName != "" {
+		values.Set(peerRESTListenBucket, bucketName)
+	}
 
-	if bucketName == "" {
-		if s3Error := checkRequestAuthType(ctx, r, policy.ListenNotificationAction, bucketName, ""); s3Error != ErrNone {
+	if len(values[peerRESTListenBucket]) == 0 {
+		if s3Error := checkRequestAuthType(ctx, r, policy.ListenNotificationAction, "", ""); s3Error != ErrNone {
 			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL, guessIsBrowserReq(r))
 			return
 		}
-	} else {
-		if s3Error := checkRequestAuthType(ctx, r, policy.ListenBucketNotificationAction, bucketName, ""); s3Error != ErrNone {
-			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL, guessIsBrowserReq(r))
-			return
-		}
-	}
-
-	values := r.URL.Query()
-
-	var prefix string
-	if len(values[peerRESTListenPrefix]) > 1 {
-		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrFilterNamePrefix), r.URL, guessIsBrowserReq(r))
-		return
 	}
-
-	if len(values[peerRESTListenPrefix]) == 1 {
-		if err := event.ValidateFilterRuleValue(values[peerRESTListenPrefix][0]); err != nil {
-			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+	for _, bucket := range values[peerRESTListenBucket] {
+		if s3Error := checkRequestAuthType(ctx, r, policy.ListenBucketNotificationAction, bucket, ""); s3Error != ErrNone {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL, guessIsBrowserReq(r))
 			return
 		}
-
-		prefix = values[peerRESTListenPrefix][0]
 	}
 
-	var suffix string
-	if len(values[peerRESTListenSuffix]) > 1 {
-		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrFilterNameSuffix), r.URL, guessIsBrowserReq(r))
+	filter, err := parseListenFilter(values)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
 		return
 	}
 
-	if len(values[peerRESTListenSuffix]) == 1 {
-		if err := event.ValidateFilterRuleValue(values[peerRESTListenSuffix][0]); err != nil {
-			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+	var cursor eventCursor
+	if c := values.Get(listenCursor); c != "" {
+		if cursor, err = parseEventCursor(c); err != nil {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidListenCursor), r.URL, guessIsBrowserReq(r))
 			return
 		}
-
-		suffix = values[peerRESTListenSuffix][0]
-	}
-
-	pattern := event.NewPattern(prefix, suffix)
-
-	var eventNames []event.Name
-	for _, s := range values[peerRESTListenEvents] {
-		eventName, err := event.ParseName(s)
-		if err != nil {
-			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+		if !getEventLogConfig().Enabled {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrListenCursorNotSupported), r.URL, guessIsBrowserReq(r))
 			return
 		}
-
-		eventNames = append(eventNames, eventName)
+		values.Del(listenCursor)
 	}
 
-	if bucketName != "" {
-		if _, err := objAPI.GetBucketInfo(ctx, bucketName); err != nil {
+	for _, bucket := range values[peerRESTListenBucket] {
+		if _, err := objAPI.GetBucketInfo(ctx, bucket); err != nil {
 			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
 			return
 		}
 	}
 
-	rulesMap := event.NewRulesMap(eventNames, pattern, event.TargetID{ID: mustGetUUID()})
-
 	setEventStreamHeaders(w)
 
 	// Listen Publisher and peer-listen-client uses nonblocking send and hence does not wait for slow receivers.
@@ -131,17 +120,9 @@ func (api objectAPIHandlers) ListenNotificationHandler(w http.ResponseWriter, r
 		if !ok {
 			return false
 		}
-		if ev.S3.Bucket.Name != "" && bucketName != "" {
-			if ev.S3.Bucket.Name != bucketName {
-				return false
-			}
-		}
-		return rulesMap.MatchSimple(ev.EventName, ev.S3.Object.Key)
+		return filter.match(ev)
 	})
 
-	if bucketName != "" {
-		values.Set(peerRESTListenBucket, bucketName)
-	}
 	for _, peer := range peers {
 		if peer == nil {
 			continue
@@ -149,10 +130,29 @@ func (api objectAPIHandlers) ListenNotificationHandler(w http.ResponseWriter, r
 		peer.Listen(listenCh, ctx.Done(), values)
 	}
 
+	enc := json.NewEncoder(w)
+
+	if !cursor.time.IsZero() {
+		// The live events are buffered while the missed ones are
+		// sent, an event emitted meanwhile may be sent twice.
+		err := sendMissedListenEvents(ctx, objAPI, filter, cursor, UTCNow(), func(ev event.Event) error {
+			if err := enc.Encode(struct{ Records []event.Event }{[]event.Event{ev}}); err != nil {
+				return err
+			}
+			w.(http.Flusher).Flush()
+			return nil
+		})
+		if err != nil {
+			// Close the stream, the listener resumes from the
+			// last event it received once it reconnects.
+			logger.LogIf(ctx, err)
+			return
+		}
+	}
+
 	keepAliveTicker := time.NewTicker(500 * time.Millisecond)
 	defer keepAliveTicker.Stop()
 
-	enc := json.NewEncoder(w)
 	for {
 		select {
 		case evI := <-listenCh:
@@ -177,3 +177,158 @@ func (api objectAPIHandlers) ListenNotificationHandler(w http.ResponseWriter, r
 		}
 	}
 }
+
+// Query parameter of ListenNotification identifying the last event
+// received by a listener which reconnects.
+const listenCursor = "cursor"
+
+// eventCursor identifies an event by its eventTime and sequencer, the
+// sequencer tells apart the events emitted within the same millisecond.
+type eventCursor struct {
+	time time.Time
+	// Zero when the cursor holds the eventTime only.
+	sequencer uint64
+}
+
+// parseEventCursor parses a cursor made of the eventTime of an event,
+// optionally followed by a slash and its sequencer.
+func parseEventCursor(s string) (c eventCursor, err error) {
+	parts := strings.SplitN(s, SlashSeparator, 2)
+	if c.time, err = time.Parse(event.AMZTimeFormat, parts[0]); err != nil {
+		return c, err
+	}
+	if len(parts) == 2 {
+		if c.sequencer, err = strconv.ParseUint(parts[1], 16, 64); err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
+
+// after returns whether ev was emitted after the event at the cursor,
+// given that it was not emitted within an earlier millisecond. Without
+// a sequencer the events of the millisecond of the cursor are taken as
+// received already.
+func (c eventCursor) after(ev event.Event) bool {
+	if ev.EventTime != c.time.UTC().Format(event.AMZTimeFormat) {
+		return true
+	}
+	if c.sequencer == 0 {
+		return false
+	}
+	sequencer, err := strconv.ParseUint(ev.S3.Object.Sequencer, 16, 64)
+	return err != nil || sequencer > c.sequencer
+}
+
+// listenFilter selects the events sent to a listener.
+type listenFilter struct {
+	// All buckets when empty.
+	buckets  map[string]struct{}
+	rulesMap event.RulesMap
+}
+
+// parseListenFilter returns the filter of the buckets, prefixes,
+// suffixes and events in the listen query values. Every prefix is
+// combined with every suffix.
+func parseListenFilter(values url.Values) (filter listenFilter, err error) {
+	prefixes := values[peerRESTListenPrefix]
+	if len(prefixes) == 0 {
+		prefixes = []string{""}
+	}
+	suffixes := values[peerRESTListenSuffix]
+	if len(suffixes) == 0 {
+		suffixes = []string{""}
+	}
+	for _, value := range append(append([]string{}, prefixes...), suffixes...) {
+		if err = event.ValidateFilterRuleValue(value); err != nil {
+			return filter, err
+		}
+	}
+
+	var eventNames []event.Name
+	for _, s := range values[peerRESTListenEvents] {
+		eventName, err := event.ParseName(s)
+		if err != nil {
+			return filter, err
+		}
+
+		eventNames = append(eventNames, eventName)
+	}
+
+	targetID := event.TargetID{ID: mustGetUUID()}
+	filter.rulesMap = make(event.RulesMap)
+	for _, prefix := range prefixes {
+		for _, suffix := range suffixes {
+			filter.rulesMap.Add(event.NewRulesMap(eventNames, event.NewPattern(prefix, suffix), targetID))
+		}
+	}
+
+	filter.buckets = make(map[string]struct{})
+	for _, bucket := range values[peerRESTListenBucket] {
+		filter.buckets[bucket] = struct{}{}
+	}
+	return filter, nil
+}
+
+func (filter listenFilter) match(ev event.Event) bool {
+	if ev.S3.Bucket.Name != "" && len(filter.buckets) != 0 {
+		if _, ok := filter.buckets[ev.S3.Bucket.Name]; !ok {
+			return false
+		}
+	}
+	return filter.rulesMap.MatchSimple(ev.EventName, ev.S3.Object.Key)
+}
+
+// sendMissedListenEvents calls send with the logged events matching
+// filter emitted after cursor and until end, bucket by bucket and
+// oldest first. The pending events of all nodes are saved first, those
+// of an offline node are missing.
+func sendMissedListenEvents(ctx context.Context, objAPI ObjectLayer, filter listenFilter, cursor eventCursor, end time.Time, send func(ev event.Event) error) error {
+	if err := globalEventLog.flush(ctx, objAPI); err != nil {
+		return err
+	}
+	for _, nErr := range globalNotificationSys.FlushEventLog(ctx) {
+		if nErr.Err != nil {
+			reqInfo := (&logger.ReqInfo{}).AppendTags("peerAddress", nErr.Host.String())
+			logger.LogIf(logger.SetReqInfo(ctx, reqInfo), nErr.Err)
+		}
+	}
+
+	var buckets []string
+	for bucket := range filter.buckets {
+		buckets = append(buckets, bucket)
+	}
+	if len(buckets) == 0 {
+		bucketsInfo, err := objAPI.ListBuckets(ctx)
+		if err != nil {
+			return err
+		}
+		for _, bi := range bucketsInfo {
+			buckets = append(buckets, bi.Name)
+		}
+	}
+	sort.Strings(buckets)
+
+	for _, bucket := range buckets {
+		err := walkEventLog(ctx, objAPI, bucket, cursor.time, end, func(ev event.Event) error {
+			if !cursor.after(ev) {
+				return nil
+			}
+			// The object names are escaped in the event log,
+			// unlike in the events sent to listeners.
+			objectName, err := url.QueryUnescape(ev.S3.Object.Key)
+			if err != nil {
+				return nil
+			}
+			ev.S3.Object.Key = objectName
+			if !filter.match(ev) {
+				return nil
+			}
+			return send(ev)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/minio/minio/pkg/event"
+)
+
+func TestListenFilter(t *testing.T) {
+	values := url.Values{
+		peerRESTListenBucket: []string{"images", "videos"},
+		peerRESTListenPrefix: []string{"raw/", "edited/"},
+		peerRESTListenSuffix: []string{".jpg", ".png"},
+		peerRESTListenEvents: []string{"s3:ObjectCreated:*"},
+	}
+	filter, err := parseListenFilter(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newEvent := func(bucket, object string, name event.Name) event.Event {
+		var ev event.Event
+		ev.EventName = name
+		ev.S3.Bucket.Name = bucket
+		ev.S3.Object.Key = object
+		return ev
+	}
+	testCases := []struct {
+		ev    event.Event
+		match bool
+	}{
+		{newEvent("images", "raw/a.jpg", event.ObjectCreatedPut), true},
+		{newEvent("videos", "edited/a.png", event.ObjectCreatedCopy), true},
+		{newEvent("images", "raw/a.gif", event.ObjectCreatedPut), false},
+		{newEvent("images", "other/a.jpg", event.ObjectCreatedPut), false},
+		{newEvent("docs", "raw/a.jpg", event.ObjectCreatedPut), false},
+		{newEvent("images", "raw/a.jpg", event.ObjectRemovedDelete), false},
+	}
+	for i, testCase := range testCases {
+		if match := filter.match(testCase.ev); match != testCase.match {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.match, match)
+		}
+	}
+
+	// No bucket matches all of them.
+	values.Del(peerRESTListenBucket)
+	if filter, err = parseListenFilter(values); err != nil {
+		t.Fatal(err)
+	}
+	if !filter.match(newEvent("docs", "raw/a.jpg", event.ObjectCreatedPut)) {
+		t.Error("expected the events of all buckets to match")
+	}
+
+	values.Set(peerRESTListenPrefix, "raw/\\")
+	if _, err = parseListenFilter(values); err == nil {
+		t.Error("expected an invalid prefix to fail")
+	}
+}
+
+func TestEventCursor(t *testing.T) {
+	newEvent := func(eventTime, sequencer string) event.Event {
+		var ev event.Event
+		ev.EventTime = eventTime
+		ev.S3.Object.Sequencer = sequencer
+		return ev
+	}
+
+	cursor, err := parseEventCursor("2021-06-01T10:02:13.419Z/1685B1D4C2F1A3E8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		ev    event.Event
+		after bool
+	}{
+		// The event at the cursor.
+		{newEvent("2021-06-01T10:02:13.419Z", "1685B1D4C2F1A3E8"), false},
+		// Emitted earlier within the same millisecond.
+		{newEvent("2021-06-01T10:02:13.419Z", "1685B1D4C2F1A3E0"), false},
+		// Emitted later within the same millisecond.
+		{newEvent("2021-06-01T10:02:13.419Z", "1685B1D4C2F1A3F0"), true},
+		{newEvent("2021-06-01T10:02:13.420Z", "1685B1D4C2F1A3E0"), true},
+		// An unknown sequencer is sent again.
+		{newEvent("2021-06-01T10:02:13.419Z", ""), true},
+	}
+	for i, testCase := range testCases {
+		if after := cursor.after(testCase.ev); after != testCase.after {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.after, after)
+		}
+	}
+
+	// Without a sequencer the millisecond of the cursor was received.
+	if cursor, err = parseEventCursor("2021-06-01T10:02:13.419Z"); err != nil {
+		t.Fatal(err)
+	}
+	if cursor.after(newEvent("2021-06-01T10:02:13.419Z", "1685B1D4C2F1A3F0")) {
+		t.Error("expected the events of the millisecond of the cursor to be received")
+	}
+
+	for _, s := range []string{"", "2021-06-01", "2021-06-01T10:02:13.419Z/xyz"} {
+		if _, err = parseEventCursor(s); err == nil {
+			t.Errorf("expected cursor %q to fail", s)
+		}
+	}
+}
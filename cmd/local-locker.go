@@ -226,13 +226,27 @@ func (l *localLocker) ForceUnlock(ctx context.Context, args dsync.LockArgs) (rep
 	default:
 		l.mutex.Lock()
 		defer l.mutex.Unlock()
-		if len(args.UID) != 0 {
-			return false, fmt.Errorf("ForceUnlock called with non-empty UID: %s", args.UID)
+		if len(args.UID) == 0 {
+			for _, resource := range args.Resources {
+				delete(l.lockMap, resource) // Remove the lock (irrespective of write or read lock)
+			}
+			return true, nil
 		}
+
+		// Remove only the lock of the given UID, whoever owns it.
 		for _, resource := range args.Resources {
-			delete(l.lockMap, resource) // Remove the lock (irrespective of write or read lock)
+			lris, ok := l.lockMap[resource]
+			if !ok {
+				continue
+			}
+			for _, lri := range lris {
+				if lri.UID == args.UID {
+					reply = l.removeEntry(resource, dsync.LockArgs{Owner: lri.Owner, UID: lri.UID}, &lris) || reply
+					break
+				}
+			}
 		}
-		return true, nil
+		return reply, nil
 	}
 }
 
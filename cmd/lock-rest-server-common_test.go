@@ -17,6 +17,7 @@
 package cmd
 
 import (
+	"context"
 	"os"
 	"reflect"
 	"sync"
@@ -110,3 +111,39 @@ func TestLockRpcServerRemoveEntry(t *testing.T) {
 		}
 	}
 }
+
+// Test function to force unlock a single lock request of a resource
+func TestLockRpcServerForceUnlockUID(t *testing.T) {
+	testPath, locker, _ := createLockTestServer(t)
+	defer os.RemoveAll(testPath)
+
+	ctx := context.Background()
+	for _, uid := range []string{"0123-4567", "89ab-cdef"} {
+		if ok, err := locker.ll.RLock(ctx, dsync.LockArgs{
+			Owner:     "owner",
+			UID:       uid,
+			Resources: []string{"name"},
+		}); !ok || err != nil {
+			t.Fatalf("Unable to take read lock %s: %v", uid, err)
+		}
+	}
+
+	// test unknown uid
+	if ok, err := locker.ll.ForceUnlock(ctx, dsync.LockArgs{
+		UID:       "unknown-uid",
+		Resources: []string{"name"},
+	}); ok || err != nil {
+		t.Errorf("Expected no lock to be released, got %v, %v", ok, err)
+	}
+
+	if ok, err := locker.ll.ForceUnlock(ctx, dsync.LockArgs{
+		UID:       "0123-4567",
+		Resources: []string{"name"},
+	}); !ok || err != nil {
+		t.Errorf("Expected the lock to be released, got %v, %v", ok, err)
+	}
+	lri := locker.ll.lockMap["name"]
+	if len(lri) != 1 || lri[0].UID != "89ab-cdef" {
+		t.Errorf("Expected only the other read lock to be held, got %#v", lri)
+	}
+}
@@ -221,10 +221,16 @@ func (l *lockRESTServer) ForceUnlockHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	if _, err = l.ll.ForceUnlock(r.Context(), args); err != nil {
+	released, err := l.ll.ForceUnlock(r.Context(), args)
+	if err != nil {
 		l.writeErrorResponse(w, err)
 		return
 	}
+
+	if !released {
+		l.writeErrorResponse(w, errLockNotFound)
+		return
+	}
 }
 
 // lockMaintenance loops over all locks and discards locks
@@ -39,6 +39,8 @@ type ResponseWriter struct {
 
 	TimeToFirstByte time.Duration
 	StartTime       time.Time
+	// Time spent writing the response to the client.
+	WriteTime time.Duration
 	// number of bytes written
 	bytesWritten int
 	// Internal recording buffer
@@ -64,10 +66,12 @@ func (lrw *ResponseWriter) Write(p []byte) (int, error) {
 		// that way following Golang HTTP response behavior.
 		lrw.WriteHeader(http.StatusOK)
 	}
+	writeStart := time.Now().UTC()
 	n, err := lrw.ResponseWriter.Write(p)
+	lrw.WriteTime += time.Since(writeStart)
 	lrw.bytesWritten += n
 	if lrw.TimeToFirstByte == 0 {
-		lrw.TimeToFirstByte = time.Now().UTC().Sub(lrw.StartTime)
+		lrw.TimeToFirstByte = writeStart.Sub(lrw.StartTime)
 	}
 	if (lrw.LogErrBody && lrw.StatusCode >= http.StatusBadRequest) || lrw.LogAllBody {
 		// Always logging error responses.
@@ -148,6 +152,15 @@ func GetAuditEntry(ctx context.Context) *audit.Entry {
 	return nil
 }
 
+// formatTiming formats a request stage duration for audit
+// entries, empty if the request did not go through the stage.
+func formatTiming(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return strconv.FormatInt(d.Nanoseconds(), 10) + "ns"
+}
+
 // AuditLog - logs audit logs to all audit targets.
 func AuditLog(ctx context.Context, w http.ResponseWriter, r *http.Request, reqClaims map[string]interface{}, filterKeys ...string) {
 	// Fast exit if there is not audit target configured
@@ -177,6 +190,7 @@ func AuditLog(ctx context.Context, w http.ResponseWriter, r *http.Request, reqCl
 			statusCode      int
 			timeToResponse  time.Duration
 			timeToFirstByte time.Duration
+			writeTime       time.Duration
 		)
 
 		st, ok := w.(*ResponseWriter)
@@ -184,6 +198,7 @@ func AuditLog(ctx context.Context, w http.ResponseWriter, r *http.Request, reqCl
 			statusCode = st.StatusCode
 			timeToResponse = time.Now().UTC().Sub(st.StartTime)
 			timeToFirstByte = st.TimeToFirstByte
+			writeTime = st.WriteTime
 		}
 
 		entry.API.Name = reqInfo.API
@@ -197,6 +212,10 @@ func AuditLog(ctx context.Context, w http.ResponseWriter, r *http.Request, reqCl
 		if timeToFirstByte != 0 {
 			entry.API.TimeToFirstByte = strconv.FormatInt(timeToFirstByte.Nanoseconds(), 10) + "ns"
 		}
+		entry.API.TimeAuth = formatTiming(reqInfo.GetTiming(TimingAuth))
+		entry.API.TimeObjectLayer = formatTiming(reqInfo.GetTiming(TimingObjectLayer))
+		entry.API.TimeDriveWait = formatTiming(reqInfo.GetTiming(TimingDriveWait))
+		entry.API.TimeNetworkWrite = formatTiming(writeTime)
 	} else {
 		auditEntry := GetAuditEntry(ctx)
 		if auditEntry != nil {
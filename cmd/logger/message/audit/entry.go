@@ -42,6 +42,12 @@ type Entry struct {
 		StatusCode      int    `json:"statusCode,omitempty"`
 		TimeToFirstByte string `json:"timeToFirstByte,omitempty"`
 		TimeToResponse  string `json:"timeToResponse,omitempty"`
+		// Breakdown of the time to response, the time waiting
+		// on drives being included in the object layer time.
+		TimeAuth         string `json:"timeAuth,omitempty"`
+		TimeObjectLayer  string `json:"timeObjectLayer,omitempty"`
+		TimeDriveWait    string `json:"timeDriveWait,omitempty"`
+		TimeNetworkWrite string `json:"timeNetworkWrite,omitempty"`
 	} `json:"api"`
 	RemoteHost string                 `json:"remotehost,omitempty"`
 	RequestID  string                 `json:"requestID,omitempty"`
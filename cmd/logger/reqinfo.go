@@ -19,7 +19,10 @@ package logger
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // Key used for Get/SetReqInfo
@@ -33,8 +36,28 @@ type KeyVal struct {
 	Val interface{}
 }
 
+// TimingStage - a stage of a request whose duration is reported in audit logs.
+type TimingStage int
+
+// Request stages whose duration is accounted.
+const (
+	// TimingAuth - signature verification and policy evaluation.
+	TimingAuth TimingStage = iota
+	// TimingObjectLayer - calls to the object layer, including
+	// reading the object data returned by it.
+	TimingObjectLayer
+	// TimingDriveWait - waiting on drive operations, summed over
+	// all drives accessed concurrently.
+	TimingDriveWait
+
+	numTimingStages
+)
+
 // ReqInfo stores the request info.
 type ReqInfo struct {
+	// Accessed atomically, kept first for 64-bit alignment.
+	timings [numTimingStages]int64
+
 	RemoteHost   string   // Client Host/IP
 	Host         string   // Node Host/IP
 	UserAgent    string   // User Agent
@@ -119,6 +142,59 @@ func (r *ReqInfo) GetTagsMap() map[string]interface{} {
 	return m
 }
 
+// AddTiming - accounts d to the time spent by the request in stage.
+func (r *ReqInfo) AddTiming(stage TimingStage, d time.Duration) {
+	if r == nil {
+		return
+	}
+	atomic.AddInt64(&r.timings[stage], int64(d))
+}
+
+// GetTiming - returns the time spent by the request in stage.
+func (r *ReqInfo) GetTiming(stage TimingStage) time.Duration {
+	if r == nil {
+		return 0
+	}
+	return time.Duration(atomic.LoadInt64(&r.timings[stage]))
+}
+
+// AddRequestTiming - accounts the time elapsed since start to the stage
+// of the request in ctx. Unlike GetReqInfo, it does nothing if ctx has
+// no ReqInfo, so it is cheap to call for internal operations.
+func AddRequestTiming(ctx context.Context, stage TimingStage, start time.Time) {
+	if ctx == nil {
+		return
+	}
+	if r, ok := ctx.Value(contextLogKey).(*ReqInfo); ok {
+		r.AddTiming(stage, time.Since(start))
+	}
+}
+
+// NewRequestTimingReader - returns a reader accounting the time spent
+// reading r to the stage of the request in ctx, r if ctx has no ReqInfo.
+func NewRequestTimingReader(ctx context.Context, r io.Reader, stage TimingStage) io.Reader {
+	if ctx == nil {
+		return r
+	}
+	if req, ok := ctx.Value(contextLogKey).(*ReqInfo); ok {
+		return &requestTimingReader{Reader: r, req: req, stage: stage}
+	}
+	return r
+}
+
+type requestTimingReader struct {
+	io.Reader
+	req   *ReqInfo
+	stage TimingStage
+}
+
+func (r *requestTimingReader) Read(p []byte) (int, error) {
+	start := time.Now()
+	n, err := r.Reader.Read(p)
+	r.req.AddTiming(r.stage, time.Since(start))
+	return n, err
+}
+
 // SetReqInfo sets ReqInfo in the context.
 func SetReqInfo(ctx context.Context, req *ReqInfo) context.Context {
 	if ctx == nil {
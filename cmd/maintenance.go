@@ -0,0 +1,284 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	maintenanceConfigFile = minioConfigPrefix + "/maintenance.json"
+
+	// The maintenance state is reloaded this often, so that nodes
+	// which missed a change made on another node catch up with it.
+	maintenanceRefreshInterval = time.Minute
+)
+
+var (
+	errMaintenanceInvalidNode = AdminError{
+		Code:       "XMinioAdminInvalidMaintenanceNode",
+		Message:    "The specified node is not a server of this deployment",
+		StatusCode: http.StatusBadRequest,
+	}
+	errMaintenanceInvalidPool = AdminError{
+		Code:       "XMinioAdminInvalidMaintenancePool",
+		Message:    "The specified pool does not exist, or is the only pool of this deployment",
+		StatusCode: http.StatusBadRequest,
+	}
+	errMaintenanceAllPools = AdminError{
+		Code:       "XMinioAdminMaintenanceAllPools",
+		Message:    "At least one pool must stay out of maintenance mode for new objects",
+		StatusCode: http.StatusConflict,
+	}
+)
+
+// MaintenanceSys - keeps track of the nodes and pools in maintenance
+// mode. Nodes in maintenance reject writes sent to them, pools in
+// maintenance reject writes to the objects they hold and are not
+// chosen for new objects. Reads are served by both.
+type MaintenanceSys struct {
+	mu    sync.RWMutex
+	nodes map[string]struct{}
+	pools map[int]struct{}
+}
+
+// NewMaintenanceSys - creates a new maintenance system.
+func NewMaintenanceSys() *MaintenanceSys {
+	return &MaintenanceSys{
+		nodes: make(map[string]struct{}),
+		pools: make(map[int]struct{}),
+	}
+}
+
+func loadMaintenanceStatus(ctx context.Context, objAPI ObjectLayer) (status madmin.MaintenanceStatus, err error) {
+	data, err := readConfig(ctx, objAPI, maintenanceConfigFile)
+	if err != nil {
+		if err == errConfigNotFound {
+			err = nil
+		}
+		return status, err
+	}
+	err = json.Unmarshal(data, &status)
+	return status, err
+}
+
+func (sys *MaintenanceSys) set(status madmin.MaintenanceStatus) {
+	nodes := make(map[string]struct{}, len(status.Nodes))
+	for _, node := range status.Nodes {
+		nodes[node] = struct{}{}
+	}
+	pools := make(map[int]struct{}, len(status.Pools))
+	for _, pool := range status.Pools {
+		pools[pool] = struct{}{}
+	}
+
+	sys.mu.Lock()
+	sys.nodes = nodes
+	sys.pools = pools
+	sys.mu.Unlock()
+}
+
+// Load - reloads the maintenance state from the backend.
+func (sys *MaintenanceSys) Load(ctx context.Context, objAPI ObjectLayer) error {
+	status, err := loadMaintenanceStatus(ctx, objAPI)
+	if err != nil {
+		return err
+	}
+	sys.set(status)
+	return nil
+}
+
+// Status - returns the nodes and pools in maintenance mode.
+func (sys *MaintenanceSys) Status() madmin.MaintenanceStatus {
+	sys.mu.RLock()
+	defer sys.mu.RUnlock()
+
+	status := madmin.MaintenanceStatus{
+		Nodes: make([]string, 0, len(sys.nodes)),
+		Pools: make([]int, 0, len(sys.pools)),
+	}
+	for node := range sys.nodes {
+		status.Nodes = append(status.Nodes, node)
+	}
+	for pool := range sys.pools {
+		status.Pools = append(status.Pools, pool)
+	}
+	sort.Strings(status.Nodes)
+	sort.Ints(status.Pools)
+	return status
+}
+
+// isDeploymentNode - returns true if node, as host:port, is one of
+// the servers of the deployment.
+func isDeploymentNode(node string) bool {
+	for _, ep := range globalEndpoints {
+		for _, endpoint := range ep.Endpoints {
+			if endpoint.Host != "" && endpoint.Host == node {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetNode - puts the node into maintenance mode or takes it out of it.
+func (sys *MaintenanceSys) SetNode(ctx context.Context, objAPI ObjectLayer, node string, enable bool) (madmin.MaintenanceStatus, error) {
+	if !isDeploymentNode(node) {
+		return madmin.MaintenanceStatus{}, errMaintenanceInvalidNode
+	}
+	return sys.update(ctx, objAPI, func(status *madmin.MaintenanceStatus) error {
+		nodes := status.Nodes[:0]
+		for _, n := range status.Nodes {
+			if n != node {
+				nodes = append(nodes, n)
+			}
+		}
+		if enable {
+			nodes = append(nodes, node)
+		}
+		status.Nodes = nodes
+		return nil
+	})
+}
+
+// SetPool - puts the pool into maintenance mode or takes it out of it.
+// At least one pool is kept out of maintenance for new objects.
+func (sys *MaintenanceSys) SetPool(ctx context.Context, objAPI ObjectLayer, pool int, enable bool) (madmin.MaintenanceStatus, error) {
+	z, ok := objAPI.(*erasureServerPools)
+	if !ok || pool < 0 || pool >= len(z.serverPools) || z.SinglePool() {
+		return madmin.MaintenanceStatus{}, errMaintenanceInvalidPool
+	}
+	return sys.update(ctx, objAPI, func(status *madmin.MaintenanceStatus) error {
+		pools := status.Pools[:0]
+		for _, p := range status.Pools {
+			if p != pool {
+				pools = append(pools, p)
+			}
+		}
+		if enable {
+			pools = append(pools, pool)
+		}
+		if len(pools) >= len(z.serverPools) {
+			return errMaintenanceAllPools
+		}
+		status.Pools = pools
+		return nil
+	})
+}
+
+func (sys *MaintenanceSys) update(ctx context.Context, objAPI ObjectLayer, fn func(*madmin.MaintenanceStatus) error) (madmin.MaintenanceStatus, error) {
+	locker := objAPI.NewNSLock(minioMetaBucket, minioConfigPrefix+"/maintenance.lock")
+	ctx, err := locker.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return madmin.MaintenanceStatus{}, err
+	}
+	defer locker.Unlock()
+
+	status, err := loadMaintenanceStatus(ctx, objAPI)
+	if err != nil {
+		return status, err
+	}
+	if err = fn(&status); err != nil {
+		return madmin.MaintenanceStatus{}, err
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		return status, err
+	}
+	if err = saveConfig(ctx, objAPI, maintenanceConfigFile, data); err != nil {
+		return status, err
+	}
+	sys.set(status)
+	return sys.Status(), nil
+}
+
+// NodeInMaintenance - returns true if the node is in maintenance mode.
+func (sys *MaintenanceSys) NodeInMaintenance(node string) bool {
+	sys.mu.RLock()
+	defer sys.mu.RUnlock()
+	_, ok := sys.nodes[node]
+	return ok
+}
+
+// LocalNodeInMaintenance - returns true if this server is in maintenance mode.
+func (sys *MaintenanceSys) LocalNodeInMaintenance() bool {
+	return sys.NodeInMaintenance(globalLocalNodeName)
+}
+
+// PoolInMaintenance - returns true if the pool is in maintenance mode.
+func (sys *MaintenanceSys) PoolInMaintenance(pool int) bool {
+	sys.mu.RLock()
+	defer sys.mu.RUnlock()
+	_, ok := sys.pools[pool]
+	return ok
+}
+
+func initMaintenance(ctx context.Context, objAPI ObjectLayer) {
+	logger.LogIf(ctx, globalMaintenanceSys.Load(ctx, objAPI))
+	go func() {
+		ticker := time.NewTicker(maintenanceRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				logger.LogIf(ctx, globalMaintenanceSys.Load(ctx, objAPI))
+			}
+		}
+	}()
+}
+
+// setMaintenanceHandler - rejects S3 writes sent to a server in
+// maintenance mode, reads and internode, admin, health and metrics
+// requests are still served.
+func setMaintenanceHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if globalMaintenanceSys != nil && globalMaintenanceSys.LocalNodeInMaintenance() && isMaintenanceWriteReq(r) {
+			writeErrorResponse(r.Context(), w, errorCodes.ToAPIErr(ErrNodeMaintenance), r.URL, guessIsBrowserReq(r))
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// isMaintenanceWriteReq - returns true if the request may modify data.
+func isMaintenanceWriteReq(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	if guessIsRPCReq(r) || isAdminReq(r) || guessIsHealthCheckReq(r) ||
+		guessIsMetricsReq(r) || guessIsLoginSTSReq(r) {
+		return false
+	}
+	// S3 Select only reads the object.
+	if _, ok := r.URL.Query()["select"]; ok && r.Method == http.MethodPost {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestMaintenanceSysStatus(t *testing.T) {
+	sys := NewMaintenanceSys()
+	sys.set(madmin.MaintenanceStatus{
+		Nodes: []string{"server2:9000", "server1:9000"},
+		Pools: []int{2, 1},
+	})
+
+	if !sys.NodeInMaintenance("server1:9000") || sys.NodeInMaintenance("server3:9000") {
+		t.Fatal("Unexpected node maintenance state")
+	}
+	if !sys.PoolInMaintenance(1) || sys.PoolInMaintenance(0) {
+		t.Fatal("Unexpected pool maintenance state")
+	}
+
+	expected := madmin.MaintenanceStatus{
+		Nodes: []string{"server1:9000", "server2:9000"},
+		Pools: []int{1, 2},
+	}
+	if status := sys.Status(); !reflect.DeepEqual(status, expected) {
+		t.Fatalf("Expected %v, got %v", expected, status)
+	}
+}
+
+func TestIsMaintenanceWriteReq(t *testing.T) {
+	testCases := []struct {
+		method string
+		url    string
+		write  bool
+	}{
+		{http.MethodGet, "/bucket/object", false},
+		{http.MethodHead, "/bucket/object", false},
+		{http.MethodPut, "/bucket/object", true},
+		{http.MethodDelete, "/bucket/object", true},
+		{http.MethodPost, "/bucket/object?uploads", true},
+		{http.MethodPost, "/bucket/object?select&select-type=2", false},
+		{http.MethodPost, adminPathPrefix + adminAPIVersionPrefix + "/maintenance", false},
+		{http.MethodPost, minioReservedBucketPath + "/peer/v13/loadmaintenance", false},
+	}
+	for i, testCase := range testCases {
+		r := httptest.NewRequest(testCase.method, testCase.url, nil)
+		if write := isMaintenanceWriteReq(r); write != testCase.write {
+			t.Errorf("Test %d: %s %s: expected %v, got %v", i+1, testCase.method, testCase.url, testCase.write, write)
+		}
+	}
+}
+
+func TestPoolInMaintenanceMetaBucketWrites(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var endpoints EndpointServerPools
+	for i := 0; i < 2; i++ {
+		disks, err := getRandomDisks(4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer removeRoots(disks)
+		endpoints = append(endpoints, mustGetPoolEndpoints(disks...)...)
+	}
+	obj, _, err := initObjectLayer(ctx, endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(ctx)
+	z := obj.(*erasureServerPools)
+
+	if err = z.MakeBucketWithLocation(ctx, "bucket", BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, bucket := range []string{"bucket", minioMetaBucket} {
+		if _, err = z.PutObject(ctx, bucket, "object", mustGetPutObjReader(t, bytes.NewReader([]byte("abcd")), 4, "", ""), ObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	defer func(sys *MaintenanceSys) { globalMaintenanceSys = sys }(globalMaintenanceSys)
+	globalMaintenanceSys = NewMaintenanceSys()
+
+	// Pools enter and leave maintenance mode, whichever pool
+	// holds the maintenance state.
+	for _, pool := range []int{0, 1} {
+		if _, err = globalMaintenanceSys.SetPool(ctx, z, pool, true); err != nil {
+			t.Fatalf("Unable to put pool %d into maintenance: %v", pool, err)
+		}
+		if _, err = globalMaintenanceSys.SetPool(ctx, z, 1-pool, true); err != errMaintenanceAllPools {
+			t.Fatalf("Expected %v, got %v", errMaintenanceAllPools, err)
+		}
+		if _, err = globalMaintenanceSys.SetPool(ctx, z, pool, false); err != nil {
+			t.Fatalf("Unable to take pool %d out of maintenance: %v", pool, err)
+		}
+	}
+
+	globalMaintenanceSys.set(madmin.MaintenanceStatus{Pools: []int{0, 1}})
+
+	// Objects of regular buckets cannot be modified,
+	_, err = z.PutObject(ctx, "bucket", "object", mustGetPutObjReader(t, bytes.NewReader([]byte("efgh")), 4, "", ""), ObjectOptions{})
+	if _, ok := err.(PoolInMaintenance); !ok {
+		t.Fatalf("Expected PoolInMaintenance, got %v", err)
+	}
+	// but the server must still be able to update its own metadata.
+	for _, object := range []string{"object", "new-object"} {
+		if _, err = z.PutObject(ctx, minioMetaBucket, object, mustGetPutObjReader(t, bytes.NewReader([]byte("efgh")), 4, "", ""), ObjectOptions{}); err != nil {
+			t.Fatalf("Unable to write %s to the meta bucket: %v", object, err)
+		}
+	}
+	if _, err = z.DeleteObject(ctx, minioMetaBucket, "object", ObjectOptions{}); err != nil {
+		t.Fatalf("Unable to delete from the meta bucket: %v", err)
+	}
+}
@@ -75,7 +75,7 @@ func newBucketMetacache(bucket string, cleanup bool) *bucketMetacache {
 }
 
 func (b *bucketMetacache) debugf(format string, data ...interface{}) {
-	if serverDebugLog {
+	if debugLogEnabled(debugLogListing) {
 		console.Debugf(format+"\n", data...)
 	}
 }
@@ -126,13 +126,13 @@ func (o listPathOptions) newMetacache() metacache {
 }
 
 func (o *listPathOptions) debugf(format string, data ...interface{}) {
-	if serverDebugLog {
+	if debugLogEnabled(debugLogListing) {
 		console.Debugf(format+"\n", data...)
 	}
 }
 
 func (o *listPathOptions) debugln(data ...interface{}) {
-	if serverDebugLog {
+	if debugLogEnabled(debugLogListing) {
 		console.Debugln(data...)
 	}
 }
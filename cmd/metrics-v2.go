@@ -107,7 +107,12 @@ const (
 	writeBytes    MetricName = "write_bytes"
 	wcharBytes    MetricName = "wchar_bytes"
 
-	usagePercent MetricName = "update_percent"
+	usagePercent       MetricName = "update_percent"
+	utilizationPercent MetricName = "utilization_percent"
+
+	queueDepth          MetricName = "queue_depth"
+	readLatencySeconds  MetricName = "read_latency_seconds"
+	writeLatencySeconds MetricName = "write_latency_seconds"
 
 	commitInfo  MetricName = "commit_info"
 	usageInfo   MetricName = "usage_info"
@@ -358,6 +363,51 @@ func getNodeDiskTotalBytesMD() MetricDescription {
 		Type:      gaugeMetric,
 	}
 }
+func getNodeDiskReadLatencyMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      readLatencySeconds,
+		Help:      "Percentiles of the duration of reads on a disk in the last minute.",
+		Type:      gaugeMetric,
+	}
+}
+func getNodeDiskWriteLatencyMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      writeLatencySeconds,
+		Help:      "Percentiles of the duration of writes on a disk in the last minute.",
+		Type:      gaugeMetric,
+	}
+}
+func getNodeDiskQueueDepthMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      queueDepth,
+		Help:      "Total calls in progress on a disk.",
+		Type:      gaugeMetric,
+	}
+}
+func getNodeDiskUtilizationMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      utilizationPercent,
+		Help:      "Percentage of time a disk was busy in the last minute.",
+		Type:      gaugeMetric,
+	}
+}
+func getNodeDiskErrorsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      errorsTotal,
+		Help:      "Total calls failed on a disk since server start.",
+		Type:      counterMetric,
+	}
+}
 func getUsageLastScanActivityMD() MetricDescription {
 	return MetricDescription{
 		Namespace: minioMetricNamespace,
@@ -1355,6 +1405,42 @@ func getLocalStorageMetrics() MetricsGroup {
 					Value:          float64(disk.TotalSpace),
 					VariableLabels: map[string]string{"disk": disk.DrivePath},
 				})
+
+				if disk.Metrics == nil {
+					continue
+				}
+				for quantile, latency := range disk.Metrics.ReadLatencies {
+					metrics = append(metrics, Metric{
+						Description:    getNodeDiskReadLatencyMD(),
+						Value:          time.Duration(latency).Seconds(),
+						VariableLabels: map[string]string{"disk": disk.DrivePath, "quantile": quantile},
+					})
+				}
+				for quantile, latency := range disk.Metrics.WriteLatencies {
+					metrics = append(metrics, Metric{
+						Description:    getNodeDiskWriteLatencyMD(),
+						Value:          time.Duration(latency).Seconds(),
+						VariableLabels: map[string]string{"disk": disk.DrivePath, "quantile": quantile},
+					})
+				}
+
+				metrics = append(metrics, Metric{
+					Description:    getNodeDiskQueueDepthMD(),
+					Value:          float64(disk.Metrics.QueueDepth),
+					VariableLabels: map[string]string{"disk": disk.DrivePath},
+				})
+
+				metrics = append(metrics, Metric{
+					Description:    getNodeDiskUtilizationMD(),
+					Value:          disk.Metrics.Utilization,
+					VariableLabels: map[string]string{"disk": disk.DrivePath},
+				})
+
+				metrics = append(metrics, Metric{
+					Description:    getNodeDiskErrorsMD(),
+					Value:          float64(disk.Metrics.TotalErrors),
+					VariableLabels: map[string]string{"disk": disk.DrivePath},
+				})
 			}
 			return
 		},
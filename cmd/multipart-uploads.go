@@ -0,0 +1,144 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/config/multipart"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// Recorded in the metadata of a multipart upload until it completes.
+	multipartObjectKey    = ReservedMetadataPrefix + "Multipart-Object"
+	multipartInitiatorKey = ReservedMetadataPrefix + "Multipart-Initiator"
+	// The bitrot algorithm of all parts, chosen when the upload starts.
+	multipartBitrotKey = ReservedMetadataPrefix + "Multipart-Bitrot"
+)
+
+var (
+	globalMultipartConfig = multipart.Config{
+		AbortIncomplete: true,
+		AbortAfter:      GlobalStaleUploadsExpiry,
+	}
+	globalMultipartConfigMu sync.RWMutex
+)
+
+func getMultipartConfig() multipart.Config {
+	globalMultipartConfigMu.RLock()
+	defer globalMultipartConfigMu.RUnlock()
+	return globalMultipartConfig
+}
+
+// multipartUploadsFilter selects the multipart uploads listed by
+// listAllMultipartUploads, empty fields select all uploads.
+type multipartUploadsFilter struct {
+	bucket    string
+	prefix    string
+	olderThan time.Time
+}
+
+func (f multipartUploadsFilter) match(u madmin.MultipartUploadInfo) bool {
+	if f.bucket != "" && u.Bucket != f.bucket {
+		return false
+	}
+	if f.prefix != "" && !strings.HasPrefix(u.Object, f.prefix) {
+		return false
+	}
+	return f.olderThan.IsZero() || u.Initiated.Before(f.olderThan)
+}
+
+// walkMultipartUploads calls fn for each multipart upload of the set,
+// as read from a single disk.
+func (er erasureObjects) walkMultipartUploads(ctx context.Context, fn func(madmin.MultipartUploadInfo)) error {
+	var shaDirs []string
+	var disk StorageAPI
+	var err error
+	for _, disk = range er.getLoadBalancedDisks(true) {
+		shaDirs, err = disk.ListDir(ctx, minioMetaMultipartBucket, "", -1)
+		if err == errDiskNotFound {
+			continue
+		}
+		break
+	}
+	if err != nil {
+		if err == errFileNotFound || err == errVolumeNotFound {
+			return nil
+		}
+		return err
+	}
+
+	for _, shaDir := range shaDirs {
+		uploadIDs, err := disk.ListDir(ctx, minioMetaMultipartBucket, shaDir, -1)
+		if err != nil {
+			// Removed in the meantime.
+			continue
+		}
+		for _, uploadID := range uploadIDs {
+			if err = ctx.Err(); err != nil {
+				return err
+			}
+			uploadID = strings.TrimSuffix(uploadID, SlashSeparator)
+			fi, err := disk.ReadVersion(ctx, minioMetaMultipartBucket, pathJoin(shaDir, uploadID), "", false)
+			if err != nil {
+				continue
+			}
+			u := madmin.MultipartUploadInfo{
+				UploadID:  uploadID,
+				Initiated: fi.ModTime,
+				Initiator: fi.Metadata[multipartInitiatorKey],
+				Parts:     len(fi.Parts),
+			}
+			if bucketObject := fi.Metadata[multipartObjectKey]; bucketObject != "" {
+				u.Bucket, u.Object = path2BucketObject(bucketObject)
+			}
+			for _, part := range fi.Parts {
+				u.Size += part.Size
+			}
+			fn(u)
+		}
+	}
+	return nil
+}
+
+// listAllMultipartUploads returns the multipart uploads of all buckets
+// matching filter, oldest first.
+func (z *erasureServerPools) listAllMultipartUploads(ctx context.Context, filter multipartUploadsFilter) ([]madmin.MultipartUploadInfo, error) {
+	var uploads []madmin.MultipartUploadInfo
+	for _, pool := range z.serverPools {
+		for _, set := range pool.sets {
+			err := set.walkMultipartUploads(ctx, func(u madmin.MultipartUploadInfo) {
+				if filter.match(u) {
+					uploads = append(uploads, u)
+				}
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		return uploads[i].Initiated.Before(uploads[j].Initiated)
+	})
+	return uploads, nil
+}
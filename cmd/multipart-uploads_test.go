@@ -0,0 +1,87 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestListAllMultipartUploads(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	z := obj.(*erasureServerPools)
+	if err = obj.MakeBucketWithLocation(ctx, "bucket", BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	uploadID, err := obj.NewMultipartUpload(ctx, "bucket", "dir/object", ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("a"), 1024)
+	_, err = obj.PutObjectPart(ctx, "bucket", "dir/object", uploadID, 1, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uploads, err := z.listAllMultipartUploads(ctx, multipartUploadsFilter{bucket: "bucket", prefix: "dir/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uploads) != 1 {
+		t.Fatalf("expected 1 upload, got %d", len(uploads))
+	}
+	u := uploads[0]
+	if u.Bucket != "bucket" || u.Object != "dir/object" || u.UploadID != uploadID {
+		t.Fatalf("unexpected upload %+v", u)
+	}
+	if u.Parts != 1 || u.Size != int64(len(data)) {
+		t.Fatalf("expected 1 part of %d bytes, got %d parts of %d bytes", len(data), u.Parts, u.Size)
+	}
+
+	for _, filter := range []multipartUploadsFilter{
+		{bucket: "other"},
+		{prefix: "other/"},
+		{olderThan: UTCNow().Add(-time.Hour)},
+	} {
+		uploads, err = z.listAllMultipartUploads(ctx, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(uploads) != 0 {
+			t.Fatalf("filter %+v: expected no upload, got %d", filter, len(uploads))
+		}
+	}
+
+	if err = obj.AbortMultipartUpload(ctx, "bucket", "dir/object", uploadID, ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if uploads, err = z.listAllMultipartUploads(ctx, multipartUploadsFilter{}); err != nil || len(uploads) != 0 {
+		t.Fatalf("expected no upload after abort, got %d (%v)", len(uploads), err)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/minio/minio/pkg/event"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+var errNotificationTargetNotFound = errors.New("notification target not found")
+
+// notificationTargetLists returns the lists of the notification
+// targets configured in the server config and the environment.
+func notificationTargetLists() []*event.TargetList {
+	var lists []*event.TargetList
+	for _, list := range []*event.TargetList{globalConfigTargetList, globalEnvTargetList} {
+		if list != nil {
+			lists = append(lists, list)
+		}
+	}
+	return lists
+}
+
+func toNotificationTargetStatus(stat event.TargetStat) madmin.NotificationTargetStatus {
+	status := madmin.NotificationTargetStatus{
+		Node:          globalLocalNodeName,
+		Target:        stat.ID.String(),
+		Online:        stat.Active,
+		LastErrorTime: stat.LastErrTime,
+		LastDelivery:  stat.LastDelivery,
+		QueueStore:    stat.HasQueueStore,
+		QueuedEvents:  stat.QueuedEvents,
+	}
+	if stat.Err != nil {
+		status.Error = stat.Err.Error()
+	}
+	if stat.LastErr != nil {
+		status.LastError = stat.LastErr.Error()
+	}
+	return status
+}
+
+// localNotificationTargetsStatus returns the state of the notification
+// targets on this server, connecting those not connected yet.
+func localNotificationTargetsStatus() []madmin.NotificationTargetStatus {
+	statuses := []madmin.NotificationTargetStatus{}
+	for _, list := range notificationTargetLists() {
+		for _, stat := range list.Stats() {
+			statuses = append(statuses, toNotificationTargetStatus(stat))
+		}
+	}
+	return statuses
+}
+
+// localNotificationTargetStatus returns the state of the notification
+// target on this server, connecting it if it is not connected yet.
+func localNotificationTargetStatus(targetID event.TargetID) (madmin.NotificationTargetStatus, error) {
+	for _, list := range notificationTargetLists() {
+		if stat, ok := list.Stat(targetID); ok {
+			return toNotificationTargetStatus(stat), nil
+		}
+	}
+	return madmin.NotificationTargetStatus{}, errNotificationTargetNotFound
+}
+
+// localSendTestNotification sends an s3:TestEvent event to the
+// notification target on this server and returns its state.
+func localSendTestNotification(targetID event.TargetID) (madmin.NotificationTargetStatus, error) {
+	now := time.Now().UTC()
+	respElements := map[string]string{
+		"x-minio-origin-endpoint": globalMinioEndpoint,
+	}
+	if globalDeploymentID != "" {
+		respElements["x-minio-deployment-id"] = globalDeploymentID
+	}
+	ev := event.Event{
+		EventVersion:      "2.0",
+		EventSource:       "minio:admin",
+		AwsRegion:         globalServerRegion,
+		EventTime:         now.Format(event.AMZTimeFormat),
+		EventName:         event.TestEvent,
+		RequestParameters: map[string]string{},
+		ResponseElements:  respElements,
+		S3: event.Metadata{
+			SchemaVersion:   "1.0",
+			ConfigurationID: "Config",
+			Object: event.Object{
+				Sequencer: fmt.Sprintf("%X", now.UnixNano()),
+			},
+		},
+		Source: event.Source{
+			Host: globalLocalNodeName,
+		},
+	}
+
+	for _, list := range notificationTargetLists() {
+		// Failures to deliver are reported as the last error of the target.
+		if ok, _ := list.SendTest(targetID, ev); ok {
+			return localNotificationTargetStatus(targetID)
+		}
+	}
+	return madmin.NotificationTargetStatus{}, errNotificationTargetNotFound
+}
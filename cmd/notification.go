@@ -652,6 +652,51 @@ func (sys *NotificationSys) GetLocks(ctx context.Context, r *http.Request) []*Pe
 	return locksResp
 }
 
+// LoadMaintenance - reloads the maintenance state on all peers.
+func (sys *NotificationSys) LoadMaintenance(ctx context.Context) []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(ctx, func() error {
+			return client.LoadMaintenance(ctx)
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
+// LoadBreakGlass - reloads the break-glass state on all peers.
+func (sys *NotificationSys) LoadBreakGlass(ctx context.Context) []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(ctx, func() error {
+			return client.LoadBreakGlass(ctx)
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
+// FlushEventLog - saves the pending events of all peers.
+func (sys *NotificationSys) FlushEventLog(ctx context.Context) []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(ctx, func() error {
+			return client.FlushEventLog(ctx)
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
 // LoadBucketMetadata - calls LoadBucketMetadata call on all peers
 func (sys *NotificationSys) LoadBucketMetadata(ctx context.Context, bucketName string) {
 	ng := WithNPeers(len(sys.peerClients))
@@ -872,11 +917,45 @@ func (sys *NotificationSys) Send(args eventArgs) {
 	targetIDSet := sys.bucketRulesMap[args.BucketName].Match(args.EventName, args.Object.Name)
 	sys.RUnlock()
 
-	if len(targetIDSet) == 0 {
+	// The event log keeps all the events, they are sent again
+	// to listeners resuming from a cursor.
+	if len(targetIDSet) == 0 && !getEventLogConfig().Enabled {
 		return
 	}
 
-	sys.targetList.Send(args.ToEvent(true), targetIDSet, sys.targetResCh)
+	ev := args.ToEvent(true)
+	globalEventLog.add(args.BucketName, ev)
+	if len(targetIDSet) != 0 {
+		sys.targetList.Send(ev, targetIDSet, sys.targetResCh)
+	}
+}
+
+// Replay - sends a logged event of the bucket again to the target and
+// waits for the target to take it, if the notification rules of the
+// bucket still send the event to the target. objectName is the
+// unescaped key of the event.
+func (sys *NotificationSys) Replay(bucketName, objectName string, ev event.Event, targetID event.TargetID) (bool, error) {
+	sys.RLock()
+	targetIDSet := sys.bucketRulesMap[bucketName].Match(ev.EventName, objectName)
+	sys.RUnlock()
+
+	if _, ok := targetIDSet[targetID]; !ok {
+		return false, nil
+	}
+	target, ok := sys.targetList.TargetMap()[targetID]
+	if !ok {
+		return false, errEventReplayNoSuchTarget
+	}
+	return true, target.Save(ev)
+}
+
+// SendToTargets - sends the event to the given targets, regardless
+// of the notification rules of the buckets.
+func (sys *NotificationSys) SendToTargets(ev event.Event, targetIDs ...event.TargetID) {
+	if len(targetIDs) == 0 {
+		return
+	}
+	sys.targetList.Send(ev, event.NewTargetIDSet(targetIDs...), sys.targetResCh)
 }
 
 // NetInfo - Net information
@@ -1459,6 +1538,261 @@ func sendEvent(args eventArgs) {
 	globalNotificationSys.Send(args)
 }
 
+// GetCopyProgress - gets the server-side copies in progress on all nodes
+// including self, oldest first.
+func (sys *NotificationSys) GetCopyProgress(ctx context.Context) []madmin.CopyProgress {
+	replies := make([][]madmin.CopyProgress, len(sys.peerClients))
+	g := errgroup.WithNErrs(len(sys.peerClients))
+	for index := range sys.peerClients {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		index := index
+		g.Go(func() error {
+			var err error
+			replies[index], err = sys.peerClients[index].GetCopyProgress(ctx)
+			return err
+		}, index)
+	}
+
+	for index, err := range g.Wait() {
+		if err != nil {
+			reqInfo := (&logger.ReqInfo{}).AppendTags("peerAddress",
+				sys.peerClients[index].host.String())
+			ctx := logger.SetReqInfo(ctx, reqInfo)
+			logger.LogOnceIf(ctx, err, sys.peerClients[index].host.String())
+		}
+	}
+
+	copies := globalCopyProgress.list()
+	for _, reply := range replies {
+		copies = append(copies, reply...)
+	}
+	sortCopyProgress(copies)
+	return copies
+}
+
+// SetLogLevel - sets the log level of a subsystem, of all subsystems if
+// subsys is empty, on the given nodes including self, or on all nodes
+// when none are given. Returns the resulting log levels of those nodes.
+func (sys *NotificationSys) SetLogLevel(ctx context.Context, subsys string, level madmin.LogLevel, nodes set.StringSet) []madmin.NodeLogLevels {
+	return sys.logLevels(ctx, nodes, func(client *peerRESTClient) (madmin.NodeLogLevels, error) {
+		return client.SetLogLevel(ctx, subsys, level)
+	}, func() (madmin.NodeLogLevels, error) {
+		err := globalDebugLog.setLevel(subsys, level)
+		return globalDebugLog.getLevels(), err
+	})
+}
+
+// GetLogLevels - gets the log level of every subsystem on all nodes including self.
+func (sys *NotificationSys) GetLogLevels(ctx context.Context) []madmin.NodeLogLevels {
+	return sys.logLevels(ctx, nil, func(client *peerRESTClient) (madmin.NodeLogLevels, error) {
+		return client.GetLogLevels(ctx)
+	}, func() (madmin.NodeLogLevels, error) {
+		return globalDebugLog.getLevels(), nil
+	})
+}
+
+func (sys *NotificationSys) logLevels(ctx context.Context, nodes set.StringSet, peerFn func(*peerRESTClient) (madmin.NodeLogLevels, error), localFn func() (madmin.NodeLogLevels, error)) []madmin.NodeLogLevels {
+	var levels []madmin.NodeLogLevels
+	if nodes.IsEmpty() || nodes.Contains(globalLocalNodeName) {
+		l, err := localFn()
+		if err != nil {
+			l.Error = err.Error()
+		}
+		levels = append(levels, l)
+	}
+
+	replies := make([]madmin.NodeLogLevels, len(sys.peerClients))
+	g := errgroup.WithNErrs(len(sys.peerClients))
+	for index, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		replies[index].Node = client.host.String()
+		if !nodes.IsEmpty() && !nodes.Contains(replies[index].Node) {
+			continue
+		}
+		index, client := index, client
+		g.Go(func() error {
+			reply, err := peerFn(client)
+			if err != nil {
+				return err
+			}
+			replies[index] = reply
+			return nil
+		}, index)
+	}
+
+	for index, err := range g.Wait() {
+		if err != nil {
+			replies[index].Error = err.Error()
+		}
+	}
+	for _, reply := range replies {
+		if reply.Subsystems != nil || reply.Error != "" {
+			levels = append(levels, reply)
+		}
+	}
+	return levels
+}
+
+// NotificationTargetsStatus - gets the state of the notification targets
+// on all nodes including self, connecting those not connected yet.
+func (sys *NotificationSys) NotificationTargetsStatus(ctx context.Context) []madmin.NotificationTargetStatus {
+	statuses := localNotificationTargetsStatus()
+
+	replies := make([][]madmin.NotificationTargetStatus, len(sys.peerClients))
+	g := errgroup.WithNErrs(len(sys.peerClients))
+	for index, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		index, client := index, client
+		g.Go(func() error {
+			var err error
+			replies[index], err = client.NotificationTargetsStatus(ctx)
+			return err
+		}, index)
+	}
+
+	for index, err := range g.Wait() {
+		if err != nil {
+			reqInfo := (&logger.ReqInfo{}).AppendTags("peerAddress", sys.peerClients[index].host.String())
+			ctx := logger.SetReqInfo(ctx, reqInfo)
+			logger.LogIf(ctx, err)
+			continue
+		}
+		statuses = append(statuses, replies[index]...)
+	}
+	return statuses
+}
+
+// ReconnectNotificationTarget - connects the notification target on all
+// nodes including self where it is not connected, and returns its state.
+func (sys *NotificationSys) ReconnectNotificationTarget(ctx context.Context, targetID event.TargetID) []madmin.NotificationTargetStatus {
+	return sys.notificationTarget(ctx, func(client *peerRESTClient) (madmin.NotificationTargetStatus, error) {
+		return client.ReconnectNotificationTarget(ctx, targetID)
+	}, func() (madmin.NotificationTargetStatus, error) {
+		return localNotificationTargetStatus(targetID)
+	})
+}
+
+// SendTestNotification - sends an s3:TestEvent event to the notification
+// target from all nodes including self, and returns its state.
+func (sys *NotificationSys) SendTestNotification(ctx context.Context, targetID event.TargetID) []madmin.NotificationTargetStatus {
+	return sys.notificationTarget(ctx, func(client *peerRESTClient) (madmin.NotificationTargetStatus, error) {
+		return client.SendTestNotification(ctx, targetID)
+	}, func() (madmin.NotificationTargetStatus, error) {
+		return localSendTestNotification(targetID)
+	})
+}
+
+func (sys *NotificationSys) notificationTarget(ctx context.Context, peerFn func(*peerRESTClient) (madmin.NotificationTargetStatus, error), localFn func() (madmin.NotificationTargetStatus, error)) []madmin.NotificationTargetStatus {
+	var statuses []madmin.NotificationTargetStatus
+	if status, err := localFn(); err == nil {
+		statuses = append(statuses, status)
+	}
+
+	replies := make([]madmin.NotificationTargetStatus, len(sys.peerClients))
+	g := errgroup.WithNErrs(len(sys.peerClients))
+	for index, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		index, client := index, client
+		g.Go(func() error {
+			var err error
+			replies[index], err = peerFn(client)
+			return err
+		}, index)
+	}
+
+	for index, err := range g.Wait() {
+		if err != nil {
+			if err.Error() != errNotificationTargetNotFound.Error() {
+				reqInfo := (&logger.ReqInfo{}).AppendTags("peerAddress", sys.peerClients[index].host.String())
+				ctx := logger.SetReqInfo(ctx, reqInfo)
+				logger.LogIf(ctx, err)
+			}
+			continue
+		}
+		statuses = append(statuses, replies[index])
+	}
+	return statuses
+}
+
+// InflightRequests - gets the S3 requests being served by all nodes
+// including self, the longest running first.
+func (sys *NotificationSys) InflightRequests(ctx context.Context) []madmin.InflightRequest {
+	reqs := globalInflightRequests.List()
+
+	replies := make([][]madmin.InflightRequest, len(sys.peerClients))
+	g := errgroup.WithNErrs(len(sys.peerClients))
+	for index, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		index, client := index, client
+		g.Go(func() error {
+			var err error
+			replies[index], err = client.InflightRequests(ctx)
+			return err
+		}, index)
+	}
+
+	for index, err := range g.Wait() {
+		if err != nil {
+			reqInfo := (&logger.ReqInfo{}).AppendTags("peerAddress", sys.peerClients[index].host.String())
+			ctx := logger.SetReqInfo(ctx, reqInfo)
+			logger.LogIf(ctx, err)
+			continue
+		}
+		reqs = append(reqs, replies[index]...)
+	}
+	sortInflightRequests(reqs)
+	return reqs
+}
+
+// CancelRequest - cancels the S3 request on the node serving it,
+// returns the node or errInflightRequestNotFound.
+func (sys *NotificationSys) CancelRequest(ctx context.Context, id string) (string, error) {
+	if globalInflightRequests.Cancel(id) {
+		return globalLocalNodeName, nil
+	}
+
+	g := errgroup.WithNErrs(len(sys.peerClients))
+	for index, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		g.Go(func() error {
+			return client.CancelRequest(ctx, id)
+		}, index)
+	}
+
+	node := ""
+	for index, err := range g.Wait() {
+		if sys.peerClients[index] == nil {
+			continue
+		}
+		if err != nil {
+			if err.Error() != errInflightRequestNotFound.Error() {
+				reqInfo := (&logger.ReqInfo{}).AppendTags("peerAddress", sys.peerClients[index].host.String())
+				ctx := logger.SetReqInfo(ctx, reqInfo)
+				logger.LogIf(ctx, err)
+			}
+			continue
+		}
+		node = sys.peerClients[index].host.String()
+	}
+	if node == "" {
+		return "", errInflightRequestNotFound
+	}
+	return node, nil
+}
+
 // GetBandwidthReports - gets the bandwidth report from all nodes including self.
 func (sys *NotificationSys) GetBandwidthReports(ctx context.Context, buckets ...string) bandwidth.Report {
 	reports := make([]*bandwidth.Report, len(sys.peerClients))
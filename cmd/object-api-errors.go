@@ -650,6 +650,16 @@ func (e BackendDown) Error() string {
 	return "Backend down"
 }
 
+// PoolInMaintenance is returned for writes to objects stored on a
+// pool in maintenance mode.
+type PoolInMaintenance struct {
+	Pool int
+}
+
+func (e PoolInMaintenance) Error() string {
+	return fmt.Sprintf("Pool %d is in maintenance mode", e.Pool)
+}
+
 // isErrBucketNotFound - Check if error type is BucketNotFound.
 func isErrBucketNotFound(err error) bool {
 	var bkNotFound BucketNotFound
@@ -55,6 +55,7 @@ type ObjectOptions struct {
 	ProxyRequest                  bool                                                  // only set for GET/HEAD in active-active replication scenario
 	ProxyHeaderSet                bool                                                  // only set for GET/HEAD in active-active replication scenario
 	ParentIsObject                func(ctx context.Context, bucket, parent string) bool // Used to verify if parent is an object.
+	PrefixEnabledFn               func(prefix string) bool                              // Only set in DeleteObjects, returns true if versioning is enabled on prefix.
 
 	// Use the maximum parity (N/2), used when
 	// saving server configuration files
@@ -366,20 +366,16 @@ func getHostsSlice(records []dns.SrvRecord) []string {
 	return hosts
 }
 
-// returns an online host (and corresponding port) from a slice of DNS records
+// returns an online host (and corresponding port) from a slice of DNS records,
+// hosts are tried in the order of latency observed by the forwarder.
 func getHostFromSrv(records []dns.SrvRecord) (host string) {
-	hosts := getHostsSlice(records)
-	rng := rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+	hosts := globalForwarder.SortTargets(getHostsSlice(records))
 	var d net.Dialer
-	var retry int
-	for retry < len(hosts) {
+	for _, host = range hosts {
 		ctx, cancel := context.WithTimeout(GlobalContext, 300*time.Millisecond)
-
-		host = hosts[rng.Intn(len(hosts))]
 		conn, err := d.DialContext(ctx, "tcp", host)
 		cancel()
 		if err != nil {
-			retry++
 			continue
 		}
 		conn.Close()
@@ -0,0 +1,68 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	xhttp "github.com/minio/minio/cmd/http"
+)
+
+const (
+	// objectExpiresAtKey - internal metadata holding the time an
+	// object version expires at, when it was written with the
+	// X-Minio-Expiration-Seconds header.
+	objectExpiresAtKey = ReservedMetadataPrefixLower + "expires-at"
+
+	// Longest expiration which can be set on an object.
+	maxObjectExpiration = 100 * 365 * 24 * time.Hour
+)
+
+// setObjectExpiration - sets the expiration of the object written from
+// the X-Minio-Expiration-Seconds header of the request, if any. The
+// object is deleted by the scanner once it expires, like with the
+// lifecycle expiration rules.
+func setObjectExpiration(r *http.Request, metadata map[string]string) APIErrorCode {
+	v := r.Header.Get(xhttp.MinIOExpirationSeconds)
+	if v == "" {
+		return ErrNone
+	}
+	seconds, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || seconds <= 0 || seconds > int64(maxObjectExpiration/time.Second) {
+		return ErrInvalidObjectExpiration
+	}
+	expiresAt := UTCNow().Add(time.Duration(seconds) * time.Second)
+	metadata[objectExpiresAtKey] = expiresAt.Format(time.RFC3339)
+	return ErrNone
+}
+
+// objectExpiresAt - returns the time the object version expires at,
+// zero if it was written without an expiration.
+func objectExpiresAt(objInfo ObjectInfo) time.Time {
+	v, ok := objInfo.UserDefined[objectExpiresAtKey]
+	if !ok {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,65 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	xhttp "github.com/minio/minio/cmd/http"
+)
+
+func TestSetObjectExpiration(t *testing.T) {
+	testCases := []struct {
+		header      string
+		expectedErr APIErrorCode
+		expiration  time.Duration
+	}{
+		{header: "", expectedErr: ErrNone},
+		{header: "3600", expectedErr: ErrNone, expiration: time.Hour},
+		{header: "0", expectedErr: ErrInvalidObjectExpiration},
+		{header: "-60", expectedErr: ErrInvalidObjectExpiration},
+		{header: "1h", expectedErr: ErrInvalidObjectExpiration},
+		{header: "9223372036854775807", expectedErr: ErrInvalidObjectExpiration},
+	}
+
+	for i, tc := range testCases {
+		r := httptest.NewRequest(http.MethodPut, "/bucket/object", nil)
+		if tc.header != "" {
+			r.Header.Set(xhttp.MinIOExpirationSeconds, tc.header)
+		}
+		metadata := make(map[string]string)
+		now := UTCNow()
+		if err := setObjectExpiration(r, metadata); err != tc.expectedErr {
+			t.Fatalf("Test %d: expected error %v, got %v", i+1, tc.expectedErr, err)
+		}
+
+		expiresAt := objectExpiresAt(ObjectInfo{UserDefined: metadata})
+		if tc.expiration == 0 {
+			if !expiresAt.IsZero() {
+				t.Fatalf("Test %d: expected no expiration, got %s", i+1, expiresAt)
+			}
+			continue
+		}
+		if d := expiresAt.Sub(now); d < tc.expiration-time.Second || d > tc.expiration+time.Second {
+			t.Fatalf("Test %d: expected expiration in %s, got %s", i+1, tc.expiration, d)
+		}
+	}
+}
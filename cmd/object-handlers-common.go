@@ -264,6 +264,7 @@ func setPutObjHeaders(w http.ResponseWriter, objInfo ObjectInfo, delete bool) {
 			ruleID, expiryTime := lc.PredictExpiryTime(lifecycle.ObjectOpts{
 				Name:         objInfo.Name,
 				UserTags:     objInfo.UserTags,
+				Size:         objInfo.Size,
 				VersionID:    objInfo.VersionID,
 				ModTime:      objInfo.ModTime,
 				IsLatest:     objInfo.IsLatest,
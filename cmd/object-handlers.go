@@ -25,6 +25,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/textproto"
 	"net/url"
 	"os"
 	"sort"
@@ -55,6 +56,7 @@ import (
 	"github.com/minio/minio/pkg/hash"
 	iampolicy "github.com/minio/minio/pkg/iam/policy"
 	"github.com/minio/minio/pkg/ioutil"
+	"github.com/minio/minio/pkg/madmin"
 	xnet "github.com/minio/minio/pkg/net"
 	"github.com/minio/minio/pkg/s3select"
 	"github.com/minio/sio"
@@ -289,6 +291,8 @@ func (api objectAPIHandlers) SelectObjectContentHandler(w http.ResponseWriter, r
 
 	s3Select.Evaluate(w)
 
+	globalAccessTimeTracker.Record(objInfo)
+
 	// Notify object accessed via a GET request.
 	sendEvent(eventArgs{
 		EventName:    event.ObjectAccessedGet,
@@ -529,6 +533,8 @@ func (api objectAPIHandlers) GetObjectHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	globalAccessTimeTracker.Record(objInfo)
+
 	// Notify object accessed via a GET request.
 	sendEvent(eventArgs{
 		EventName:    event.ObjectAccessedGet,
@@ -823,6 +829,104 @@ var getRemoteInstanceClient = func(r *http.Request, host string) (*miniogo.Core,
 	return core, nil
 }
 
+// getRemoteObjectNInfo reads an object, or the requested range of it, from
+// the instance of a federated deployment holding its bucket. The object is
+// decrypted by that instance, hence the returned object info has no
+// encryption metadata.
+func getRemoteObjectNInfo(ctx context.Context, r *http.Request, bucket, object string, rs *HTTPRangeSpec, opts ObjectOptions) (*GetObjectReader, error) {
+	records, err := globalDNSConfig.Get(bucket)
+	if err != nil {
+		return nil, err
+	}
+	core, err := getRemoteInstanceClient(r, getHostFromSrv(records))
+	if err != nil {
+		return nil, err
+	}
+
+	oi, err := core.StatObject(ctx, bucket, object, miniogo.StatObjectOptions{
+		ServerSideEncryption: opts.ServerSideEncryption,
+		VersionID:            opts.VersionID,
+	})
+	if err != nil {
+		return nil, ErrorRespToObjectError(err, bucket, object)
+	}
+
+	objInfo := ObjectInfo{
+		Bucket:          bucket,
+		Name:            object,
+		ModTime:         oi.LastModified,
+		Size:            oi.Size,
+		ETag:            canonicalizeETag(oi.ETag),
+		VersionID:       oi.VersionID,
+		ContentType:     oi.ContentType,
+		ContentEncoding: oi.Metadata.Get(xhttp.ContentEncoding),
+		StorageClass:    oi.StorageClass,
+		Expires:         oi.Expires,
+		UserDefined:     make(map[string]string),
+	}
+	if err = extractMetadataFromMime(ctx, textproto.MIMEHeader(oi.Metadata), objInfo.UserDefined); err != nil {
+		return nil, err
+	}
+	if oi.UserTagCount > 0 {
+		t, err := core.GetObjectTagging(ctx, bucket, object, miniogo.GetObjectTaggingOptions{VersionID: oi.VersionID})
+		if err != nil {
+			return nil, ErrorRespToObjectError(err, bucket, object)
+		}
+		objInfo.UserTags = t.String()
+	}
+	if opts.CheckPrecondFn != nil && opts.CheckPrecondFn(objInfo) {
+		return nil, PreConditionFailed{}
+	}
+	// The preconditions were checked against the stat above.
+	opts.CheckPrecondFn = nil
+
+	// Pin the read to the version checked above.
+	getOpts := miniogo.GetObjectOptions{
+		ServerSideEncryption: opts.ServerSideEncryption,
+		VersionID:            oi.VersionID,
+	}
+	if err = getOpts.SetMatchETag(oi.ETag); err != nil {
+		return nil, err
+	}
+	if rs != nil {
+		start, length, err := rs.GetOffsetLength(objInfo.Size)
+		if err != nil {
+			return nil, err
+		}
+		if length > 0 {
+			if err = getOpts.SetRange(start, start+length-1); err != nil {
+				return nil, err
+			}
+		}
+	}
+	reader, _, _, err := core.GetObject(ctx, bucket, object, getOpts)
+	if err != nil {
+		return nil, ErrorRespToObjectError(err, bucket, object)
+	}
+	return NewGetObjectReaderFromReader(reader, objInfo, opts, func() { reader.Close() })
+}
+
+// forwardCopyRequest forwards a copy request to the instance of a federated
+// deployment holding the destination bucket, which reads the source object
+// itself instead of this instance relaying it.
+func forwardCopyRequest(w http.ResponseWriter, r *http.Request, dstBucket string) error {
+	records, err := globalDNSConfig.Get(dstBucket)
+	if err != nil {
+		return err
+	}
+	r.URL.Scheme = "http"
+	if globalIsTLS {
+		r.URL.Scheme = "https"
+	}
+	// Make sure we remove any existing headers before
+	// proxying the request to another node.
+	for k := range w.Header() {
+		w.Header().Del(k)
+	}
+	globalForwarder.ServeHTTPWithRetry(w, r, getHostsSlice(records))
+	return nil
+}
+
 // Check if the destination bucket is on a remote site, this code only gets executed
 // when federation is enabled, ie when globalDNSConfig is non 'nil'.
 //
@@ -933,6 +1037,23 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// The source bucket may be on another instance of a federated
+	// deployment, when the destination bucket is remote as well the
+	// instance holding it performs the copy.
+	srcRemote := srcBucket != dstBucket && isRemoteCallRequired(ctx, srcBucket, objectAPI)
+	if srcRemote && isRemoteCallRequired(ctx, dstBucket, objectAPI) {
+		if err = forwardCopyRequest(w, r, dstBucket); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+		}
+		return
+	}
+
+	copyBandwidth, err := getCopyBandwidth(r.Header)
+	if err != nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErrWithErr(ErrInvalidRequest, err), r.URL, guessIsBrowserReq(r))
+		return
+	}
+
 	// Check if metadata directive is valid.
 	if !isDirectiveValid(r.Header.Get(xhttp.AmzMetadataDirective)) {
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidMetadataDirective), r.URL, guessIsBrowserReq(r))
@@ -1009,6 +1130,14 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 		lock = readLock
 	}
 
+	// A remote source object is copied from its instance without the
+	// client downloading and uploading it again.
+	if srcRemote {
+		getObjectNInfo = func(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, h http.Header, lockType LockType, opts ObjectOptions) (*GetObjectReader, error) {
+			return getRemoteObjectNInfo(ctx, r, bucket, object, rs, opts)
+		}
+	}
+
 	var rs *HTTPRangeSpec
 	gr, err := getObjectNInfo(ctx, srcBucket, srcObject, rs, r.Header, lock, getOpts)
 	if err != nil {
@@ -1029,6 +1158,13 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 	defer gr.Close()
 	srcInfo := gr.ObjInfo
 
+	if srcRemote {
+		// The source object was decrypted by the remote instance.
+		r.Header.Del(xhttp.AmzServerSideEncryptionCopyCustomerAlgorithm)
+		r.Header.Del(xhttp.AmzServerSideEncryptionCopyCustomerKey)
+		r.Header.Del(xhttp.AmzServerSideEncryptionCopyCustomerKeyMD5)
+	}
+
 	// maximum Upload size for object in a single CopyObject operation.
 	if isMaxObjectSize(srcInfo.Size) {
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrEntityTooLarge), r.URL, guessIsBrowserReq(r))
@@ -1062,8 +1198,24 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
 			return
 		}
+		if err := enforceBucketObjectsQuota(ctx, dstBucket, dstObject); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+			return
+		}
 	}
 
+	// Report the progress of the copy, throttled to the requested bandwidth.
+	copyReader, copyDone := globalCopyProgress.track(ctx, gr, madmin.CopyProgress{
+		SrcBucket:      srcBucket,
+		SrcObject:      srcObject,
+		DstBucket:      dstBucket,
+		DstObject:      dstObject,
+		TotalBytes:     actualSize,
+		BandwidthLimit: copyBandwidth,
+	})
+	defer copyDone()
+	reader = copyReader
+
 	// Check if either the source is encrypted or the destination will be encrypted.
 	_, objectEncryption := crypto.IsRequested(r.Header)
 	objectEncryption = objectEncryption || crypto.IsSourceEncrypted(srcInfo.UserDefined)
@@ -1087,7 +1239,7 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 	} else {
 		delete(srcInfo.UserDefined, ReservedMetadataPrefix+"compression")
 		delete(srcInfo.UserDefined, ReservedMetadataPrefix+"actual-size")
-		reader = gr
+		reader = copyReader
 	}
 
 	srcInfo.Reader, err = hash.NewReader(reader, length, "", "", actualSize)
@@ -1339,6 +1491,24 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 		}
 		objInfo.ETag = remoteObjInfo.ETag
 		objInfo.ModTime = remoteObjInfo.LastModified
+	} else if srcRemote {
+		putObject := objectAPI.PutObject
+		if api.CacheAPI() != nil {
+			putObject = api.CacheAPI().PutObject
+		}
+
+		opts := ObjectOptions{
+			ServerSideEncryption: dstOpts.ServerSideEncryption,
+			UserDefined:          srcInfo.UserDefined,
+			Versioned:            dstOpts.Versioned,
+			VersionID:            dstOpts.VersionID,
+			MTime:                dstOpts.MTime,
+		}
+		objInfo, err = putObject(ctx, dstBucket, dstObject, srcInfo.PutObjReader, opts)
+		if err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+			return
+		}
 	} else {
 		copyObjectFn := objectAPI.CopyObject
 		if api.CacheAPI() != nil {
@@ -1451,7 +1621,7 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 	/// if Content-Length is unknown/missing, deny the request
 	size := r.ContentLength
 	rAuthType := getRequestAuthType(r)
-	if rAuthType == authTypeStreamingSigned {
+	if rAuthType == authTypeStreamingSigned || rAuthType == authTypeStreamingUnsignedTrailer {
 		if sizeStr, ok := r.Header[xhttp.AmzDecodedContentLength]; ok {
 			if sizeStr[0] == "" {
 				writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrMissingContentLength), r.URL, guessIsBrowserReq(r))
@@ -1495,6 +1665,11 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		metadata[xhttp.AmzObjectTagging] = objTags
 	}
 
+	if s3Err := setObjectExpiration(r, metadata); s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL, guessIsBrowserReq(r))
+		return
+	}
+
 	var (
 		md5hex              = clientETag.String()
 		sha256hex           = ""
@@ -1512,7 +1687,14 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 	switch rAuthType {
 	case authTypeStreamingSigned:
 		// Initialize stream signature verifier.
-		reader, s3Err = newSignV4ChunkedReader(r)
+		reader, s3Err = newSignV4ChunkedReader(r, metadata)
+		if s3Err != ErrNone {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL, guessIsBrowserReq(r))
+			return
+		}
+	case authTypeStreamingUnsignedTrailer:
+		// Initialize unsigned chunked reader with trailing headers.
+		reader, s3Err = newUnsignedV4ChunkedReader(r, metadata)
 		if s3Err != ErrNone {
 			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL, guessIsBrowserReq(r))
 			return
@@ -1538,6 +1720,10 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
 		return
 	}
+	if err := enforceBucketObjectsQuota(ctx, bucket, object); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+		return
+	}
 
 	// Check if bucket encryption is enabled
 	_, err = globalBucketSSEConfigSys.Get(bucket)
@@ -1684,6 +1870,7 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		scheduleReplication(ctx, objInfo.Clone(), objectAPI, sync, replication.ObjectReplicationType)
 	}
 	setPutObjHeaders(w, objInfo, false)
+	setChecksumHeaders(w, metadata)
 
 	writeSuccessResponseHeadersOnly(w)
 
@@ -1764,7 +1951,7 @@ func (api objectAPIHandlers) PutObjectExtractHandler(w http.ResponseWriter, r *h
 	/// if Content-Length is unknown/missing, deny the request
 	size := r.ContentLength
 	rAuthType := getRequestAuthType(r)
-	if rAuthType == authTypeStreamingSigned {
+	if rAuthType == authTypeStreamingSigned || rAuthType == authTypeStreamingUnsignedTrailer {
 		if sizeStr, ok := r.Header[xhttp.AmzDecodedContentLength]; ok {
 			if sizeStr[0] == "" {
 				writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrMissingContentLength), r.URL, guessIsBrowserReq(r))
@@ -1806,7 +1993,14 @@ func (api objectAPIHandlers) PutObjectExtractHandler(w http.ResponseWriter, r *h
 	switch rAuthType {
 	case authTypeStreamingSigned:
 		// Initialize stream signature verifier.
-		reader, s3Err = newSignV4ChunkedReader(r)
+		reader, s3Err = newSignV4ChunkedReader(r, nil)
+		if s3Err != ErrNone {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL, guessIsBrowserReq(r))
+			return
+		}
+	case authTypeStreamingUnsignedTrailer:
+		// Initialize unsigned chunked reader with trailing headers.
+		reader, s3Err = newUnsignedV4ChunkedReader(r, nil)
 		if s3Err != ErrNone {
 			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL, guessIsBrowserReq(r))
 			return
@@ -1859,6 +2053,11 @@ func (api objectAPIHandlers) PutObjectExtractHandler(w http.ResponseWriter, r *h
 	}
 
 	putObjectTar := func(reader io.Reader, info os.FileInfo, object string) {
+		if err := enforceBucketObjectsQuota(ctx, bucket, object); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+			return
+		}
+
 		size := info.Size()
 		metadata := map[string]string{
 			xhttp.AmzStorageClass: sc,
@@ -2195,6 +2394,23 @@ func (api objectAPIHandlers) CopyObjectPartHandler(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// The source bucket may be on another instance of a federated
+	// deployment, when the destination bucket is remote as well the
+	// instance holding it performs the copy.
+	srcRemote := srcBucket != dstBucket && isRemoteCallRequired(ctx, srcBucket, objectAPI)
+	if srcRemote && isRemoteCallRequired(ctx, dstBucket, objectAPI) {
+		if err = forwardCopyRequest(w, r, dstBucket); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+		}
+		return
+	}
+
+	copyBandwidth, err := getCopyBandwidth(r.Header)
+	if err != nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErrWithErr(ErrInvalidRequest, err), r.URL, guessIsBrowserReq(r))
+		return
+	}
+
 	uploadID := r.URL.Query().Get(xhttp.UploadID)
 	partIDString := r.URL.Query().Get(xhttp.PartNumber)
 
@@ -2234,6 +2450,11 @@ func (api objectAPIHandlers) CopyObjectPartHandler(w http.ResponseWriter, r *htt
 	if api.CacheAPI() != nil {
 		getObjectNInfo = api.CacheAPI().GetObjectNInfo
 	}
+	if srcRemote {
+		getObjectNInfo = func(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, h http.Header, lockType LockType, opts ObjectOptions) (*GetObjectReader, error) {
+			return getRemoteObjectNInfo(ctx, r, bucket, object, rs, opts)
+		}
+	}
 
 	// Get request range.
 	var rs *HTTPRangeSpec
@@ -2281,6 +2502,13 @@ func (api objectAPIHandlers) CopyObjectPartHandler(w http.ResponseWriter, r *htt
 	defer gr.Close()
 	srcInfo := gr.ObjInfo
 
+	if srcRemote {
+		// The source object was decrypted by the remote instance.
+		r.Header.Del(xhttp.AmzServerSideEncryptionCopyCustomerAlgorithm)
+		r.Header.Del(xhttp.AmzServerSideEncryptionCopyCustomerKey)
+		r.Header.Del(xhttp.AmzServerSideEncryptionCopyCustomerKeyMD5)
+	}
+
 	actualPartSize := srcInfo.Size
 	if _, ok := crypto.IsEncrypted(srcInfo.UserDefined); ok {
 		actualPartSize, err = srcInfo.GetActualSize()
@@ -2344,7 +2572,20 @@ func (api objectAPIHandlers) CopyObjectPartHandler(w http.ResponseWriter, r *htt
 	}
 
 	actualPartSize = length
-	var reader io.Reader = etag.NewReader(gr, nil)
+
+	// Report the progress of the copy, throttled to the requested bandwidth.
+	copyReader, copyDone := globalCopyProgress.track(ctx, gr, madmin.CopyProgress{
+		SrcBucket:      srcBucket,
+		SrcObject:      srcObject,
+		DstBucket:      dstBucket,
+		DstObject:      dstObject,
+		UploadID:       uploadID,
+		PartNumber:     partID,
+		TotalBytes:     length,
+		BandwidthLimit: copyBandwidth,
+	})
+	defer copyDone()
+	var reader io.Reader = etag.NewReader(copyReader, nil)
 
 	mi, err := objectAPI.GetMultipartInfo(ctx, dstBucket, dstObject, uploadID, dstOpts)
 	if err != nil {
@@ -2430,10 +2671,16 @@ func (api objectAPIHandlers) CopyObjectPartHandler(w http.ResponseWriter, r *htt
 	}
 
 	srcInfo.PutObjReader = pReader
-	// Copy source object to destination, if source and destination
-	// object is same then only metadata is updated.
-	partInfo, err := objectAPI.CopyObjectPart(ctx, srcBucket, srcObject, dstBucket, dstObject, uploadID, partID,
-		startOffset, length, srcInfo, srcOpts, dstOpts)
+	var partInfo PartInfo
+	if srcRemote {
+		// The source bucket is not known to the local object layer.
+		partInfo, err = objectAPI.PutObjectPart(ctx, dstBucket, dstObject, uploadID, partID, srcInfo.PutObjReader, dstOpts)
+	} else {
+		// Copy source object to destination, if source and destination
+		// object is same then only metadata is updated.
+		partInfo, err = objectAPI.CopyObjectPart(ctx, srcBucket, srcObject, dstBucket, dstObject, uploadID, partID,
+			startOffset, length, srcInfo, srcOpts, dstOpts)
+	}
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
 		return
@@ -2506,7 +2753,7 @@ func (api objectAPIHandlers) PutObjectPartHandler(w http.ResponseWriter, r *http
 
 	rAuthType := getRequestAuthType(r)
 	// For auth type streaming signature, we need to gather a different content length.
-	if rAuthType == authTypeStreamingSigned {
+	if rAuthType == authTypeStreamingSigned || rAuthType == authTypeStreamingUnsignedTrailer {
 		if sizeStr, ok := r.Header[xhttp.AmzDecodedContentLength]; ok {
 			if sizeStr[0] == "" {
 				writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrMissingContentLength), r.URL, guessIsBrowserReq(r))
@@ -2550,6 +2797,7 @@ func (api objectAPIHandlers) PutObjectPartHandler(w http.ResponseWriter, r *http
 		sha256hex           = ""
 		reader    io.Reader = r.Body
 		s3Error   APIErrorCode
+		checksums = make(map[string]string)
 	)
 	if s3Error = isPutActionAllowed(ctx, rAuthType, bucket, object, r, iampolicy.PutObjectAction); s3Error != ErrNone {
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL, guessIsBrowserReq(r))
@@ -2559,7 +2807,14 @@ func (api objectAPIHandlers) PutObjectPartHandler(w http.ResponseWriter, r *http
 	switch rAuthType {
 	case authTypeStreamingSigned:
 		// Initialize stream signature verifier.
-		reader, s3Error = newSignV4ChunkedReader(r)
+		reader, s3Error = newSignV4ChunkedReader(r, checksums)
+		if s3Error != ErrNone {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL, guessIsBrowserReq(r))
+			return
+		}
+	case authTypeStreamingUnsignedTrailer:
+		// Initialize unsigned chunked reader with trailing headers.
+		reader, s3Error = newUnsignedV4ChunkedReader(r, checksums)
 		if s3Error != ErrNone {
 			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL, guessIsBrowserReq(r))
 			return
@@ -2721,6 +2976,7 @@ func (api objectAPIHandlers) PutObjectPartHandler(w http.ResponseWriter, r *http
 	// clients expect the ETag header key to be literally "ETag" - not "Etag" (case-sensitive).
 	// Therefore, we have to set the ETag directly as map entry.
 	w.Header()[xhttp.ETag] = []string{"\"" + etag + "\""}
+	setChecksumHeaders(w, checksums)
 
 	writeSuccessResponseHeadersOnly(w)
 }
@@ -2968,6 +3224,11 @@ func (api objectAPIHandlers) CompleteMultipartUploadHandler(w http.ResponseWrite
 		return
 	}
 
+	if err = enforceBucketObjectsQuota(ctx, bucket, object); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+		return
+	}
+
 	var objectEncryptionKey []byte
 	var isEncrypted, ssec bool
 	if objectAPI.IsEncryptionSupported() {
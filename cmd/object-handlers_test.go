@@ -22,11 +22,14 @@ import (
 	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io"
+	"net"
 	"runtime"
 	"strings"
+	"time"
 
 	"io/ioutil"
 	"net/http"
@@ -37,6 +40,9 @@ import (
 	"testing"
 
 	humanize "github.com/dustin/go-humanize"
+	miniogo "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio/cmd/config/dns"
 	xhttp "github.com/minio/minio/cmd/http"
 	"github.com/minio/minio/pkg/auth"
 	ioutilx "github.com/minio/minio/pkg/ioutil"
@@ -1987,6 +1993,195 @@ func testAPICopyObjectPartHandler(obj ObjectLayer, instanceType, bucketName stri
 
 }
 
+// testRemoteBucketDNS resolves buckets to the instances of a federated
+// deployment holding them.
+type testRemoteBucketDNS map[string][]dns.SrvRecord
+
+func (s testRemoteBucketDNS) Put(bucket string) error { return nil }
+
+func (s testRemoteBucketDNS) Get(bucket string) ([]dns.SrvRecord, error) {
+	records, ok := s[bucket]
+	if !ok {
+		return nil, dns.ErrNoEntriesFound
+	}
+	return records, nil
+}
+
+func (s testRemoteBucketDNS) Delete(bucket string) error { return nil }
+
+func (s testRemoteBucketDNS) List() (map[string][]dns.SrvRecord, error) { return s, nil }
+
+func (s testRemoteBucketDNS) DeleteRecord(record dns.SrvRecord) error { return nil }
+
+func (s testRemoteBucketDNS) Close() error { return nil }
+
+func (s testRemoteBucketDNS) String() string { return "test" }
+
+// Wrapper for calling Copy Object and Copy Object Part API handlers tests
+// with a source bucket on another instance of a federated deployment.
+func TestAPICopyObjectRemoteSource(t *testing.T) {
+	defer DetectTestLeak(t)()
+	ExecObjectLayerAPITest(t, testAPICopyObjectRemoteSource, []string{"CopyObjectPart", "CopyObject"})
+}
+
+func testAPICopyObjectRemoteSource(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	cred auth.Credentials, t *testing.T) {
+
+	srcBucket, srcObject := "remote-bucket", "remote-object"
+	dstRemoteBucket := "remote-destination-bucket"
+	srcData := generateBytesData(6 * humanize.KiByte)
+	srcETag := getMD5Hash(srcData)
+	srcModTime := UTCNow().Truncate(time.Second)
+
+	// The remote instance serves the source object and accepts
+	// copies into its destination bucket.
+	var mu sync.Mutex
+	var srcReads, dstCopies int
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == SlashSeparator+srcBucket+SlashSeparator+srcObject:
+			mu.Lock()
+			srcReads++
+			mu.Unlock()
+			w.Header().Set(xhttp.ETag, "\""+srcETag+"\"")
+			w.Header().Set(xhttp.ContentType, "application/octet-stream")
+			http.ServeContent(w, r, srcObject, srcModTime, bytes.NewReader(srcData))
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, SlashSeparator+dstRemoteBucket+SlashSeparator):
+			mu.Lock()
+			dstCopies++
+			mu.Unlock()
+			writeSuccessResponseXML(w, encodeResponse(generateCopyObjectResponse(srcETag, srcModTime)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer remote.Close()
+
+	u, err := url.Parse(remote.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, port, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := []dns.SrvRecord{{Host: host, Port: json.Number(port)}}
+
+	defer func(dnsConfig dns.Store, federation bool, remoteClient func(*http.Request, string) (*miniogo.Core, error)) {
+		globalDNSConfig = dnsConfig
+		globalBucketFederation = federation
+		getRemoteInstanceClient = remoteClient
+	}(globalDNSConfig, globalBucketFederation, getRemoteInstanceClient)
+
+	globalDNSConfig = testRemoteBucketDNS{
+		srcBucket:       records,
+		dstRemoteBucket: records,
+	}
+	globalBucketFederation = true
+	getRemoteInstanceClient = func(r *http.Request, host string) (*miniogo.Core, error) {
+		return miniogo.NewCore(host, &miniogo.Options{
+			Creds:  credentials.NewStaticV4(cred.AccessKey, cred.SecretKey, ""),
+			Region: "us-east-1",
+		})
+	}
+
+	uploadID, err := obj.NewMultipartUpload(context.Background(), bucketName, "part-object", ObjectOptions{})
+	if err != nil {
+		t.Fatalf("MinIO %s : <ERROR>  %s", instanceType, err)
+	}
+
+	copySource := url.QueryEscape(SlashSeparator + srcBucket + SlashSeparator + srcObject)
+	testCases := []struct {
+		url                string
+		copySourceRange    string
+		copySourceIfMatch  string
+		expectedRespStatus int
+		expectedSize       int64
+		expectedSrcReads   int
+		expectedDstCopies  int
+	}{
+		// Test case - 1, copy the remote object into a local bucket.
+		{
+			url:                getCopyObjectURL("", bucketName, "copy-object"),
+			expectedRespStatus: http.StatusOK,
+			expectedSize:       int64(len(srcData)),
+			expectedSrcReads:   2,
+		},
+		// Test case - 2, copy a range of the remote object as a part.
+		{
+			url:                getCopyObjectPartURL("", bucketName, "part-object", uploadID, "1"),
+			copySourceRange:    "bytes=1024-2047",
+			expectedRespStatus: http.StatusOK,
+			expectedSize:       humanize.KiByte,
+			expectedSrcReads:   2,
+		},
+		// Test case - 3, copy a part of a remote object not matching the precondition.
+		{
+			url:                getCopyObjectPartURL("", bucketName, "part-object", uploadID, "2"),
+			copySourceIfMatch:  "\"not-the-etag\"",
+			expectedRespStatus: http.StatusPreconditionFailed,
+			expectedSrcReads:   1,
+		},
+		// Test case - 4, copy the remote object into another remote bucket,
+		// the request is forwarded without reading the source.
+		{
+			url:                getCopyObjectURL("", dstRemoteBucket, "copy-object"),
+			expectedRespStatus: http.StatusOK,
+			expectedDstCopies:  1,
+		},
+	}
+
+	for i, testCase := range testCases {
+		mu.Lock()
+		srcReads, dstCopies = 0, 0
+		mu.Unlock()
+
+		rec := httptest.NewRecorder()
+		req, err := newTestSignedRequestV4(http.MethodPut, testCase.url, 0, nil, cred.AccessKey, cred.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Test %d: Failed to create HTTP request for copy Object: <ERROR> %v", i+1, err)
+		}
+		req.Header.Set(xhttp.AmzCopySource, copySource)
+		if testCase.copySourceRange != "" {
+			req.Header.Set(xhttp.AmzCopySourceRange, testCase.copySourceRange)
+		}
+		if testCase.copySourceIfMatch != "" {
+			req.Header.Set(xhttp.AmzCopySourceIfMatch, testCase.copySourceIfMatch)
+		}
+
+		apiRouter.ServeHTTP(rec, req)
+		if rec.Code != testCase.expectedRespStatus {
+			t.Fatalf("Test %d: %s: Expected the response status to be `%d`, but instead found `%d`: %s", i+1, instanceType, testCase.expectedRespStatus, rec.Code, rec.Body)
+		}
+
+		mu.Lock()
+		gotReads, gotCopies := srcReads, dstCopies
+		mu.Unlock()
+		if gotReads != testCase.expectedSrcReads {
+			t.Errorf("Test %d: %s: Expected %d reads of the remote source, found %d", i+1, instanceType, testCase.expectedSrcReads, gotReads)
+		}
+		if gotCopies != testCase.expectedDstCopies {
+			t.Errorf("Test %d: %s: Expected %d forwarded copies, found %d", i+1, instanceType, testCase.expectedDstCopies, gotCopies)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = GetObject(context.Background(), obj, bucketName, "copy-object", 0, int64(len(srcData)), &buf, "", ObjectOptions{}); err != nil {
+		t.Fatalf("%s: Failed to read the copied object: <ERROR> %s", instanceType, err)
+	}
+	if !bytes.Equal(buf.Bytes(), srcData) {
+		t.Errorf("%s: Copied object does not match the remote source", instanceType)
+	}
+
+	parts, err := obj.ListObjectParts(context.Background(), bucketName, "part-object", uploadID, 0, 10, ObjectOptions{})
+	if err != nil {
+		t.Fatalf("%s: Failed to look for copied object part: <ERROR> %s", instanceType, err)
+	}
+	if len(parts.Parts) != 1 || parts.Parts[0].ETag != getMD5Hash(srcData[humanize.KiByte:2*humanize.KiByte]) {
+		t.Errorf("%s: Expected a single part holding the copied range, found %v", instanceType, parts.Parts)
+	}
+}
+
 // Wrapper for calling Copy Object API handler tests for both Erasure multiple disks and single node setup.
 func TestAPICopyObjectHandler(t *testing.T) {
 	defer DetectTestLeak(t)()
@@ -927,6 +927,139 @@ func (client *peerRESTClient) MonitorBandwidth(ctx context.Context, buckets []st
 	return &bandwidthReport, err
 }
 
+// GetCopyProgress - fetch the server-side copies in progress on the peer.
+func (client *peerRESTClient) GetCopyProgress(ctx context.Context) ([]madmin.CopyProgress, error) {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodGetCopyProgress, nil, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer http.DrainBody(respBody)
+
+	var copies []madmin.CopyProgress
+	err = gob.NewDecoder(respBody).Decode(&copies)
+	return copies, err
+}
+
+// SetLogLevel - sets the log level of a subsystem on the peer,
+// of all subsystems if subsys is empty.
+func (client *peerRESTClient) SetLogLevel(ctx context.Context, subsys string, level madmin.LogLevel) (madmin.NodeLogLevels, error) {
+	values := make(url.Values)
+	values.Set(peerRESTLogSubsys, subsys)
+	values.Set(peerRESTLogLevel, string(level))
+	return client.logLevels(ctx, peerRESTMethodSetLogLevel, values)
+}
+
+// GetLogLevels - fetch the log level of every subsystem on the peer.
+func (client *peerRESTClient) GetLogLevels(ctx context.Context) (madmin.NodeLogLevels, error) {
+	return client.logLevels(ctx, peerRESTMethodGetLogLevels, nil)
+}
+
+func (client *peerRESTClient) logLevels(ctx context.Context, method string, values url.Values) (madmin.NodeLogLevels, error) {
+	var levels madmin.NodeLogLevels
+	respBody, err := client.callWithContext(ctx, method, values, nil, -1)
+	if err != nil {
+		return levels, err
+	}
+	defer http.DrainBody(respBody)
+
+	err = gob.NewDecoder(respBody).Decode(&levels)
+	return levels, err
+}
+
+// NotificationTargetsStatus - fetch the state of the notification targets on the peer.
+func (client *peerRESTClient) NotificationTargetsStatus(ctx context.Context) ([]madmin.NotificationTargetStatus, error) {
+	var statuses []madmin.NotificationTargetStatus
+	respBody, err := client.callWithContext(ctx, peerRESTMethodNotificationTargets, nil, nil, -1)
+	if err != nil {
+		return statuses, err
+	}
+	defer http.DrainBody(respBody)
+
+	err = gob.NewDecoder(respBody).Decode(&statuses)
+	return statuses, err
+}
+
+// ReconnectNotificationTarget - connects the notification target on the
+// peer if it is not connected and returns its state.
+func (client *peerRESTClient) ReconnectNotificationTarget(ctx context.Context, targetID event.TargetID) (madmin.NotificationTargetStatus, error) {
+	return client.notificationTarget(ctx, peerRESTMethodReconnectNotifyTarget, targetID)
+}
+
+// SendTestNotification - sends an s3:TestEvent event to the notification
+// target from the peer and returns its state.
+func (client *peerRESTClient) SendTestNotification(ctx context.Context, targetID event.TargetID) (madmin.NotificationTargetStatus, error) {
+	return client.notificationTarget(ctx, peerRESTMethodSendTestNotification, targetID)
+}
+
+func (client *peerRESTClient) notificationTarget(ctx context.Context, method string, targetID event.TargetID) (madmin.NotificationTargetStatus, error) {
+	var status madmin.NotificationTargetStatus
+	values := make(url.Values)
+	values.Set(peerRESTNotificationTarget, targetID.String())
+	respBody, err := client.callWithContext(ctx, method, values, nil, -1)
+	if err != nil {
+		return status, err
+	}
+	defer http.DrainBody(respBody)
+
+	err = gob.NewDecoder(respBody).Decode(&status)
+	return status, err
+}
+
+// LoadMaintenance - reloads the maintenance state on the peer.
+func (client *peerRESTClient) LoadMaintenance(ctx context.Context) error {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodLoadMaintenance, nil, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
+// LoadBreakGlass - reloads the break-glass state on the peer.
+func (client *peerRESTClient) LoadBreakGlass(ctx context.Context) error {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodLoadBreakGlass, nil, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
+// FlushEventLog - saves the pending events of the peer.
+func (client *peerRESTClient) FlushEventLog(ctx context.Context) error {
+	respBody, err := client.callWithContext(ctx, peerRESTMethodFlushEventLog, nil, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
+// InflightRequests - fetch the S3 requests being served by the peer.
+func (client *peerRESTClient) InflightRequests(ctx context.Context) ([]madmin.InflightRequest, error) {
+	var reqs []madmin.InflightRequest
+	respBody, err := client.callWithContext(ctx, peerRESTMethodInflightRequests, nil, nil, -1)
+	if err != nil {
+		return reqs, err
+	}
+	defer http.DrainBody(respBody)
+
+	err = gob.NewDecoder(respBody).Decode(&reqs)
+	return reqs, err
+}
+
+// CancelRequest - cancels the S3 request served by the peer.
+func (client *peerRESTClient) CancelRequest(ctx context.Context, id string) error {
+	values := make(url.Values)
+	values.Set(peerRESTRequestID, id)
+	respBody, err := client.callWithContext(ctx, peerRESTMethodCancelRequest, values, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
 func (client *peerRESTClient) GetPeerMetrics(ctx context.Context) (<-chan Metric, error) {
 	respBody, err := client.callWithContext(ctx, peerRESTMethodGetPeerMetrics, nil, nil, -1)
 	if err != nil {
@@ -60,6 +60,17 @@ const (
 	peerRESTMethodGetMetacacheListing    = "/getmetacache"
 	peerRESTMethodUpdateMetacacheListing = "/updatemetacache"
 	peerRESTMethodGetPeerMetrics         = "/peermetrics"
+	peerRESTMethodGetCopyProgress        = "/copyprogress"
+	peerRESTMethodSetLogLevel            = "/setloglevel"
+	peerRESTMethodGetLogLevels           = "/getloglevels"
+	peerRESTMethodNotificationTargets    = "/notificationtargets"
+	peerRESTMethodReconnectNotifyTarget  = "/reconnectnotifytarget"
+	peerRESTMethodSendTestNotification   = "/sendtestnotification"
+	peerRESTMethodLoadMaintenance        = "/loadmaintenance"
+	peerRESTMethodInflightRequests       = "/inflightrequests"
+	peerRESTMethodCancelRequest          = "/cancelrequest"
+	peerRESTMethodLoadBreakGlass         = "/loadbreakglass"
+	peerRESTMethodFlushEventLog          = "/flusheventlog"
 )
 
 const (
@@ -84,4 +95,11 @@ const (
 	peerRESTListenPrefix = "prefix"
 	peerRESTListenSuffix = "suffix"
 	peerRESTListenEvents = "events"
+
+	peerRESTLogSubsys = "subsys"
+	peerRESTLogLevel  = "level"
+
+	peerRESTNotificationTarget = "target"
+
+	peerRESTRequestID = "id"
 )
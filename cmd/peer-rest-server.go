@@ -832,53 +832,12 @@ func (s *peerRESTServer) ListenHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	values := r.URL.Query()
-
-	var prefix string
-	if len(values[peerRESTListenPrefix]) > 1 {
-		s.writeErrorResponse(w, errors.New("invalid request"))
-		return
-	}
-
-	if len(values[peerRESTListenPrefix]) == 1 {
-		if err := event.ValidateFilterRuleValue(values[peerRESTListenPrefix][0]); err != nil {
-			s.writeErrorResponse(w, err)
-			return
-		}
-
-		prefix = values[peerRESTListenPrefix][0]
-	}
-
-	var suffix string
-	if len(values[peerRESTListenSuffix]) > 1 {
-		s.writeErrorResponse(w, errors.New("invalid request"))
+	filter, err := parseListenFilter(r.URL.Query())
+	if err != nil {
+		s.writeErrorResponse(w, err)
 		return
 	}
 
-	if len(values[peerRESTListenSuffix]) == 1 {
-		if err := event.ValidateFilterRuleValue(values[peerRESTListenSuffix][0]); err != nil {
-			s.writeErrorResponse(w, err)
-			return
-		}
-
-		suffix = values[peerRESTListenSuffix][0]
-	}
-
-	pattern := event.NewPattern(prefix, suffix)
-
-	var eventNames []event.Name
-	for _, ev := range values[peerRESTListenEvents] {
-		eventName, err := event.ParseName(ev)
-		if err != nil {
-			s.writeErrorResponse(w, err)
-			return
-		}
-
-		eventNames = append(eventNames, eventName)
-	}
-
-	rulesMap := event.NewRulesMap(eventNames, pattern, event.TargetID{ID: mustGetUUID()})
-
 	w.WriteHeader(http.StatusOK)
 	w.(http.Flusher).Flush()
 
@@ -894,12 +853,7 @@ func (s *peerRESTServer) ListenHandler(w http.ResponseWriter, r *http.Request) {
 		if !ok {
 			return false
 		}
-		if ev.S3.Bucket.Name != "" && values.Get(peerRESTListenBucket) != "" {
-			if ev.S3.Bucket.Name != values.Get(peerRESTListenBucket) {
-				return false
-			}
-		}
-		return rulesMap.MatchSimple(ev.EventName, ev.S3.Object.Key)
+		return filter.match(ev)
 	})
 
 	keepAliveTicker := time.NewTicker(500 * time.Millisecond)
@@ -1074,6 +1028,171 @@ func (s *peerRESTServer) GetBandwidth(w http.ResponseWriter, r *http.Request) {
 	w.(http.Flusher).Flush()
 }
 
+// GetCopyProgress returns the server-side copies in progress on this server.
+func (s *peerRESTServer) GetCopyProgress(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetCopyProgress")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalCopyProgress.list()))
+}
+
+// SetLogLevelHandler sets the log level of a subsystem on this server.
+func (s *peerRESTServer) SetLogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	vars := mux.Vars(r)
+	if err := globalDebugLog.setLevel(vars[peerRESTLogSubsys], madmin.LogLevel(vars[peerRESTLogLevel])); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	ctx := newContext(r, w, "SetLogLevel")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalDebugLog.getLevels()))
+}
+
+// GetLogLevelsHandler returns the log level of every subsystem on this server.
+func (s *peerRESTServer) GetLogLevelsHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "GetLogLevels")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalDebugLog.getLevels()))
+}
+
+// NotificationTargetsHandler returns the state of the notification targets on this server.
+func (s *peerRESTServer) NotificationTargetsHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "NotificationTargets")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(localNotificationTargetsStatus()))
+}
+
+// ReconnectNotifyTargetHandler connects the notification target on this
+// server if it is not connected and returns its state.
+func (s *peerRESTServer) ReconnectNotifyTargetHandler(w http.ResponseWriter, r *http.Request) {
+	s.notificationTargetHandler(w, r, "ReconnectNotifyTarget", localNotificationTargetStatus)
+}
+
+// SendTestNotificationHandler sends an s3:TestEvent event to the
+// notification target from this server and returns its state.
+func (s *peerRESTServer) SendTestNotificationHandler(w http.ResponseWriter, r *http.Request) {
+	s.notificationTargetHandler(w, r, "SendTestNotification", localSendTestNotification)
+}
+
+func (s *peerRESTServer) notificationTargetHandler(w http.ResponseWriter, r *http.Request, api string, fn func(event.TargetID) (madmin.NotificationTargetStatus, error)) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	targetID, err := event.ParseTargetID(mux.Vars(r)[peerRESTNotificationTarget])
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	status, err := fn(*targetID)
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	ctx := newContext(r, w, api)
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(status))
+}
+
+// LoadMaintenanceHandler - reloads the maintenance state of nodes and pools.
+func (s *peerRESTServer) LoadMaintenanceHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	objAPI := newObjectLayerFn()
+	if objAPI == nil || globalMaintenanceSys == nil {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	if err := globalMaintenanceSys.Load(r.Context(), objAPI); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+}
+
+// LoadBreakGlassHandler - reloads the break-glass state.
+func (s *peerRESTServer) LoadBreakGlassHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	objAPI := newObjectLayerFn()
+	if objAPI == nil || globalBreakGlassSys == nil {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	if err := globalBreakGlassSys.Load(r.Context(), objAPI); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+}
+
+// FlushEventLogHandler - saves the pending events.
+func (s *peerRESTServer) FlushEventLogHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	if err := globalEventLog.flush(r.Context(), objAPI); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+}
+
+// InflightRequestsHandler - returns the S3 requests being served.
+func (s *peerRESTServer) InflightRequestsHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "InflightRequests")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalInflightRequests.List()))
+}
+
+// CancelRequestHandler - cancels an S3 request being served.
+func (s *peerRESTServer) CancelRequestHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	if !globalInflightRequests.Cancel(mux.Vars(r)[peerRESTRequestID]) {
+		s.writeErrorResponse(w, errInflightRequestNotFound)
+		return
+	}
+}
+
 // GetPeerMetrics gets the metrics to be federated across peers.
 func (s *peerRESTServer) GetPeerMetrics(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
@@ -1135,6 +1254,17 @@ func registerPeerRESTHandlers(router *mux.Router) {
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLog).HandlerFunc(server.ConsoleLogHandler)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetLocalDiskIDs).HandlerFunc(httpTraceHdrs(server.GetLocalDiskIDs))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetBandwidth).HandlerFunc(httpTraceHdrs(server.GetBandwidth))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetCopyProgress).HandlerFunc(httpTraceHdrs(server.GetCopyProgress))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodSetLogLevel).HandlerFunc(httpTraceHdrs(server.SetLogLevelHandler)).Queries(restQueries(peerRESTLogSubsys, peerRESTLogLevel)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetLogLevels).HandlerFunc(httpTraceHdrs(server.GetLogLevelsHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodNotificationTargets).HandlerFunc(httpTraceHdrs(server.NotificationTargetsHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodReconnectNotifyTarget).HandlerFunc(httpTraceHdrs(server.ReconnectNotifyTargetHandler)).Queries(restQueries(peerRESTNotificationTarget)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodSendTestNotification).HandlerFunc(httpTraceHdrs(server.SendTestNotificationHandler)).Queries(restQueries(peerRESTNotificationTarget)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLoadMaintenance).HandlerFunc(httpTraceHdrs(server.LoadMaintenanceHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodInflightRequests).HandlerFunc(httpTraceHdrs(server.InflightRequestsHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodCancelRequest).HandlerFunc(httpTraceHdrs(server.CancelRequestHandler)).Queries(restQueries(peerRESTRequestID)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLoadBreakGlass).HandlerFunc(httpTraceHdrs(server.LoadBreakGlassHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodFlushEventLog).HandlerFunc(httpTraceHdrs(server.FlushEventLogHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetMetacacheListing).HandlerFunc(httpTraceHdrs(server.GetMetacacheListingHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodUpdateMetacacheListing).HandlerFunc(httpTraceHdrs(server.UpdateMetacacheListingHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetPeerMetrics).HandlerFunc(httpTraceHdrs(server.GetPeerMetrics))
@@ -76,6 +76,8 @@ var globalHandlers = []mux.MiddlewareFunc{
 	// requests when object layer is not
 	// initialized.
 	setRedirectHandler,
+	// Reject writes sent to a server in maintenance mode.
+	setMaintenanceHandler,
 	// Add new handlers here.
 }
 
@@ -90,8 +92,9 @@ func configureServerHandler(endpointServerPools EndpointServerPools) (http.Handl
 		registerDistErasureRouters(router, endpointServerPools)
 	}
 
-	// Register web router when its enabled.
-	if globalBrowserEnabled {
+	// Register web router when its enabled, unless it is
+	// served on its own address.
+	if globalBrowserEnabled && globalBrowserAddr == "" {
 		if err := registerWebRouter(router); err != nil {
 			return nil, err
 		}
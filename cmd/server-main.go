@@ -242,6 +242,18 @@ func newAllSubsystems() {
 
 	// Create new bucket replication subsytem
 	globalBucketTargetSys = NewBucketTargetSys()
+
+	// Create new batch jobs subsystem
+	globalBatchJobsSys = NewBatchJobsSys()
+
+	// Create new tenant subsystem
+	globalTenantSys = NewTenantSys()
+
+	// Create new maintenance subsystem
+	globalMaintenanceSys = NewMaintenanceSys()
+
+	// Create new break-glass subsystem
+	globalBreakGlassSys = NewBreakGlassSys(globalBreakGlassCred)
 }
 
 func configRetriableErrors(err error) bool {
@@ -488,6 +500,7 @@ func serverMain(ctx *cli.Context) {
 	}()
 
 	setHTTPServer(httpServer)
+	startBrowserServer()
 
 	if globalIsDistErasure && globalEndpoints.FirstLocal() {
 		for {
@@ -538,6 +551,16 @@ func serverMain(ctx *cli.Context) {
 	if globalIsErasure { // to be done after config init
 		initBackgroundReplication(GlobalContext, newObject)
 	}
+
+	initBatchJobs(GlobalContext, newObject)
+	initMaintenance(GlobalContext, newObject)
+	initBreakGlass(GlobalContext, newObject)
+	initAccessTime(GlobalContext, newObject)
+	initConfigBackup(GlobalContext, newObject)
+	initHealthReports(GlobalContext, newObject)
+	initDriveAlerts(GlobalContext, newObject)
+	initEventLog(GlobalContext, newObject)
+	initBucketDNSHealth(GlobalContext)
 	if globalCacheConfig.Enabled {
 		// initialize the new disk cache objects.
 		var cacheAPI CacheObjectLayer
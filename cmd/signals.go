@@ -60,6 +60,12 @@ func handleSignals() {
 			}
 		}
 
+		if httpServer := newBrowserHTTPServerFn(); httpServer != nil {
+			if berr := httpServer.Shutdown(); !errors.Is(berr, http.ErrServerClosed) {
+				logger.LogIf(context.Background(), berr)
+			}
+		}
+
 		if objAPI := newObjectLayerFn(); objAPI != nil {
 			oerr = objAPI.Shutdown(context.Background())
 			logger.LogIf(context.Background(), oerr)
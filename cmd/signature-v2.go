@@ -27,6 +27,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	xhttp "github.com/minio/minio/cmd/http"
 
@@ -168,6 +169,13 @@ func doesPresignV2SignatureMatch(r *http.Request) APIErrorCode {
 		return ErrExpiredPresignRequest
 	}
 
+	// V2 has no maximum expiry of its own, only enforce the
+	// presigned_expiry_max when it is explicitly configured.
+	if maxExpiry := globalAPIConfig.getConfiguredPresignedExpiryMax(); maxExpiry > 0 &&
+		expiresInt-UTCNow().Unix() > int64(maxExpiry/time.Second) {
+		return ErrMaximumExpires
+	}
+
 	encodedResource, err = getResource(encodedResource, r.Host, globalDomainNames)
 	if err != nil {
 		return ErrInvalidRequest
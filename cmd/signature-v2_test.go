@@ -23,6 +23,7 @@ import (
 	"os"
 	"sort"
 	"testing"
+	"time"
 )
 
 // Tests for 'func TestResourceListSorting(t *testing.T)'.
@@ -143,7 +144,7 @@ func TestDoesPresignedV2SignatureMatch(t *testing.T) {
 				t.Errorf("(%d) expected to get %s, instead got %s", i, niceError(testCase.expected), niceError(errCode))
 			}
 		} else {
-			err = preSignV2(req, accessKey, secretKey, now.Unix()+60)
+			err = preSignV2(req, accessKey, secretKey, 60)
 			if err != nil {
 				t.Fatalf("(%d) failed to preSignV2 http request, got %v", i, err)
 			}
@@ -156,6 +157,34 @@ func TestDoesPresignedV2SignatureMatch(t *testing.T) {
 		}
 
 	}
+
+	// V2 URLs valid for longer than a week are accepted unless
+	// presigned_expiry_max is configured.
+	for _, maxExpiry := range []time.Duration{0, time.Hour} {
+		globalAPIConfig.mu.Lock()
+		globalAPIConfig.presignedExpiryMax = maxExpiry
+		globalAPIConfig.mu.Unlock()
+
+		req, err := http.NewRequest(http.MethodGet, "http://host/a/b", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = preSignV2(req, accessKey, secretKey, int64(30*24*time.Hour/time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		req.RequestURI = req.URL.RequestURI()
+
+		expected := ErrNone
+		if maxExpiry > 0 {
+			expected = ErrMaximumExpires
+		}
+		if errCode := doesPresignV2SignatureMatch(req); errCode != expected {
+			t.Errorf("presigned_expiry_max %s: expected %s, got %s", maxExpiry, niceError(expected), niceError(errCode))
+		}
+	}
+	globalAPIConfig.mu.Lock()
+	globalAPIConfig.presignedExpiryMax = 0
+	globalAPIConfig.mu.Unlock()
 }
 
 // TestValidateV2AuthHeader - Tests validate the logic of V2 Authorization header validator.
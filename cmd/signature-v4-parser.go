@@ -228,8 +228,8 @@ func parsePreSignV4(query url.Values, region string, stype serviceType) (psv pre
 		return psv, ErrNegativeExpires
 	}
 
-	// Check if Expiry time is less than 7 days (value in seconds).
-	if preSignV4Values.Expires.Seconds() > 604800 {
+	// Check if Expiry time is less than the configured maximum, 7 days by default.
+	if preSignV4Values.Expires > globalAPIConfig.getPresignedExpiryMax() {
 		return psv, ErrMaximumExpires
 	}
 
@@ -22,6 +22,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	xhttp "github.com/minio/minio/cmd/http"
 )
 
 // generates credential string from its fields.
@@ -889,3 +891,44 @@ func TestParsePreSignV4(t *testing.T) {
 
 	}
 }
+
+// TestParsePreSignV4ExpiryMax - validates that the configured maximum
+// validity of presigned URLs is enforced.
+func TestParsePreSignV4ExpiryMax(t *testing.T) {
+	globalAPIConfig.mu.Lock()
+	globalAPIConfig.presignedExpiryMax = time.Hour
+	globalAPIConfig.mu.Unlock()
+	defer func() {
+		globalAPIConfig.mu.Lock()
+		globalAPIConfig.presignedExpiryMax = 0
+		globalAPIConfig.mu.Unlock()
+	}()
+
+	testCases := []struct {
+		expires         string
+		expectedErrCode APIErrorCode
+	}{
+		{"3600", ErrNone},
+		{"3601", ErrMaximumExpires},
+		{"604800", ErrMaximumExpires},
+	}
+
+	for i, testCase := range testCases {
+		inputQuery := url.Values{}
+		inputQuery.Set(xhttp.AmzAlgorithm, signV4Algorithm)
+		inputQuery.Set(xhttp.AmzCredential, joinWithSlash(
+			"Z7IXGOO6BZ0REAN1Q26I",
+			UTCNow().Format(yyyymmdd),
+			"us-west-1",
+			"s3",
+			"aws4_request"))
+		inputQuery.Set(xhttp.AmzDate, UTCNow().Format(iso8601Format))
+		inputQuery.Set(xhttp.AmzExpires, testCase.expires)
+		inputQuery.Set(xhttp.AmzSignature, "abcd")
+		inputQuery.Set(xhttp.AmzSignedHeaders, "host;x-amz-content-sha256;x-amz-date")
+
+		if _, errCode := parsePreSignV4(inputQuery, "", serviceS3); errCode != testCase.expectedErrCode {
+			t.Fatalf("Test %d: Expected the APIErrCode to be %d, got %d", i+1, testCase.expectedErrCode, errCode)
+		}
+	}
+}
@@ -123,6 +123,14 @@ func checkKeyValid(accessKey string) (auth.Credentials, bool, APIErrorCode) {
 	var owner = true
 	var cred = globalActiveCred
 	if cred.AccessKey != accessKey {
+		// The break-glass credential has root privileges while it is enabled.
+		if globalBreakGlassSys.IsAccessKey(accessKey) {
+			var ok bool
+			if cred, ok = globalBreakGlassSys.GetCredentials(accessKey); !ok {
+				return cred, false, ErrInvalidAccessKeyID
+			}
+			return cred, true, ErrNone
+		}
 		// Check if the access key is part of users credentials.
 		var ok bool
 		if cred, ok = globalIAMSys.GetUser(accessKey); !ok {
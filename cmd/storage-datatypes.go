@@ -43,10 +43,24 @@ type DiskInfo struct {
 
 // DiskMetrics has the information about XL Storage APIs
 // the number of calls of each API and the moving average of
-// the duration of each API.
+// the duration of each API, and the statistics of the drive
+// over the last minute.
 type DiskMetrics struct {
 	APILatencies map[string]string `json:"apiLatencies,omitempty"`
 	APICalls     map[string]uint64 `json:"apiCalls,omitempty"`
+
+	// Percentiles of the duration of reads and writes in
+	// nanoseconds, keyed by percentile: "p50", "p90" and "p99".
+	ReadLatencies  map[string]int64 `json:"readLatencies,omitempty"`
+	WriteLatencies map[string]int64 `json:"writeLatencies,omitempty"`
+	// Number of calls in progress.
+	QueueDepth uint64 `json:"queueDepth"`
+	// Percentage of time at least one call was in progress.
+	Utilization float64 `json:"utilization"`
+	// Number of calls which failed since the server started.
+	TotalErrors uint64 `json:"totalErrors"`
+	// Number of bitrot errors detected since the server started.
+	BitrotErrors uint64 `json:"bitrotErrors"`
 }
 
 // VolsInfo is a collection of volume(bucket) information
@@ -335,6 +335,90 @@ func (z *DiskMetrics) DecodeMsg(dc *msgp.Reader) (err error) {
 				}
 				z.APICalls[za0003] = za0004
 			}
+		case "ReadLatencies":
+			var zb0004 uint32
+			zb0004, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ReadLatencies")
+				return
+			}
+			if z.ReadLatencies == nil {
+				z.ReadLatencies = make(map[string]int64, zb0004)
+			} else if len(z.ReadLatencies) > 0 {
+				for key := range z.ReadLatencies {
+					delete(z.ReadLatencies, key)
+				}
+			}
+			for zb0004 > 0 {
+				zb0004--
+				var za0005 string
+				var za0006 int64
+				za0005, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "ReadLatencies")
+					return
+				}
+				za0006, err = dc.ReadInt64()
+				if err != nil {
+					err = msgp.WrapError(err, "ReadLatencies", za0005)
+					return
+				}
+				z.ReadLatencies[za0005] = za0006
+			}
+		case "WriteLatencies":
+			var zb0005 uint32
+			zb0005, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "WriteLatencies")
+				return
+			}
+			if z.WriteLatencies == nil {
+				z.WriteLatencies = make(map[string]int64, zb0005)
+			} else if len(z.WriteLatencies) > 0 {
+				for key := range z.WriteLatencies {
+					delete(z.WriteLatencies, key)
+				}
+			}
+			for zb0005 > 0 {
+				zb0005--
+				var za0007 string
+				var za0008 int64
+				za0007, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "WriteLatencies")
+					return
+				}
+				za0008, err = dc.ReadInt64()
+				if err != nil {
+					err = msgp.WrapError(err, "WriteLatencies", za0007)
+					return
+				}
+				z.WriteLatencies[za0007] = za0008
+			}
+		case "QueueDepth":
+			z.QueueDepth, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "QueueDepth")
+				return
+			}
+		case "Utilization":
+			z.Utilization, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Utilization")
+				return
+			}
+		case "TotalErrors":
+			z.TotalErrors, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "TotalErrors")
+				return
+			}
+		case "BitrotErrors":
+			z.BitrotErrors, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "BitrotErrors")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -348,9 +432,9 @@ func (z *DiskMetrics) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *DiskMetrics) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 2
+	// map header, size 8
 	// write "APILatencies"
-	err = en.Append(0x82, 0xac, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
+	err = en.Append(0x88, 0xac, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
 	if err != nil {
 		return
 	}
@@ -393,15 +477,99 @@ func (z *DiskMetrics) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	// write "ReadLatencies"
+	err = en.Append(0xad, 0x52, 0x65, 0x61, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteMapHeader(uint32(len(z.ReadLatencies)))
+	if err != nil {
+		err = msgp.WrapError(err, "ReadLatencies")
+		return
+	}
+	for za0005, za0006 := range z.ReadLatencies {
+		err = en.WriteString(za0005)
+		if err != nil {
+			err = msgp.WrapError(err, "ReadLatencies")
+			return
+		}
+		err = en.WriteInt64(za0006)
+		if err != nil {
+			err = msgp.WrapError(err, "ReadLatencies", za0005)
+			return
+		}
+	}
+	// write "WriteLatencies"
+	err = en.Append(0xae, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteMapHeader(uint32(len(z.WriteLatencies)))
+	if err != nil {
+		err = msgp.WrapError(err, "WriteLatencies")
+		return
+	}
+	for za0007, za0008 := range z.WriteLatencies {
+		err = en.WriteString(za0007)
+		if err != nil {
+			err = msgp.WrapError(err, "WriteLatencies")
+			return
+		}
+		err = en.WriteInt64(za0008)
+		if err != nil {
+			err = msgp.WrapError(err, "WriteLatencies", za0007)
+			return
+		}
+	}
+	// write "QueueDepth"
+	err = en.Append(0xaa, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.QueueDepth)
+	if err != nil {
+		err = msgp.WrapError(err, "QueueDepth")
+		return
+	}
+	// write "Utilization"
+	err = en.Append(0xab, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Utilization)
+	if err != nil {
+		err = msgp.WrapError(err, "Utilization")
+		return
+	}
+	// write "TotalErrors"
+	err = en.Append(0xab, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.TotalErrors)
+	if err != nil {
+		err = msgp.WrapError(err, "TotalErrors")
+		return
+	}
+	// write "BitrotErrors"
+	err = en.Append(0xac, 0x42, 0x69, 0x74, 0x72, 0x6f, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.BitrotErrors)
+	if err != nil {
+		err = msgp.WrapError(err, "BitrotErrors")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *DiskMetrics) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 2
+	// map header, size 8
 	// string "APILatencies"
-	o = append(o, 0x82, 0xac, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
+	o = append(o, 0x88, 0xac, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
 	o = msgp.AppendMapHeader(o, uint32(len(z.APILatencies)))
 	for za0001, za0002 := range z.APILatencies {
 		o = msgp.AppendString(o, za0001)
@@ -414,6 +582,32 @@ func (z *DiskMetrics) MarshalMsg(b []byte) (o []byte, err error) {
 		o = msgp.AppendString(o, za0003)
 		o = msgp.AppendUint64(o, za0004)
 	}
+	// string "ReadLatencies"
+	o = append(o, 0xad, 0x52, 0x65, 0x61, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
+	o = msgp.AppendMapHeader(o, uint32(len(z.ReadLatencies)))
+	for za0005, za0006 := range z.ReadLatencies {
+		o = msgp.AppendString(o, za0005)
+		o = msgp.AppendInt64(o, za0006)
+	}
+	// string "WriteLatencies"
+	o = append(o, 0xae, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
+	o = msgp.AppendMapHeader(o, uint32(len(z.WriteLatencies)))
+	for za0007, za0008 := range z.WriteLatencies {
+		o = msgp.AppendString(o, za0007)
+		o = msgp.AppendInt64(o, za0008)
+	}
+	// string "QueueDepth"
+	o = append(o, 0xaa, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68)
+	o = msgp.AppendUint64(o, z.QueueDepth)
+	// string "Utilization"
+	o = append(o, 0xab, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendFloat64(o, z.Utilization)
+	// string "TotalErrors"
+	o = append(o, 0xab, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73)
+	o = msgp.AppendUint64(o, z.TotalErrors)
+	// string "BitrotErrors"
+	o = append(o, 0xac, 0x42, 0x69, 0x74, 0x72, 0x6f, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73)
+	o = msgp.AppendUint64(o, z.BitrotErrors)
 	return
 }
 
@@ -495,6 +689,90 @@ func (z *DiskMetrics) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 				z.APICalls[za0003] = za0004
 			}
+		case "ReadLatencies":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReadLatencies")
+				return
+			}
+			if z.ReadLatencies == nil {
+				z.ReadLatencies = make(map[string]int64, zb0004)
+			} else if len(z.ReadLatencies) > 0 {
+				for key := range z.ReadLatencies {
+					delete(z.ReadLatencies, key)
+				}
+			}
+			for zb0004 > 0 {
+				var za0005 string
+				var za0006 int64
+				zb0004--
+				za0005, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ReadLatencies")
+					return
+				}
+				za0006, bts, err = msgp.ReadInt64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ReadLatencies", za0005)
+					return
+				}
+				z.ReadLatencies[za0005] = za0006
+			}
+		case "WriteLatencies":
+			var zb0005 uint32
+			zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "WriteLatencies")
+				return
+			}
+			if z.WriteLatencies == nil {
+				z.WriteLatencies = make(map[string]int64, zb0005)
+			} else if len(z.WriteLatencies) > 0 {
+				for key := range z.WriteLatencies {
+					delete(z.WriteLatencies, key)
+				}
+			}
+			for zb0005 > 0 {
+				var za0007 string
+				var za0008 int64
+				zb0005--
+				za0007, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "WriteLatencies")
+					return
+				}
+				za0008, bts, err = msgp.ReadInt64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "WriteLatencies", za0007)
+					return
+				}
+				z.WriteLatencies[za0007] = za0008
+			}
+		case "QueueDepth":
+			z.QueueDepth, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "QueueDepth")
+				return
+			}
+		case "Utilization":
+			z.Utilization, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Utilization")
+				return
+			}
+		case "TotalErrors":
+			z.TotalErrors, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TotalErrors")
+				return
+			}
+		case "BitrotErrors":
+			z.BitrotErrors, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BitrotErrors")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -523,6 +801,21 @@ func (z *DiskMetrics) Msgsize() (s int) {
 			s += msgp.StringPrefixSize + len(za0003) + msgp.Uint64Size
 		}
 	}
+	s += 14 + msgp.MapHeaderSize
+	if z.ReadLatencies != nil {
+		for za0005, za0006 := range z.ReadLatencies {
+			_ = za0006
+			s += msgp.StringPrefixSize + len(za0005) + msgp.Int64Size
+		}
+	}
+	s += 15 + msgp.MapHeaderSize
+	if z.WriteLatencies != nil {
+		for za0007, za0008 := range z.WriteLatencies {
+			_ = za0008
+			s += msgp.StringPrefixSize + len(za0007) + msgp.Int64Size
+		}
+	}
+	s += 11 + msgp.Uint64Size + 12 + msgp.Float64Size + 12 + msgp.Uint64Size + 13 + msgp.Uint64Size
 	return
 }
 
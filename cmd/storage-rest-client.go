@@ -148,6 +148,7 @@ func (client *storageRESTClient) call(ctx context.Context, method string, values
 		values = make(url.Values)
 	}
 	values.Set(storageRESTDiskID, client.diskID)
+	defer logger.AddRequestTiming(ctx, logger.TimingDriveWait, time.Now())
 	respBody, err := client.restClient.Call(ctx, method, values, body, length)
 	if err == nil {
 		return respBody, nil
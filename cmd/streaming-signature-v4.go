@@ -36,10 +36,12 @@ import (
 
 // Streaming AWS Signature Version '4' constants.
 const (
-	emptySHA256              = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
-	streamingContentSHA256   = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
-	signV4ChunkedAlgorithm   = "AWS4-HMAC-SHA256-PAYLOAD"
-	streamingContentEncoding = "aws-chunked"
+	emptySHA256                   = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	streamingContentSHA256        = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	streamingContentSHA256Trailer = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD-TRAILER"
+	signV4ChunkedAlgorithm        = "AWS4-HMAC-SHA256-PAYLOAD"
+	signV4ChunkedAlgorithmTrailer = "AWS4-HMAC-SHA256-TRAILER"
+	streamingContentEncoding      = "aws-chunked"
 )
 
 // getChunkSignature - get chunk signature.
@@ -61,6 +63,24 @@ func getChunkSignature(cred auth.Credentials, seedSignature string, region strin
 	return newSignature
 }
 
+// getTrailerSignature - get signature of the trailing headers.
+func getTrailerSignature(cred auth.Credentials, seedSignature string, region string, date time.Time, trailer []byte) string {
+	hashedTrailer := sha256.Sum256(trailer)
+
+	// Calculate string to sign.
+	stringToSign := signV4ChunkedAlgorithmTrailer + "\n" +
+		date.Format(iso8601Format) + "\n" +
+		getScope(date, region) + "\n" +
+		seedSignature + "\n" +
+		hex.EncodeToString(hashedTrailer[:])
+
+	// Get hmac signing key.
+	signingKey := getSigningKey(cred.SecretKey, date, region, serviceS3)
+
+	// Calculate signature.
+	return getSignature(signingKey, stringToSign)
+}
+
 // calculateSeedSignature - Calculate seed signature in accordance with
 //     - http://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-streaming.html
 // returns signature, error otherwise if the signature mismatches or any other
@@ -79,10 +99,11 @@ func calculateSeedSignature(r *http.Request) (cred auth.Credentials, signature s
 	}
 
 	// Payload streaming.
-	payload := streamingContentSHA256
+	payload := req.Header.Get(xhttp.AmzContentSha256)
 
-	// Payload for STREAMING signature should be 'STREAMING-AWS4-HMAC-SHA256-PAYLOAD'
-	if payload != req.Header.Get(xhttp.AmzContentSha256) {
+	// Payload for STREAMING signature should be 'STREAMING-AWS4-HMAC-SHA256-PAYLOAD',
+	// optionally followed by trailing headers.
+	if payload != streamingContentSHA256 && payload != streamingContentSHA256Trailer {
 		return cred, "", "", time.Time{}, ErrContentSHA256Mismatch
 	}
 
@@ -150,15 +171,22 @@ var errMalformedEncoding = errors.New("malformed chunked encoding")
 // newSignV4ChunkedReader returns a new s3ChunkedReader that translates the data read from r
 // out of HTTP "chunked" format before returning it.
 // The s3ChunkedReader returns io.EOF when the final 0-length chunk is read.
+// The checksums verified against the trailing headers, if any, are
+// added to checksums, if not nil.
 //
 // NewChunkedReader is not needed by normal applications. The http package
 // automatically decodes chunking when reading response bodies.
-func newSignV4ChunkedReader(req *http.Request) (io.ReadCloser, APIErrorCode) {
+func newSignV4ChunkedReader(req *http.Request, checksums map[string]string) (io.ReadCloser, APIErrorCode) {
 	cred, seedSignature, region, seedDate, errCode := calculateSeedSignature(req)
 	if errCode != ErrNone {
 		return nil, errCode
 	}
 
+	var trailer *chunkTrailer
+	if req.Header.Get(xhttp.AmzContentSha256) == streamingContentSHA256Trailer {
+		trailer = newChunkTrailer(req.Header.Get(xhttp.AmzTrailer), checksums)
+	}
+
 	return &s3ChunkedReader{
 		reader:            bufio.NewReader(req.Body),
 		cred:              cred,
@@ -166,6 +194,7 @@ func newSignV4ChunkedReader(req *http.Request) (io.ReadCloser, APIErrorCode) {
 		seedDate:          seedDate,
 		region:            region,
 		chunkSHA256Writer: sha256.New(),
+		trailer:           trailer,
 		buffer:            make([]byte, 64*1024),
 	}, ErrNone
 }
@@ -179,7 +208,8 @@ type s3ChunkedReader struct {
 	seedDate      time.Time
 	region        string
 
-	chunkSHA256Writer hash.Hash // Calculates sha256 of chunk data.
+	chunkSHA256Writer hash.Hash     // Calculates sha256 of chunk data.
+	trailer           *chunkTrailer // Trailing headers, if announced.
 	buffer            []byte
 	offset            int
 	err               error
@@ -312,22 +342,25 @@ func (cr *s3ChunkedReader) Read(buf []byte) (n int, err error) {
 		cr.err = err
 		return n, cr.err
 	}
-	b, err = cr.reader.ReadByte()
-	if b != '\r' {
-		cr.err = errMalformedEncoding
-		return n, cr.err
-	}
-	b, err = cr.reader.ReadByte()
-	if err == io.EOF {
-		err = io.ErrUnexpectedEOF
-	}
-	if err != nil {
-		cr.err = err
-		return n, cr.err
-	}
-	if b != '\n' {
-		cr.err = errMalformedEncoding
-		return n, cr.err
+	// With trailing headers, they directly follow the last chunk.
+	if size > 0 || cr.trailer == nil {
+		b, err = cr.reader.ReadByte()
+		if b != '\r' {
+			cr.err = errMalformedEncoding
+			return n, cr.err
+		}
+		b, err = cr.reader.ReadByte()
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			cr.err = err
+			return n, cr.err
+		}
+		if b != '\n' {
+			cr.err = errMalformedEncoding
+			return n, cr.err
+		}
 	}
 
 	// Once we have read the entire chunk successfully, we verify
@@ -340,10 +373,19 @@ func (cr *s3ChunkedReader) Read(buf []byte) (n int, err error) {
 	}
 	cr.seedSignature = newSignature
 	cr.chunkSHA256Writer.Reset()
+	if cr.trailer != nil {
+		cr.trailer.Write(cr.buffer)
+	}
 
 	// If the chunk size is zero we return io.EOF. As specified by AWS,
 	// only the last chunk is zero-sized.
 	if size == 0 {
+		if cr.trailer != nil {
+			if err = cr.readTrailer(); err != nil {
+				cr.err = err
+				return n, cr.err
+			}
+		}
 		cr.err = io.EOF
 		return n, cr.err
 	}
@@ -353,6 +395,50 @@ func (cr *s3ChunkedReader) Read(buf []byte) (n int, err error) {
 	return n, err
 }
 
+// Prefix of the trailing header carrying the signature of the others.
+const trailerSignaturePrefix = "x-amz-trailer-signature:"
+
+// readTrailer reads the trailing headers following the last chunk,
+// verifies their signature and the checksums they carry.
+func (cr *s3ChunkedReader) readTrailer() error {
+	// The trailing headers have the following format:
+	//   <key> + ":" + <value> + "\r\n"
+	//   "x-amz-trailer-signature:" + <signature-as-hex> + "\r\n" + "\r\n"
+	//
+	// The signature is computed over the other headers, each
+	// one terminated by "\n" instead.
+	var trailer bytes.Buffer
+	for {
+		line, err := cr.reader.ReadSlice('\n')
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		} else if err == bufio.ErrBufferFull {
+			err = errLineTooLong
+		}
+		if err != nil {
+			return err
+		}
+		line = trimTrailingWhitespace(line)
+		if bytes.HasPrefix(line, []byte(trailerSignaturePrefix)) {
+			newSignature := getTrailerSignature(cr.cred, cr.seedSignature, cr.region, cr.seedDate, trailer.Bytes())
+			if !compareSignatureV4(string(line[len(trailerSignaturePrefix):]), newSignature) {
+				return errSignatureMismatch
+			}
+			break
+		}
+		if err = cr.trailer.add(line); err != nil {
+			return err
+		}
+		trailer.Write(line)
+		trailer.WriteByte('\n')
+	}
+	// Some clients omit the final empty line.
+	if err := readCRLF(cr.reader); err != nil && err != io.EOF {
+		return err
+	}
+	return cr.trailer.verify()
+}
+
 // readCRLF - check if reader only has '\r\n' CRLF character.
 // returns malformed encoding if it doesn't.
 func readCRLF(reader io.Reader) error {
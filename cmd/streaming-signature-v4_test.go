@@ -19,10 +19,16 @@ package cmd
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/auth"
 )
 
 // Test read chunk line.
@@ -194,3 +200,63 @@ func TestParseHexUint(t *testing.T) {
 		}
 	}
 }
+
+// Tests decoding of signed chunks followed by signed trailing headers.
+func TestS3ChunkedReaderTrailer(t *testing.T) {
+	cred := auth.Credentials{AccessKey: "minio", SecretKey: "minio123"}
+	region := globalMinioDefaultRegion
+	date := UTCNow().Truncate(time.Second)
+	seedSignature := strings.Repeat("a", 64)
+
+	newBody := func(payload, trailer, trailerSignature string) string {
+		sum := sha256.Sum256([]byte(payload))
+		signature := getChunkSignature(cred, seedSignature, region, date, hex.EncodeToString(sum[:]))
+		body := fmt.Sprintf("%x;chunk-signature=%s\r\n%s\r\n", len(payload), signature, payload)
+		signature = getChunkSignature(cred, signature, region, date, emptySHA256)
+		body += fmt.Sprintf("0;chunk-signature=%s\r\n%s\r\n", signature, trailer)
+		if trailerSignature == "" {
+			trailerSignature = getTrailerSignature(cred, signature, region, date, []byte(trailer+"\n"))
+		}
+		return body + fmt.Sprintf("x-amz-trailer-signature:%s\r\n\r\n", trailerSignature)
+	}
+
+	testCases := []struct {
+		body        string
+		expectedErr error
+	}{
+		// Test 1 - valid checksum and signature.
+		{newBody("hello world", "x-amz-checksum-crc32:DUoRhQ==", ""), nil},
+		// Test 2 - checksum mismatch.
+		{newBody("hello", "x-amz-checksum-crc32:DUoRhQ==", ""), errContentChecksumMismatch},
+		// Test 3 - invalid trailer signature.
+		{newBody("hello world", "x-amz-checksum-crc32:DUoRhQ==", strings.Repeat("b", 64)), errSignatureMismatch},
+	}
+
+	for i, testCase := range testCases {
+		checksums := make(map[string]string)
+		cr := &s3ChunkedReader{
+			reader:            bufio.NewReader(strings.NewReader(testCase.body)),
+			cred:              cred,
+			seedSignature:     seedSignature,
+			seedDate:          date,
+			region:            region,
+			chunkSHA256Writer: sha256.New(),
+			trailer:           newChunkTrailer("x-amz-checksum-crc32", checksums),
+			buffer:            make([]byte, 64*1024),
+		}
+		data, err := ioutil.ReadAll(cr)
+		if err != testCase.expectedErr {
+			t.Errorf("Test %d: expected error %v, got %v", i+1, testCase.expectedErr, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if string(data) != "hello world" {
+			t.Errorf("Test %d: expected %q, got %q", i+1, "hello world", string(data))
+		}
+		if checksums["X-Amz-Checksum-Crc32"] != "DUoRhQ==" {
+			t.Errorf("Test %d: expected the checksum to be verified, got %v", i+1, checksums)
+		}
+	}
+}
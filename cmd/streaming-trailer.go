@@ -0,0 +1,125 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"net/http"
+	"strings"
+)
+
+// errContentChecksumMismatch is returned when the checksum sent
+// in the trailing headers does not match the uploaded content.
+var errContentChecksumMismatch = errors.New("content checksum does not match the trailing checksum")
+
+// Checksums which can be sent in the trailing headers, the
+// uploaded content is verified against them.
+var trailerChecksums = map[string]func() hash.Hash{
+	"X-Amz-Checksum-Crc32":  func() hash.Hash { return crc32.NewIEEE() },
+	"X-Amz-Checksum-Crc32c": func() hash.Hash { return crc32.New(crc32.MakeTable(crc32.Castagnoli)) },
+	"X-Amz-Checksum-Sha1":   sha1.New,
+	"X-Amz-Checksum-Sha256": sha256.New,
+}
+
+// chunkTrailer keeps track of the trailing headers of an
+// 'aws-chunked' upload and of the checksums they carry.
+type chunkTrailer struct {
+	declared  map[string]struct{}  // Trailing headers announced in 'X-Amz-Trailer'.
+	checksums map[string]hash.Hash // Checksums of the content announced as trailers.
+	received  http.Header          // Trailing headers received.
+
+	// Verified checksums are added to verified, if not nil.
+	verified map[string]string
+}
+
+// newChunkTrailer returns a chunkTrailer for the comma separated
+// list of trailing headers announced in 'X-Amz-Trailer'.
+func newChunkTrailer(declared string, verified map[string]string) *chunkTrailer {
+	t := &chunkTrailer{
+		declared:  make(map[string]struct{}),
+		checksums: make(map[string]hash.Hash),
+		received:  make(http.Header),
+		verified:  verified,
+	}
+	for _, key := range strings.Split(declared, ",") {
+		key = http.CanonicalHeaderKey(strings.TrimSpace(key))
+		if key == "" {
+			continue
+		}
+		t.declared[key] = struct{}{}
+		if newHash, ok := trailerChecksums[key]; ok {
+			t.checksums[key] = newHash()
+		}
+	}
+	return t
+}
+
+// Write - implements `io.Writer`, all the content of the upload
+// is to be written to compute the announced checksums.
+func (t *chunkTrailer) Write(p []byte) (int, error) {
+	for _, h := range t.checksums {
+		h.Write(p)
+	}
+	return len(p), nil
+}
+
+// add parses a trailing header line of the form "<key>:<value>",
+// the key must have been announced in 'X-Amz-Trailer'.
+func (t *chunkTrailer) add(line []byte) error {
+	i := bytes.IndexByte(line, ':')
+	if i <= 0 {
+		return errMalformedEncoding
+	}
+	key := http.CanonicalHeaderKey(string(bytes.TrimSpace(line[:i])))
+	if _, ok := t.declared[key]; !ok {
+		return errMalformedEncoding
+	}
+	t.received.Set(key, string(bytes.TrimSpace(line[i+1:])))
+	return nil
+}
+
+// verify compares the checksums received in the trailing
+// headers with the ones computed over the content.
+func (t *chunkTrailer) verify() error {
+	for key, h := range t.checksums {
+		if t.received.Get(key) != base64.StdEncoding.EncodeToString(h.Sum(nil)) {
+			return errContentChecksumMismatch
+		}
+	}
+	if t.verified != nil {
+		for key := range t.checksums {
+			t.verified[key] = t.received.Get(key)
+		}
+	}
+	return nil
+}
+
+// setChecksumHeaders sets the response headers of the checksums
+// verified against the trailing headers of an upload.
+func setChecksumHeaders(w http.ResponseWriter, checksums map[string]string) {
+	for key := range trailerChecksums {
+		if value, ok := checksums[key]; ok {
+			w.Header().Set(key, value)
+		}
+	}
+}
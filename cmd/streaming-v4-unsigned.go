@@ -0,0 +1,154 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+
+	xhttp "github.com/minio/minio/cmd/http"
+)
+
+// Payload of 'aws-chunked' uploads with unsigned chunks
+// and optional trailing headers.
+const unsignedPayloadTrailer = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+
+// newUnsignedV4ChunkedReader returns a reader which decodes the
+// 'aws-chunked' body of an upload signed with an unsigned payload
+// and trailing headers, the request signature is verified first.
+// The checksums verified against the trailing headers are added
+// to checksums, if not nil.
+func newUnsignedV4ChunkedReader(req *http.Request, checksums map[string]string) (io.ReadCloser, APIErrorCode) {
+	if errCode := doesSignatureMatch(unsignedPayloadTrailer, req, globalServerRegion, serviceS3); errCode != ErrNone {
+		return nil, errCode
+	}
+	return &s3UnsignedChunkedReader{
+		reader:  bufio.NewReader(req.Body),
+		trailer: newChunkTrailer(req.Header.Get(xhttp.AmzTrailer), checksums),
+	}, ErrNone
+}
+
+// Represents the state required to decode an 'aws-chunked'
+// body with unsigned chunks and trailing headers.
+type s3UnsignedChunkedReader struct {
+	reader  *bufio.Reader
+	trailer *chunkTrailer
+
+	remaining int64 // Unread bytes of the current chunk.
+	started   bool  // Whether a chunk was read already.
+	err       error
+}
+
+func (cr *s3UnsignedChunkedReader) Close() (err error) {
+	return nil
+}
+
+// Read - implements `io.Reader`, which transparently decodes
+// the incoming 'aws-chunked' body.
+func (cr *s3UnsignedChunkedReader) Read(buf []byte) (n int, err error) {
+	// A chunk has the following format:
+	//   <chunk-size-as-hex> + "\r\n" + <payload> + "\r\n"
+	//
+	// The last chunk is 0-sized and followed by the trailing
+	// headers, each one terminated by "\r\n", and an empty line.
+	for cr.remaining == 0 {
+		if cr.err != nil {
+			return 0, cr.err
+		}
+		if cr.started {
+			// Every chunk payload is followed by CRLF.
+			if cr.err = readCRLF(cr.reader); cr.err != nil {
+				if cr.err == io.EOF {
+					cr.err = io.ErrUnexpectedEOF
+				}
+				continue
+			}
+		}
+		cr.started = true
+		cr.err = cr.readChunkSize()
+	}
+
+	if int64(len(buf)) > cr.remaining {
+		buf = buf[:cr.remaining]
+	}
+	n, err = cr.reader.Read(buf)
+	cr.trailer.Write(buf[:n])
+	cr.remaining -= int64(n)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		cr.err = err
+	}
+	return n, err
+}
+
+// readChunkSize reads the header line of the next chunk, reading
+// the trailing headers once the last chunk is reached.
+func (cr *s3UnsignedChunkedReader) readChunkSize() error {
+	line, _, err := readChunkLine(cr.reader)
+	if err != nil {
+		return err
+	}
+	// Ignore chunk extensions if any.
+	if i := bytes.IndexByte(line, ';'); i >= 0 {
+		line = line[:i]
+	}
+	size, err := parseHexUint(line)
+	if err != nil || len(line) == 0 {
+		return errMalformedEncoding
+	}
+	if size > 0 {
+		cr.remaining = int64(size)
+		return nil
+	}
+	if err = cr.readTrailers(); err != nil {
+		return err
+	}
+	return io.EOF
+}
+
+// readTrailers reads the trailing headers after the last chunk
+// and verifies the checksums sent in them.
+func (cr *s3UnsignedChunkedReader) readTrailers() error {
+	for {
+		line, err := cr.reader.ReadSlice('\n')
+		if err == io.EOF && len(bytes.TrimSpace(line)) == 0 {
+			// Some clients omit the final empty line.
+			break
+		}
+		if err == bufio.ErrBufferFull || len(line) >= maxLineLength {
+			return errLineTooLong
+		}
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		line = trimTrailingWhitespace(line)
+		if len(line) == 0 {
+			break
+		}
+		if err = cr.trailer.add(line); err != nil {
+			return err
+		}
+	}
+	return cr.trailer.verify()
+}
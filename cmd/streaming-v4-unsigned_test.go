@@ -0,0 +1,73 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package cmd
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+// Test decoding of unsigned 'aws-chunked' bodies with trailing headers.
+func TestS3UnsignedChunkedReader(t *testing.T) {
+	testCases := []struct {
+		body        string
+		trailer     string
+		expected    string
+		expectedErr error
+	}{
+		// Test 1 - single chunk without trailers.
+		{"5\r\nhello\r\n0\r\n\r\n", "", "hello", nil},
+		// Test 2 - several chunks with a valid checksum.
+		{"5\r\nhello\r\n6\r\n world\r\n0\r\nx-amz-checksum-crc32:DUoRhQ==\r\n\r\n", "x-amz-checksum-crc32", "hello world", nil},
+		// Test 3 - same with a sha256 checksum and no final empty line.
+		{"b\r\nhello world\r\n0\r\nx-amz-checksum-sha256:uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=\r\n", "x-amz-checksum-sha256", "hello world", nil},
+		// Test 4 - checksum mismatch.
+		{"5\r\nhello\r\n0\r\nx-amz-checksum-crc32:DUoRhQ==\r\n\r\n", "x-amz-checksum-crc32", "", errContentChecksumMismatch},
+		// Test 5 - trailer not announced in 'X-Amz-Trailer'.
+		{"5\r\nhello\r\n0\r\nx-amz-checksum-crc32:DUoRhQ==\r\n\r\n", "", "", errMalformedEncoding},
+		// Test 6 - invalid chunk size.
+		{"x\r\nhello\r\n0\r\n\r\n", "", "", errMalformedEncoding},
+		// Test 7 - missing CRLF after the chunk payload.
+		{"5\r\nhelloXX0\r\n\r\n", "", "", errMalformedEncoding},
+		// Test 8 - truncated body.
+		{"a\r\nhello", "", "", io.ErrUnexpectedEOF},
+	}
+
+	for i, testCase := range testCases {
+		checksums := make(map[string]string)
+		data, err := ioutil.ReadAll(&s3UnsignedChunkedReader{
+			reader:  bufio.NewReader(strings.NewReader(testCase.body)),
+			trailer: newChunkTrailer(testCase.trailer, checksums),
+		})
+		if err != testCase.expectedErr {
+			t.Errorf("Test %d: expected error %v, got %v", i+1, testCase.expectedErr, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if string(data) != testCase.expected {
+			t.Errorf("Test %d: expected %q, got %q", i+1, testCase.expected, string(data))
+		}
+		if testCase.trailer != "" && len(checksums) != 1 {
+			t.Errorf("Test %d: expected the checksum to be verified, got %v", i+1, checksums)
+		}
+	}
+}
@@ -0,0 +1,369 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/auth"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	tenantsPrefix = minioConfigPrefix + "/tenants"
+
+	// Tenants are cached for this long, a tenant removed and
+	// created again with another root user is seen by all nodes
+	// once it expires.
+	tenantCacheTTL = time.Minute
+)
+
+// Tenant names are also the prefix of the buckets of the tenant,
+// they cannot hold the '-' separator so that no tenant prefix is
+// a prefix of another.
+var tenantNameRegexp = regexp.MustCompile("^[a-z0-9]{3,16}$")
+
+var (
+	errTenantNotFound = AdminError{
+		Code:       "XMinioAdminNoSuchTenant",
+		Message:    "The specified tenant does not exist",
+		StatusCode: http.StatusNotFound,
+	}
+	errTenantExists = AdminError{
+		Code:       "XMinioAdminTenantExists",
+		Message:    "The specified tenant already exists",
+		StatusCode: http.StatusConflict,
+	}
+	errTenantUserExists = AdminError{
+		Code:       "XMinioAdminTenantUserExists",
+		Message:    "The root user of the tenant already exists",
+		StatusCode: http.StatusConflict,
+	}
+	errTenantNotEmpty = AdminError{
+		Code:       "XMinioAdminTenantNotEmpty",
+		Message:    "The specified tenant still has buckets",
+		StatusCode: http.StatusConflict,
+	}
+	errTenantBucketsExist = AdminError{
+		Code:       "XMinioAdminTenantBucketsExist",
+		Message:    "Buckets with the prefix of the specified tenant already exist",
+		StatusCode: http.StatusConflict,
+	}
+	errTenantInvalidName = AdminError{
+		Code:       "XMinioAdminInvalidTenantName",
+		Message:    "Tenant names must be 3 to 16 lowercase letters or digits",
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+// tenantAdminActions - admin actions allowed to the root user of a
+// tenant, the user handlers restrict them to the users of the tenant.
+var tenantAdminActions = map[iampolicy.Action]struct{}{
+	iampolicy.CreateUserAdminAction:       {},
+	iampolicy.DeleteUserAdminAction:       {},
+	iampolicy.ListUsersAdminAction:        {},
+	iampolicy.GetUserAdminAction:          {},
+	iampolicy.EnableUserAdminAction:       {},
+	iampolicy.DisableUserAdminAction:      {},
+	iampolicy.AttachPolicyAdminAction:     {},
+	iampolicy.GetPolicyAdminAction:        {},
+	iampolicy.ListUserPoliciesAdminAction: {},
+}
+
+func tenantPath(name string) string {
+	return path.Join(tenantsPrefix, name+".json")
+}
+
+// tenantLockPath - serializes the changes of a tenant, its config
+// cannot be locked itself since it is read and written meanwhile.
+func tenantLockPath(name string) string {
+	return path.Join(tenantsPrefix, name+".lock")
+}
+
+func tenantBucketPrefix(name string) string {
+	return name + "-"
+}
+
+// isAllowedTenant - returns false if args reaches outside of tenant:
+// buckets of other tenants or admin actions which are not delegated
+// to the root user of the tenant.
+func isAllowedTenant(tenant string, isRoot bool, args iampolicy.Args) bool {
+	if iampolicy.AdminAction(args.Action).IsValid() {
+		_, ok := tenantAdminActions[args.Action]
+		return isRoot && ok
+	}
+	if args.BucketName == "" {
+		// Buckets are then listed one at a time, only
+		// the buckets of the tenant are returned.
+		if args.Action == iampolicy.ListAllMyBucketsAction {
+			return false
+		}
+		return !isRoot
+	}
+	return strings.HasPrefix(args.BucketName, tenantBucketPrefix(tenant))
+}
+
+// checkTenantUser - returns an error if cred belongs to a tenant and
+// the existing user accessKey is not a user of the same tenant. The
+// root user of a tenant is only managed through the tenant APIs.
+func checkTenantUser(cred auth.Credentials, accessKey string) error {
+	tenant := globalIAMSys.TenantOf(cred.AccessKey)
+	if tenant == "" {
+		return nil
+	}
+	info, err := globalIAMSys.GetUserInfo(accessKey)
+	if err == errNoSuchUser {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Tenant != tenant || globalTenantSys.IsRoot(tenant, accessKey) {
+		return errIAMActionNotAllowed
+	}
+	return nil
+}
+
+type tenantCacheEntry struct {
+	info   madmin.TenantInfo
+	found  bool
+	loaded time.Time
+}
+
+// TenantSys - keeps track of the tenants of the deployment. The
+// users of a tenant are regular IAM users tagged with the tenant.
+type TenantSys struct {
+	mu    sync.Mutex
+	cache map[string]tenantCacheEntry
+}
+
+// NewTenantSys - creates a new tenant system.
+func NewTenantSys() *TenantSys {
+	return &TenantSys{
+		cache: make(map[string]tenantCacheEntry),
+	}
+}
+
+func loadTenant(ctx context.Context, objAPI ObjectLayer, name string) (info madmin.TenantInfo, err error) {
+	data, err := readConfig(ctx, objAPI, tenantPath(name))
+	if err != nil {
+		if err == errConfigNotFound {
+			err = errTenantNotFound
+		}
+		return info, err
+	}
+	err = json.Unmarshal(data, &info)
+	return info, err
+}
+
+// Get - returns the tenant name.
+func (sys *TenantSys) Get(ctx context.Context, name string) (madmin.TenantInfo, error) {
+	sys.mu.Lock()
+	entry, ok := sys.cache[name]
+	sys.mu.Unlock()
+	if ok && time.Since(entry.loaded) < tenantCacheTTL {
+		if !entry.found {
+			return entry.info, errTenantNotFound
+		}
+		return entry.info, nil
+	}
+
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		return madmin.TenantInfo{}, errServerNotInitialized
+	}
+	info, err := loadTenant(ctx, objAPI, name)
+	if err != nil && err != errTenantNotFound {
+		return info, err
+	}
+	sys.mu.Lock()
+	sys.cache[name] = tenantCacheEntry{
+		info:   info,
+		found:  err == nil,
+		loaded: time.Now(),
+	}
+	sys.mu.Unlock()
+	return info, err
+}
+
+// IsRoot - returns true if accessKey is the root user of tenant.
+func (sys *TenantSys) IsRoot(tenant, accessKey string) bool {
+	info, err := sys.Get(GlobalContext, tenant)
+	return err == nil && info.RootUser == accessKey
+}
+
+// Add - creates a new tenant and its root user.
+func (sys *TenantSys) Add(ctx context.Context, objAPI ObjectLayer, req madmin.AddTenantReq) (madmin.TenantInfo, error) {
+	if !tenantNameRegexp.MatchString(req.Name) {
+		return madmin.TenantInfo{}, errTenantInvalidName
+	}
+	if !auth.IsAccessKeyValid(req.AccessKey) || !auth.IsSecretKeyValid(req.SecretKey) {
+		return madmin.TenantInfo{}, auth.ErrInvalidAccessKeyLength
+	}
+	if req.AccessKey == globalActiveCred.AccessKey {
+		return madmin.TenantInfo{}, errTenantUserExists
+	}
+
+	locker := objAPI.NewNSLock(minioMetaBucket, tenantLockPath(req.Name))
+	ctx, err := locker.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return madmin.TenantInfo{}, err
+	}
+	defer locker.Unlock()
+
+	if _, err = loadTenant(ctx, objAPI, req.Name); err != errTenantNotFound {
+		if err == nil {
+			err = errTenantExists
+		}
+		return madmin.TenantInfo{}, err
+	}
+	// The tenant would be granted access to the existing buckets
+	// with its prefix, which belong to someone else.
+	hasBuckets, err := tenantHasBuckets(ctx, objAPI, req.Name)
+	if err != nil {
+		return madmin.TenantInfo{}, err
+	}
+	if hasBuckets {
+		return madmin.TenantInfo{}, errTenantBucketsExist
+	}
+	if _, err = globalIAMSys.GetUserInfo(req.AccessKey); err != errNoSuchUser {
+		if err == nil {
+			err = errTenantUserExists
+		}
+		return madmin.TenantInfo{}, err
+	}
+
+	info := madmin.TenantInfo{
+		Name:         req.Name,
+		RootUser:     req.AccessKey,
+		BucketPrefix: tenantBucketPrefix(req.Name),
+		Created:      UTCNow(),
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return info, err
+	}
+	if err = saveConfig(ctx, objAPI, tenantPath(req.Name), data); err != nil {
+		return info, err
+	}
+	if err = globalIAMSys.CreateUser(req.AccessKey, madmin.UserInfo{
+		SecretKey: req.SecretKey,
+		Status:    madmin.AccountEnabled,
+		Tenant:    req.Name,
+	}); err != nil {
+		logger.LogIf(ctx, deleteConfig(ctx, objAPI, tenantPath(req.Name)))
+		return info, err
+	}
+
+	sys.mu.Lock()
+	sys.cache[req.Name] = tenantCacheEntry{info: info, found: true, loaded: time.Now()}
+	sys.mu.Unlock()
+	return info, nil
+}
+
+// tenantHasBuckets - returns true if a bucket of the tenant name exists.
+func tenantHasBuckets(ctx context.Context, objAPI ObjectLayer, name string) (bool, error) {
+	buckets, err := objAPI.ListBuckets(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, bucket := range buckets {
+		if strings.HasPrefix(bucket.Name, tenantBucketPrefix(name)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Remove - removes a tenant without buckets and all of its users,
+// the access keys of the removed users are returned.
+func (sys *TenantSys) Remove(ctx context.Context, objAPI ObjectLayer, name string) ([]string, error) {
+	locker := objAPI.NewNSLock(minioMetaBucket, tenantLockPath(name))
+	ctx, err := locker.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer locker.Unlock()
+
+	if _, err = loadTenant(ctx, objAPI, name); err != nil {
+		return nil, err
+	}
+
+	hasBuckets, err := tenantHasBuckets(ctx, objAPI, name)
+	if err != nil {
+		return nil, err
+	}
+	if hasBuckets {
+		return nil, errTenantNotEmpty
+	}
+
+	users, err := globalIAMSys.ListUsers()
+	if err != nil {
+		return nil, err
+	}
+	var removed []string
+	for accessKey, u := range users {
+		if u.Tenant != name {
+			continue
+		}
+		if err = globalIAMSys.DeleteUser(accessKey); err != nil {
+			return removed, err
+		}
+		removed = append(removed, accessKey)
+	}
+
+	if err = deleteConfig(ctx, objAPI, tenantPath(name)); err != nil && err != errConfigNotFound {
+		return removed, err
+	}
+
+	sys.mu.Lock()
+	delete(sys.cache, name)
+	sys.mu.Unlock()
+	return removed, nil
+}
+
+// List - returns all the tenants.
+func (sys *TenantSys) List(ctx context.Context, objAPI ObjectLayer) ([]madmin.TenantInfo, error) {
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(ctx, minioMetaBucket, tenantsPrefix+SlashSeparator, objInfoCh, ObjectOptions{}); err != nil {
+		return nil, err
+	}
+
+	tenants := []madmin.TenantInfo{}
+	for obj := range objInfoCh {
+		name := strings.TrimSuffix(path.Base(obj.Name), ".json")
+		info, err := loadTenant(ctx, objAPI, name)
+		if err != nil {
+			if err == errTenantNotFound {
+				continue
+			}
+			return nil, err
+		}
+		tenants = append(tenants, info)
+	}
+	return tenants, nil
+}
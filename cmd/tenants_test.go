@@ -0,0 +1,102 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestIsAllowedTenant(t *testing.T) {
+	testCases := []struct {
+		isRoot   bool
+		action   iampolicy.Action
+		bucket   string
+		expected bool
+	}{
+		{true, iampolicy.GetObjectAction, "acme-photos", true},
+		{false, iampolicy.GetObjectAction, "acme-photos", true},
+		{true, iampolicy.GetObjectAction, "other-photos", false},
+		{false, iampolicy.PutObjectAction, "acmephotos", false},
+		// Buckets are listed one at a time.
+		{true, iampolicy.ListAllMyBucketsAction, "", false},
+		{false, iampolicy.ListAllMyBucketsAction, "", false},
+		{true, iampolicy.CreateUserAdminAction, "", true},
+		{false, iampolicy.CreateUserAdminAction, "", false},
+		{true, iampolicy.ConfigUpdateAdminAction, "", false},
+		{true, iampolicy.TenantAdminAction, "", false},
+	}
+
+	for i, testCase := range testCases {
+		allowed := isAllowedTenant("acme", testCase.isRoot, iampolicy.Args{
+			Action:     testCase.action,
+			BucketName: testCase.bucket,
+		})
+		if allowed != testCase.expected {
+			t.Errorf("Test %d: expected %t, got %t", i+1, testCase.expected, allowed)
+		}
+	}
+}
+
+func TestTenantNames(t *testing.T) {
+	testCases := []struct {
+		name  string
+		valid bool
+	}{
+		{"acme", true},
+		{"team42", true},
+		{"ab", false},
+		{"acme-corp", false},
+		{"Acme", false},
+		{"averyveryverylongname", false},
+	}
+
+	for i, testCase := range testCases {
+		if valid := tenantNameRegexp.MatchString(testCase.name); valid != testCase.valid {
+			t.Errorf("Test %d: expected %t for %s, got %t", i+1, testCase.valid, testCase.name, valid)
+		}
+	}
+}
+
+func TestTenantAddExistingBuckets(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(ctx)
+	defer removeRoots(fsDirs)
+
+	if err = obj.MakeBucketWithLocation(ctx, "acme-photos", BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewTenantSys().Add(ctx, obj, madmin.AddTenantReq{
+		Name:      "acme",
+		AccessKey: "acmeadmin",
+		SecretKey: "acmeadmin-secret",
+	})
+	if err != errTenantBucketsExist {
+		t.Fatalf("Expected %v, got %v", errTenantBucketsExist, err)
+	}
+}
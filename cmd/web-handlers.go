@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -716,6 +717,9 @@ func (web *webAPIHandlers) RemoveObject(r *http.Request, args *RemoveObjectArgs,
 	opts := ObjectOptions{
 		Versioned:        globalBucketVersioningSys.Enabled(args.BucketName),
 		VersionSuspended: globalBucketVersioningSys.Suspended(args.BucketName),
+		PrefixEnabledFn: func(prefix string) bool {
+			return globalBucketVersioningSys.PrefixEnabled(args.BucketName, prefix)
+		},
 	}
 	var (
 		err           error
@@ -781,6 +785,8 @@ next:
 				deleteObject = web.CacheAPI().DeleteObject
 			}
 
+			opts.Versioned = globalBucketVersioningSys.PrefixEnabled(args.BucketName, objectName)
+			opts.VersionSuspended = globalBucketVersioningSys.PrefixSuspended(args.BucketName, objectName)
 			oi, err := deleteObject(ctx, args.BucketName, objectName, opts)
 			if err != nil {
 				switch err.(type) {
@@ -1221,6 +1227,10 @@ func (web *webAPIHandlers) Upload(w http.ResponseWriter, r *http.Request) {
 		writeWebErrorResponse(w, err)
 		return
 	}
+	if err := enforceBucketObjectsQuota(ctx, bucket, object); err != nil {
+		writeWebErrorResponse(w, err)
+		return
+	}
 
 	// Extract incoming metadata if any.
 	metadata, err := extractMetadata(ctx, r)
@@ -2168,7 +2178,16 @@ func (web *webAPIHandlers) PresignedGet(r *http.Request, args *PresignedGetArgs,
 	}
 
 	reply.UIVersion = Version
-	reply.URL = presignedGet(args.HostName, args.BucketName, args.ObjectName, args.Expiry, creds, region)
+	host := args.HostName
+	// Shared links are served by the S3 API, on the same host
+	// name, when the browser is served on its own address.
+	if globalBrowserAddr != "" {
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		host = net.JoinHostPort(strings.Trim(host, "[]"), globalMinioPort)
+	}
+	reply.URL = presignedGet(host, args.BucketName, args.ObjectName, args.Expiry, creds, region)
 	return nil
 }
 
@@ -2182,8 +2201,10 @@ func presignedGet(host, bucket, object string, expiry int64, creds auth.Credenti
 	dateStr := date.Format(iso8601Format)
 	credential := fmt.Sprintf("%s/%s", accessKey, getScope(date, region))
 
-	var expiryStr = "604800" // Default set to be expire in 7days.
-	if expiry < 604800 && expiry > 0 {
+	// Default to the longest validity allowed, 7 days unless configured otherwise.
+	maxExpiry := int64(globalAPIConfig.getPresignedExpiryMax() / time.Second)
+	var expiryStr = strconv.FormatInt(maxExpiry, 10)
+	if expiry < maxExpiry && expiry > 0 {
 		expiryStr = strconv.FormatInt(expiry, 10)
 	}
 
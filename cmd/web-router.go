@@ -17,15 +17,19 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"io/fs"
+	"net"
 	"net/http"
 
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 
 	"github.com/minio/minio/browser"
+	xhttp "github.com/minio/minio/cmd/http"
 	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/certs"
 	jsonrpc "github.com/minio/minio/pkg/rpc"
 	"github.com/minio/minio/pkg/rpc/json2"
 )
@@ -114,3 +118,45 @@ func registerWebRouter(router *mux.Router) error {
 
 	return nil
 }
+
+func newBrowserHTTPServerFn() *xhttp.Server {
+	globalObjLayerMutex.RLock()
+	defer globalObjLayerMutex.RUnlock()
+	return globalBrowserHTTPServer
+}
+
+// startBrowserServer - serves the web browser on its own address when
+// MINIO_BROWSER_ADDRESS is set, with its own certificate if one is
+// configured and with the certificates of the server otherwise.
+func startBrowserServer() {
+	if !globalBrowserEnabled || globalBrowserAddr == "" {
+		return
+	}
+
+	router := mux.NewRouter().SkipClean(true).UseEncodedPath()
+	logger.FatalIf(registerWebRouter(router), "Unable to configure web browser")
+	router.Use(globalHandlers...)
+
+	var getCert certs.GetCertificateFunc
+	switch {
+	case globalBrowserTLSCerts != nil:
+		getCert = globalBrowserTLSCerts.GetCertificate
+	case globalTLSCerts != nil:
+		getCert = globalTLSCerts.GetCertificate
+	}
+
+	host, port := mustSplitHostPort(globalBrowserAddr)
+	logger.FatalIf(checkPortAvailability(host, port), "Unable to start the web browser")
+
+	httpServer := xhttp.NewServer([]string{globalBrowserAddr}, criticalErrorHandler{corsHandler(router)}, getCert)
+	httpServer.BaseContext = func(listener net.Listener) context.Context {
+		return GlobalContext
+	}
+	go func() {
+		globalHTTPServerErrorCh <- httpServer.Start()
+	}()
+
+	globalObjLayerMutex.Lock()
+	globalBrowserHTTPServer = httpServer
+	globalObjLayerMutex.Unlock()
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	ewma "github.com/VividCortex/ewma"
+	"github.com/minio/minio/cmd/logger"
 	trace "github.com/minio/minio/pkg/trace"
 )
 
@@ -70,6 +71,7 @@ type xlStorageDiskIDCheck struct {
 	// please use `fieldalignment ./...` to check
 	// if your changes are not causing any problems.
 	storage      StorageAPI
+	stats        *driveStats
 	apiLatencies [storageMetricLast]ewma.MovingAverage
 	diskID       string
 	apiCalls     [storageMetricLast]uint64
@@ -86,6 +88,7 @@ func (p *xlStorageDiskIDCheck) getMetrics() DiskMetrics {
 	for i := range p.apiCalls {
 		diskMetric.APICalls[storageMetric(i).String()] = atomic.LoadUint64(&p.apiCalls[i])
 	}
+	p.stats.fill(&diskMetric, time.Now())
 	return diskMetric
 }
 
@@ -116,6 +119,7 @@ func (e *lockedSimpleEWMA) Value() float64 {
 func newXLStorageDiskIDCheck(storage *xlStorage) *xlStorageDiskIDCheck {
 	xl := xlStorageDiskIDCheck{
 		storage: storage,
+		stats:   newDriveStats(time.Now()),
 	}
 	for i := range xl.apiLatencies[:] {
 		xl.apiLatencies[i] = &lockedSimpleEWMA{
@@ -228,7 +232,7 @@ func (p *xlStorageDiskIDCheck) DiskInfo(ctx context.Context) (info DiskInfo, err
 }
 
 func (p *xlStorageDiskIDCheck) MakeVolBulk(ctx context.Context, volumes ...string) (err error) {
-	defer p.updateStorageMetrics(storageMetricMakeVolBulk, volumes...)()
+	defer p.updateStorageMetrics(ctx, storageMetricMakeVolBulk, volumes...)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -243,7 +247,7 @@ func (p *xlStorageDiskIDCheck) MakeVolBulk(ctx context.Context, volumes ...strin
 }
 
 func (p *xlStorageDiskIDCheck) MakeVol(ctx context.Context, volume string) (err error) {
-	defer p.updateStorageMetrics(storageMetricMakeVol, volume)()
+	defer p.updateStorageMetrics(ctx, storageMetricMakeVol, volume)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -257,8 +261,8 @@ func (p *xlStorageDiskIDCheck) MakeVol(ctx context.Context, volume string) (err
 	return p.storage.MakeVol(ctx, volume)
 }
 
-func (p *xlStorageDiskIDCheck) ListVols(ctx context.Context) ([]VolInfo, error) {
-	defer p.updateStorageMetrics(storageMetricListVols, "/")()
+func (p *xlStorageDiskIDCheck) ListVols(ctx context.Context) (vols []VolInfo, err error) {
+	defer p.updateStorageMetrics(ctx, storageMetricListVols, "/")(&err)
 
 	select {
 	case <-ctx.Done():
@@ -273,7 +277,7 @@ func (p *xlStorageDiskIDCheck) ListVols(ctx context.Context) ([]VolInfo, error)
 }
 
 func (p *xlStorageDiskIDCheck) StatVol(ctx context.Context, volume string) (vol VolInfo, err error) {
-	defer p.updateStorageMetrics(storageMetricStatVol, volume)()
+	defer p.updateStorageMetrics(ctx, storageMetricStatVol, volume)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -288,7 +292,7 @@ func (p *xlStorageDiskIDCheck) StatVol(ctx context.Context, volume string) (vol
 }
 
 func (p *xlStorageDiskIDCheck) DeleteVol(ctx context.Context, volume string, forceDelete bool) (err error) {
-	defer p.updateStorageMetrics(storageMetricDeleteVol, volume)()
+	defer p.updateStorageMetrics(ctx, storageMetricDeleteVol, volume)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -302,8 +306,8 @@ func (p *xlStorageDiskIDCheck) DeleteVol(ctx context.Context, volume string, for
 	return p.storage.DeleteVol(ctx, volume, forceDelete)
 }
 
-func (p *xlStorageDiskIDCheck) ListDir(ctx context.Context, volume, dirPath string, count int) ([]string, error) {
-	defer p.updateStorageMetrics(storageMetricListDir, volume, dirPath)()
+func (p *xlStorageDiskIDCheck) ListDir(ctx context.Context, volume, dirPath string, count int) (entries []string, err error) {
+	defer p.updateStorageMetrics(ctx, storageMetricListDir, volume, dirPath)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -319,7 +323,7 @@ func (p *xlStorageDiskIDCheck) ListDir(ctx context.Context, volume, dirPath stri
 }
 
 func (p *xlStorageDiskIDCheck) ReadFile(ctx context.Context, volume string, path string, offset int64, buf []byte, verifier *BitrotVerifier) (n int64, err error) {
-	defer p.updateStorageMetrics(storageMetricReadFile, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricReadFile, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -335,7 +339,7 @@ func (p *xlStorageDiskIDCheck) ReadFile(ctx context.Context, volume string, path
 }
 
 func (p *xlStorageDiskIDCheck) AppendFile(ctx context.Context, volume string, path string, buf []byte) (err error) {
-	defer p.updateStorageMetrics(storageMetricAppendFile, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricAppendFile, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -350,8 +354,8 @@ func (p *xlStorageDiskIDCheck) AppendFile(ctx context.Context, volume string, pa
 	return p.storage.AppendFile(ctx, volume, path, buf)
 }
 
-func (p *xlStorageDiskIDCheck) CreateFile(ctx context.Context, volume, path string, size int64, reader io.Reader) error {
-	defer p.updateStorageMetrics(storageMetricCreateFile, volume, path)()
+func (p *xlStorageDiskIDCheck) CreateFile(ctx context.Context, volume, path string, size int64, reader io.Reader) (err error) {
+	defer p.updateStorageMetrics(ctx, storageMetricCreateFile, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -366,8 +370,8 @@ func (p *xlStorageDiskIDCheck) CreateFile(ctx context.Context, volume, path stri
 	return p.storage.CreateFile(ctx, volume, path, size, reader)
 }
 
-func (p *xlStorageDiskIDCheck) ReadFileStream(ctx context.Context, volume, path string, offset, length int64) (io.ReadCloser, error) {
-	defer p.updateStorageMetrics(storageMetricReadFileStream, volume, path)()
+func (p *xlStorageDiskIDCheck) ReadFileStream(ctx context.Context, volume, path string, offset, length int64) (rc io.ReadCloser, err error) {
+	defer p.updateStorageMetrics(ctx, storageMetricReadFileStream, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -382,8 +386,8 @@ func (p *xlStorageDiskIDCheck) ReadFileStream(ctx context.Context, volume, path
 	return p.storage.ReadFileStream(ctx, volume, path, offset, length)
 }
 
-func (p *xlStorageDiskIDCheck) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolume, dstPath string) error {
-	defer p.updateStorageMetrics(storageMetricRenameFile, srcVolume, srcPath, dstVolume, dstPath)()
+func (p *xlStorageDiskIDCheck) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolume, dstPath string) (err error) {
+	defer p.updateStorageMetrics(ctx, storageMetricRenameFile, srcVolume, srcPath, dstVolume, dstPath)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -398,8 +402,8 @@ func (p *xlStorageDiskIDCheck) RenameFile(ctx context.Context, srcVolume, srcPat
 	return p.storage.RenameFile(ctx, srcVolume, srcPath, dstVolume, dstPath)
 }
 
-func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) error {
-	defer p.updateStorageMetrics(storageMetricRenameData, srcPath, fi.DataDir, dstVolume, dstPath)()
+func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) (err error) {
+	defer p.updateStorageMetrics(ctx, storageMetricRenameData, srcPath, fi.DataDir, dstVolume, dstPath)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -415,7 +419,7 @@ func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPat
 }
 
 func (p *xlStorageDiskIDCheck) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (err error) {
-	defer p.updateStorageMetrics(storageMetricCheckParts, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricCheckParts, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -431,7 +435,7 @@ func (p *xlStorageDiskIDCheck) CheckParts(ctx context.Context, volume string, pa
 }
 
 func (p *xlStorageDiskIDCheck) CheckFile(ctx context.Context, volume string, path string) (err error) {
-	defer p.updateStorageMetrics(storageMetricCheckFile, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricCheckFile, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -447,7 +451,7 @@ func (p *xlStorageDiskIDCheck) CheckFile(ctx context.Context, volume string, pat
 }
 
 func (p *xlStorageDiskIDCheck) Delete(ctx context.Context, volume string, path string, recursive bool) (err error) {
-	defer p.updateStorageMetrics(storageMetricDelete, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricDelete, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -471,7 +475,7 @@ func (p *xlStorageDiskIDCheck) DeleteVersions(ctx context.Context, volume string
 		path = versions[0].Name
 	}
 
-	defer p.updateStorageMetrics(storageMetricDeleteVersions, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricDeleteVersions, volume, path)(nil)
 
 	errs = make([]error, len(versions))
 
@@ -494,8 +498,8 @@ func (p *xlStorageDiskIDCheck) DeleteVersions(ctx context.Context, volume string
 	return p.storage.DeleteVersions(ctx, volume, versions)
 }
 
-func (p *xlStorageDiskIDCheck) VerifyFile(ctx context.Context, volume, path string, fi FileInfo) error {
-	defer p.updateStorageMetrics(storageMetricVerifyFile, volume, path)()
+func (p *xlStorageDiskIDCheck) VerifyFile(ctx context.Context, volume, path string, fi FileInfo) (err error) {
+	defer p.updateStorageMetrics(ctx, storageMetricVerifyFile, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -511,7 +515,7 @@ func (p *xlStorageDiskIDCheck) VerifyFile(ctx context.Context, volume, path stri
 }
 
 func (p *xlStorageDiskIDCheck) WriteAll(ctx context.Context, volume string, path string, b []byte) (err error) {
-	defer p.updateStorageMetrics(storageMetricWriteAll, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricWriteAll, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -527,7 +531,7 @@ func (p *xlStorageDiskIDCheck) WriteAll(ctx context.Context, volume string, path
 }
 
 func (p *xlStorageDiskIDCheck) DeleteVersion(ctx context.Context, volume, path string, fi FileInfo, forceDelMarker bool) (err error) {
-	defer p.updateStorageMetrics(storageMetricDeleteVersion, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricDeleteVersion, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -543,7 +547,7 @@ func (p *xlStorageDiskIDCheck) DeleteVersion(ctx context.Context, volume, path s
 }
 
 func (p *xlStorageDiskIDCheck) UpdateMetadata(ctx context.Context, volume, path string, fi FileInfo) (err error) {
-	defer p.updateStorageMetrics(storageMetricUpdateMetadata, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricUpdateMetadata, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -559,7 +563,7 @@ func (p *xlStorageDiskIDCheck) UpdateMetadata(ctx context.Context, volume, path
 }
 
 func (p *xlStorageDiskIDCheck) WriteMetadata(ctx context.Context, volume, path string, fi FileInfo) (err error) {
-	defer p.updateStorageMetrics(storageMetricWriteMetadata, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricWriteMetadata, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -575,7 +579,7 @@ func (p *xlStorageDiskIDCheck) WriteMetadata(ctx context.Context, volume, path s
 }
 
 func (p *xlStorageDiskIDCheck) ReadVersion(ctx context.Context, volume, path, versionID string, readData bool) (fi FileInfo, err error) {
-	defer p.updateStorageMetrics(storageMetricReadVersion, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricReadVersion, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -591,7 +595,7 @@ func (p *xlStorageDiskIDCheck) ReadVersion(ctx context.Context, volume, path, ve
 }
 
 func (p *xlStorageDiskIDCheck) ReadAll(ctx context.Context, volume string, path string) (buf []byte, err error) {
-	defer p.updateStorageMetrics(storageMetricReadAll, volume, path)()
+	defer p.updateStorageMetrics(ctx, storageMetricReadAll, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -619,16 +623,25 @@ func storageTrace(s storageMetric, startTime time.Time, duration time.Duration,
 	}
 }
 
-// Update storage metrics
-func (p *xlStorageDiskIDCheck) updateStorageMetrics(s storageMetric, paths ...string) func() {
+// Update storage metrics, err points to the error returned
+// by the call if it is to be accounted.
+func (p *xlStorageDiskIDCheck) updateStorageMetrics(ctx context.Context, s storageMetric, paths ...string) func(err *error) {
 	startTime := time.Now()
 	trace := globalTrace.NumSubscribers() > 0
-	return func() {
+	p.stats.start(startTime)
+	return func(err *error) {
 		duration := time.Since(startTime)
 
 		atomic.AddUint64(&p.apiCalls[s], 1)
 		p.apiLatencies[s].Add(float64(duration))
 
+		var callErr error
+		if err != nil {
+			callErr = *err
+		}
+		p.stats.done(s.driveOpKind(), duration, callErr, startTime.Add(duration))
+		logger.AddRequestTiming(ctx, logger.TimingDriveWait, startTime)
+
 		if trace {
 			globalTrace.Publish(storageTrace(s, startTime, duration, strings.Join(paths, " ")))
 		}
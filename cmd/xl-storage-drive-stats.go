@@ -0,0 +1,240 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Drive statistics are computed over the last minute, the
+// current window and the previous one are kept.
+const driveStatsWindow = time.Minute
+
+// Upper bounds of the latency buckets, the latency percentiles
+// are reported as the upper bound of the bucket they fall in.
+var driveLatencyBuckets = [...]time.Duration{
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// Percentiles reported for reads and writes.
+var driveLatencyPercentiles = []struct {
+	name string
+	p    float64
+}{
+	{"p50", 0.50},
+	{"p90", 0.90},
+	{"p99", 0.99},
+}
+
+// Errors which are answers rather than failures of the drive.
+var driveStatsIgnoredErrs = []error{
+	errFileNotFound,
+	errFileVersionNotFound,
+	errVolumeNotFound,
+	errVolumeExists,
+	errVolumeNotEmpty,
+	errPathNotFound,
+	errIsNotRegular,
+	errFileNameTooLong,
+	errDoneForNow,
+	context.Canceled,
+	context.DeadlineExceeded,
+}
+
+type driveOpKind uint8
+
+const (
+	driveOpOther driveOpKind = iota
+	driveOpRead
+	driveOpWrite
+)
+
+func (s storageMetric) driveOpKind() driveOpKind {
+	switch s {
+	case storageMetricReadFile, storageMetricReadFileStream, storageMetricReadVersion, storageMetricReadAll:
+		return driveOpRead
+	case storageMetricAppendFile, storageMetricCreateFile, storageMetricRenameFile, storageMetricRenameData,
+		storageMetricWriteAll, storageMetricWriteMetadata, storageMetricUpdateMetadata:
+		return driveOpWrite
+	}
+	return driveOpOther
+}
+
+// The last bucket counts the latencies above all bounds.
+type driveLatencyHistogram [len(driveLatencyBuckets) + 1]uint64
+
+func (h *driveLatencyHistogram) add(d time.Duration) {
+	for i, bound := range driveLatencyBuckets {
+		if d <= bound {
+			h[i]++
+			return
+		}
+	}
+	h[len(driveLatencyBuckets)]++
+}
+
+// percentile returns the upper bound of the bucket holding the
+// percentile p of the latencies of h and b, latencies above all
+// bounds are reported as the last bound.
+func (h *driveLatencyHistogram) percentile(b *driveLatencyHistogram, p float64) time.Duration {
+	var total uint64
+	for i := range h {
+		total += h[i] + b[i]
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := uint64(p*float64(total) + 0.5)
+	if rank == 0 {
+		rank = 1
+	}
+	var n uint64
+	for i, bound := range driveLatencyBuckets {
+		n += h[i] + b[i]
+		if n >= rank {
+			return bound
+		}
+	}
+	return driveLatencyBuckets[len(driveLatencyBuckets)-1]
+}
+
+type driveStatsWindowData struct {
+	read, write driveLatencyHistogram
+	busy        time.Duration
+}
+
+// driveStats tracks the latency, the queue depth, the utilization
+// and the errors of the calls to a drive.
+type driveStats struct {
+	mu        sync.Mutex
+	cur, prev driveStatsWindowData
+	curStart  time.Time
+	busySince time.Time
+	inFlight  uint64
+	errors    uint64
+	bitrot    uint64
+}
+
+func newDriveStats(now time.Time) *driveStats {
+	return &driveStats{curStart: now}
+}
+
+// addBusy accounts the time the drive was busy until now.
+func (d *driveStats) addBusy(now time.Time) {
+	if d.inFlight > 0 && now.After(d.busySince) {
+		d.cur.busy += now.Sub(d.busySince)
+		d.busySince = now
+	}
+}
+
+func (d *driveStats) rotate(now time.Time) {
+	elapsed := now.Sub(d.curStart)
+	if elapsed < driveStatsWindow {
+		return
+	}
+	d.addBusy(now)
+	d.prev = d.cur
+	if elapsed >= 2*driveStatsWindow {
+		// Nothing happened during the last window.
+		d.prev = driveStatsWindowData{}
+	}
+	d.cur = driveStatsWindowData{}
+	d.curStart = now
+}
+
+func (d *driveStats) start(now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rotate(now)
+	if d.inFlight == 0 {
+		d.busySince = now
+	}
+	d.inFlight++
+}
+
+func (d *driveStats) done(kind driveOpKind, duration time.Duration, err error, now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rotate(now)
+	d.addBusy(now)
+	d.inFlight--
+	switch kind {
+	case driveOpRead:
+		d.cur.read.add(duration)
+	case driveOpWrite:
+		d.cur.write.add(duration)
+	}
+	if err != nil && !IsErrIgnored(err, driveStatsIgnoredErrs...) {
+		d.errors++
+	}
+	if errors.Is(err, errFileCorrupt) {
+		d.bitrot++
+	}
+}
+
+// addBitrot accounts a bitrot error detected outside of the calls
+// to the drive, when verifying a stream read from the drive.
+func (d *driveStats) addBitrot() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.errors++
+	d.bitrot++
+}
+
+// fill sets the statistics of the last minute in m.
+func (d *driveStats) fill(m *DiskMetrics, now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rotate(now)
+	d.addBusy(now)
+
+	m.ReadLatencies = make(map[string]int64, len(driveLatencyPercentiles))
+	m.WriteLatencies = make(map[string]int64, len(driveLatencyPercentiles))
+	for _, p := range driveLatencyPercentiles {
+		m.ReadLatencies[p.name] = int64(d.cur.read.percentile(&d.prev.read, p.p))
+		m.WriteLatencies[p.name] = int64(d.cur.write.percentile(&d.prev.write, p.p))
+	}
+	if elapsed := now.Sub(d.curStart) + driveStatsWindow; elapsed > 0 {
+		m.Utilization = float64(d.cur.busy+d.prev.busy) / float64(elapsed) * 100
+		if m.Utilization > 100 {
+			m.Utilization = 100
+		}
+	}
+	m.QueueDepth = d.inFlight
+	m.TotalErrors = d.errors
+	m.BitrotErrors = d.bitrot
+}
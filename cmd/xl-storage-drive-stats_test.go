@@ -0,0 +1,94 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package cmd
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDriveLatencyHistogramPercentile(t *testing.T) {
+	var h, empty driveLatencyHistogram
+	if d := h.percentile(&empty, 0.5); d != 0 {
+		t.Fatalf("expected 0 for no latencies, got %s", d)
+	}
+
+	for i := 0; i < 90; i++ {
+		h.add(500 * time.Microsecond)
+	}
+	for i := 0; i < 9; i++ {
+		h.add(20 * time.Millisecond)
+	}
+	h.add(time.Minute)
+
+	testCases := []struct {
+		p        float64
+		expected time.Duration
+	}{
+		{0.5, time.Millisecond},
+		{0.9, time.Millisecond},
+		{0.95, 25 * time.Millisecond},
+		{0.99, 25 * time.Millisecond},
+		// Latencies above all bounds are reported as the last bound.
+		{1, 10 * time.Second},
+	}
+	for i, testCase := range testCases {
+		if d := h.percentile(&empty, testCase.p); d != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, d)
+		}
+	}
+}
+
+func TestDriveStats(t *testing.T) {
+	t0 := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	d := newDriveStats(t0)
+
+	// Two overlapping reads keep the drive busy for 30s.
+	d.start(t0)
+	d.start(t0.Add(10 * time.Second))
+	d.done(driveOpRead, 20*time.Second, nil, t0.Add(20*time.Second))
+	d.done(driveOpRead, 30*time.Second, errFileNotFound, t0.Add(30*time.Second))
+	d.start(t0.Add(40 * time.Second))
+	d.done(driveOpWrite, 3*time.Millisecond, errors.New("i/o error"), t0.Add(40*time.Second+3*time.Millisecond))
+	d.start(t0.Add(50 * time.Second))
+
+	var m DiskMetrics
+	d.fill(&m, t0.Add(time.Minute))
+	if m.QueueDepth != 1 {
+		t.Errorf("expected queue depth 1, got %d", m.QueueDepth)
+	}
+	if m.TotalErrors != 1 {
+		t.Errorf("expected 1 error, got %d", m.TotalErrors)
+	}
+	if m.ReadLatencies["p99"] != int64(10*time.Second) || m.WriteLatencies["p50"] != int64(5*time.Millisecond) {
+		t.Errorf("unexpected latencies %v %v", m.ReadLatencies, m.WriteLatencies)
+	}
+	// 30s + 3ms + 10s busy over the last minute.
+	if m.Utilization < 66 || m.Utilization > 67 {
+		t.Errorf("expected utilization of about 66%%, got %f", m.Utilization)
+	}
+
+	// Nothing happened for two windows.
+	d.done(driveOpOther, 10*time.Second, nil, t0.Add(time.Minute))
+	m = DiskMetrics{}
+	d.fill(&m, t0.Add(3*time.Minute))
+	if m.ReadLatencies["p50"] != 0 || m.Utilization != 0 || m.TotalErrors != 1 {
+		t.Errorf("unexpected metrics after idle windows %+v", m)
+	}
+}
@@ -112,6 +112,8 @@ const (
 	HighwayHash256S
 	// BLAKE2b512 represents the BLAKE2b-512 hash function
 	BLAKE2b512
+	// BLAKE3256S represents the Streaming BLAKE3-256 hash function
+	BLAKE3256S
 )
 
 // DefaultBitrotAlgorithm is the default algorithm used for bitrot protection.
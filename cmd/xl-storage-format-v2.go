@@ -160,13 +160,23 @@ type ChecksumAlgo uint8
 const (
 	invalidChecksumAlgo ChecksumAlgo = 0
 	HighwayHash         ChecksumAlgo = 1
-	lastChecksumAlgo    ChecksumAlgo = 2
+	BLAKE3              ChecksumAlgo = 2
+	lastChecksumAlgo    ChecksumAlgo = 3
 )
 
 func (e ChecksumAlgo) valid() bool {
 	return e > invalidChecksumAlgo && e < lastChecksumAlgo
 }
 
+// newChecksumAlgo returns the checksum algorithm of the object
+// version for the bitrot algorithm of its parts.
+func newChecksumAlgo(checksums []ChecksumInfo) ChecksumAlgo {
+	if len(checksums) > 0 && checksums[0].Algorithm == BLAKE3256S {
+		return BLAKE3
+	}
+	return HighwayHash
+}
+
 // xlMetaV2DeleteMarker defines the data struct for the delete marker journal type
 type xlMetaV2DeleteMarker struct {
 	VersionID [16]byte          `json:"ID" msg:"ID"`                               // Version ID for delete marker
@@ -805,7 +815,7 @@ func (z *xlMetaV2) AddVersion(fi FileInfo) error {
 			ErasureN:           fi.Erasure.ParityBlocks,
 			ErasureBlockSize:   fi.Erasure.BlockSize,
 			ErasureIndex:       fi.Erasure.Index,
-			BitrotChecksumAlgo: HighwayHash,
+			BitrotChecksumAlgo: newChecksumAlgo(fi.Erasure.Checksums),
 			ErasureDist:        make([]uint8, len(fi.Erasure.Distribution)),
 			PartNumbers:        make([]int, len(fi.Parts)),
 			PartETags:          make([]string, len(fi.Parts)),
@@ -937,6 +947,9 @@ func (j xlMetaV2Object) ToFileInfo(volume, path string) (FileInfo, error) {
 		case HighwayHash:
 			fi.Erasure.Checksums[i].Algorithm = HighwayHash256S
 			fi.Erasure.Checksums[i].Hash = []byte{}
+		case BLAKE3:
+			fi.Erasure.Checksums[i].Algorithm = BLAKE3256S
+			fi.Erasure.Checksums[i].Hash = []byte{}
 		default:
 			return FileInfo{}, fmt.Errorf("unknown BitrotChecksumAlgo: %v", j.BitrotChecksumAlgo)
 		}
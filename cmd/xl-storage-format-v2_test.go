@@ -148,3 +148,50 @@ func TestXLV2FormatData(t *testing.T) {
 		t.Fatal("metadata corruption not detected")
 	}
 }
+
+// Tests that the bitrot algorithm of object versions is kept in xl.meta.
+func TestXLV2BitrotAlgorithm(t *testing.T) {
+	for _, algo := range []BitrotAlgorithm{HighwayHash256S, BLAKE3256S} {
+		xl := xlMetaV2{}
+		fi := FileInfo{
+			Volume:    "volume",
+			Name:      "object",
+			VersionID: "756100c6-b393-4981-928a-d49bbc164741",
+			DataDir:   "bffea160-ca7f-465f-98bc-9b4f1c3ba1ef",
+			ModTime:   time.Now(),
+			Size:      10,
+			Parts:     []ObjectPartInfo{{Number: 1, Size: 10, ActualSize: 10}},
+			Erasure: ErasureInfo{
+				Algorithm:    ReedSolomon.String(),
+				DataBlocks:   4,
+				ParityBlocks: 2,
+				BlockSize:    10000,
+				Index:        1,
+				Distribution: []int{1, 2, 3, 4, 5, 6},
+				Checksums: []ChecksumInfo{{
+					PartNumber: 1,
+					Algorithm:  algo,
+				}},
+			},
+		}
+		if err := xl.AddVersion(fi); err != nil {
+			t.Fatal(err)
+		}
+
+		serialized, err := xl.AppendTo(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var xl2 xlMetaV2
+		if err = xl2.Load(serialized); err != nil {
+			t.Fatal(err)
+		}
+		got, err := xl2.ToFileInfo(fi.Volume, fi.Name, fi.VersionID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Erasure.GetChecksumInfo(1).Algorithm != algo {
+			t.Fatalf("want bitrot algorithm %v, got %v", algo, got.Erasure.GetChecksumInfo(1).Algorithm)
+		}
+	}
+}
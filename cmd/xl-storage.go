@@ -48,6 +48,7 @@ import (
 	"github.com/minio/minio/pkg/disk"
 	"github.com/minio/minio/pkg/env"
 	xioutil "github.com/minio/minio/pkg/ioutil"
+	"github.com/minio/minio/pkg/sync/errgroup"
 )
 
 const (
@@ -69,6 +70,10 @@ const (
 
 	// XL metadata file carries per object metadata.
 	xlStorageFormatFile = "xl.meta"
+
+	// Number of objects whose versions are deleted concurrently
+	// on a drive by a bulk delete.
+	deleteVersionsConcurrency = 4
 )
 
 var alignedBuf []byte
@@ -385,7 +390,7 @@ func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache) (dataUs
 		lc, err = globalLifecycleSys.Get(cache.Info.Name)
 		if err == nil && lc.HasActiveRules("", true) {
 			cache.Info.lifeCycle = lc
-			if intDataUpdateTracker.debug {
+			if debugLogEnabled(debugLogScanner) {
 				console.Debugln(color.Green("scannerDisk:") + " lifecycle: Active rules found")
 			}
 		}
@@ -408,7 +413,7 @@ func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache) (dataUs
 
 		buf, err := xioutil.ReadFile(item.Path)
 		if err != nil {
-			if intDataUpdateTracker.debug {
+			if debugLogEnabled(debugLogScanner) {
 				console.Debugf(color.Green("scannerBucket:")+" object path missing: %v: %w\n", item.Path, err)
 			}
 			return sizeSummary{}, errSkipFile
@@ -419,25 +424,37 @@ func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache) (dataUs
 
 		fivs, err := getFileInfoVersions(buf, item.bucket, item.objectPath())
 		if err != nil {
-			if intDataUpdateTracker.debug {
+			if debugLogEnabled(debugLogScanner) {
 				console.Debugf(color.Green("scannerBucket:")+" reading xl.meta failed: %v: %w\n", item.Path, err)
 			}
 			return sizeSummary{}, errSkipFile
 		}
 
 		var totalSize int64
+		var deleted int
 
 		sizeS := sizeSummary{}
 		for _, version := range fivs.Versions {
 			oi := version.ToObjectInfo(item.bucket, item.objectPath())
 			if objAPI != nil {
-				totalSize += item.applyActions(ctx, objAPI, actionMeta{
+				objDeleted, size := item.applyActions(ctx, objAPI, actionMeta{
 					oi:         oi,
 					bitRotScan: healOpts.Bitrot,
 				})
+				if objDeleted {
+					deleted++
+				}
+				totalSize += size
+				if !objDeleted {
+					sizeS.addVersion(ctx, oi, size)
+				}
 				item.healReplication(ctx, objAPI, oi.Clone(), &sizeS)
 			}
 		}
+		// The latest delete marker may have been left as the only version.
+		if n := len(fivs.Versions); objAPI != nil && n > 1 && deleted == n-1 {
+			item.applyExpiredDeleteMarker(ctx, objAPI, fivs.Versions[0].ToObjectInfo(item.bucket, item.objectPath()))
+		}
 		sizeS.totalSize = totalSize
 		return sizeS, nil
 	})
@@ -786,12 +803,162 @@ func (s *xlStorage) ListDir(ctx context.Context, volume, dirPath string, count i
 func (s *xlStorage) DeleteVersions(ctx context.Context, volume string, versions []FileInfo) []error {
 	errs := make([]error, len(versions))
 
+	// Group the versions by object, the versions of an object are
+	// deleted together with a single update of its metadata.
+	var names []string
+	indexes := make(map[string][]int)
 	for i, version := range versions {
-		if err := s.DeleteVersion(ctx, volume, version.Name, version, false); err != nil {
+		if _, ok := indexes[version.Name]; !ok {
+			names = append(names, version.Name)
+		}
+		indexes[version.Name] = append(indexes[version.Name], i)
+	}
+
+	g := errgroup.WithNErrs(len(names)).WithConcurrency(deleteVersionsConcurrency)
+	for index := range names {
+		index := index
+		g.Go(func() error {
+			idxs := indexes[names[index]]
+			fis := make([]FileInfo, len(idxs))
+			for i, idx := range idxs {
+				fis[i] = versions[idx]
+			}
+			for i, err := range s.deleteObjectVersions(ctx, volume, names[index], fis) {
+				errs[idxs[i]] = err
+			}
+			return nil
+		}, index)
+	}
+	g.Wait()
+
+	return errs
+}
+
+// deleteObjectVersions - deletes the versions of an object, in order,
+// reading and writing `xl.meta` only once.
+func (s *xlStorage) deleteObjectVersions(ctx context.Context, volume, path string, fis []FileInfo) []error {
+	errs := make([]error, len(fis))
+	if len(fis) == 1 || HasSuffix(path, SlashSeparator) {
+		for i := range fis {
+			errs[i] = s.DeleteVersion(ctx, volume, path, fis[i], false)
+		}
+		return errs
+	}
+
+	setErrs := func(err error) []error {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	buf, err := s.ReadAll(ctx, volume, pathJoin(path, xlStorageFormatFile))
+	if err != nil && err != errFileNotFound {
+		return setErrs(err)
+	}
+	if err == errFileNotFound || len(buf) == 0 {
+		for i := range fis {
+			errs[i] = errFileNotFound
+			if fis[i].VersionID != "" {
+				errs[i] = errFileVersionNotFound
+			}
+		}
+		return errs
+	}
+
+	if !isXL2V1Format(buf) {
+		// Legacy objects have a single version.
+		for i := range fis {
+			errs[i] = s.DeleteVersion(ctx, volume, path, fis[i], false)
+		}
+		return errs
+	}
+
+	volumeDir, err := s.getVolDir(volume)
+	if err != nil {
+		return setErrs(err)
+	}
+
+	var xlMeta xlMetaV2
+	if err = xlMeta.Load(buf); err != nil {
+		return setErrs(err)
+	}
+
+	var (
+		dataDirs []string
+		deleted  bool
+	)
+	for i, fi := range fis {
+		dataDir, _, err := xlMeta.DeleteVersion(fi)
+		if err != nil {
 			errs[i] = err
+			continue
+		}
+		deleted = true
+		// When object is pending transition, just update
+		// the metadata and avoid deleting data dir. Data
+		// dirs are not moved if no version is left, the
+		// whole object is.
+		if dataDir != "" && fi.TransitionStatus != lifecycle.TransitionPending {
+			versionID := fi.VersionID
+			if versionID == "" {
+				versionID = nullVersionID
+			}
+			xlMeta.data.remove(versionID)
+			xlMeta.data.remove(dataDir)
+			dataDirs = append(dataDirs, dataDir)
+		}
+	}
+	if !deleted {
+		return errs
+	}
+
+	// The errors of the versions deleted in memory are set
+	// if the result cannot be saved.
+	setDeletedErrs := func(err error) []error {
+		for i := range errs {
+			if errs[i] == nil {
+				errs[i] = err
+			}
+		}
+		return errs
+	}
+
+	if len(xlMeta.Versions) == 0 {
+		// Move everything to trash.
+		filePath := retainSlash(pathJoin(volumeDir, path))
+		if err = checkPathLength(filePath); err != nil {
+			return setDeletedErrs(err)
+		}
+		if err = renameAll(filePath, pathutil.Join(s.diskPath, minioMetaTmpDeletedBucket, mustGetUUID())); err != nil {
+			return setDeletedErrs(err)
+		}
+
+		// Delete parents if needed.
+		filePath = retainSlash(pathutil.Dir(pathJoin(volumeDir, path)))
+		if filePath != retainSlash(volumeDir) {
+			s.deleteFile(volumeDir, filePath, false)
 		}
+		return errs
 	}
 
+	for _, dataDir := range dataDirs {
+		filePath := pathJoin(volumeDir, path, dataDir)
+		if err = checkPathLength(filePath); err != nil {
+			return setDeletedErrs(err)
+		}
+		if err = renameAll(filePath, pathutil.Join(s.diskPath, minioMetaTmpDeletedBucket, mustGetUUID())); err != nil && err != errFileNotFound {
+			return setDeletedErrs(err)
+		}
+	}
+
+	buf, err = xlMeta.AppendTo(nil)
+	if err != nil {
+		return setDeletedErrs(err)
+	}
+	if err = s.WriteAll(ctx, volume, pathJoin(path, xlStorageFormatFile), buf); err != nil {
+		return setDeletedErrs(err)
+	}
 	return errs
 }
 
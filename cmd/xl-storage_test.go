@@ -1825,3 +1825,66 @@ func TestXLStorageVerifyFile(t *testing.T) {
 		t.Fatal("expected to fail bitrot check")
 	}
 }
+
+// TestXLStorageDeleteVersions - tests bulk deletes of several
+// versions of the same object.
+func TestXLStorageDeleteVersions(t *testing.T) {
+	xlStorage, path, err := newXLStorageTestSetup()
+	if err != nil {
+		t.Fatalf("Unable to create xlStorage test setup, %s", err)
+	}
+	defer os.RemoveAll(path)
+
+	volume := "success-vol"
+	if err = xlStorage.MakeVol(context.Background(), volume); err != nil {
+		t.Fatalf("Unable to create volume, %s", err)
+	}
+
+	versionIDs := []string{
+		"756100c6-b393-4981-928a-d49bbc164741",
+		"4b8e3c1a-0f36-4d4b-a1e2-7e0a6b1d2c3f",
+		"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+	}
+	newVersion := func(object, versionID string) FileInfo {
+		fi := newFileInfo(object, 4, 2)
+		fi.Volume = volume
+		fi.VersionID = versionID
+		fi.DataDir = mustGetUUID()
+		fi.ModTime = UTCNow()
+		return fi
+	}
+	for _, versionID := range versionIDs {
+		if err = xlStorage.WriteMetadata(context.Background(), volume, "object", newVersion("object", versionID)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = xlStorage.WriteMetadata(context.Background(), volume, "other", newVersion("other", versionIDs[0])); err != nil {
+		t.Fatal(err)
+	}
+
+	errs := xlStorage.DeleteVersions(context.Background(), volume, []FileInfo{
+		{Name: "object", VersionID: versionIDs[0]},
+		{Name: "other", VersionID: versionIDs[0]},
+		{Name: "object", VersionID: versionIDs[1]},
+		{Name: "object", VersionID: "1b8e3c1a-0f36-4d4b-a1e2-7e0a6b1d2c3f"},
+	})
+	expectedErrs := []error{nil, nil, nil, errFileVersionNotFound}
+	for i := range errs {
+		if errs[i] != expectedErrs[i] {
+			t.Errorf("Version %d: expected %v, got %v", i, expectedErrs[i], errs[i])
+		}
+	}
+
+	for i, versionID := range versionIDs {
+		_, err = xlStorage.ReadVersion(context.Background(), volume, "object", versionID, false)
+		if i < 2 && err != errFileVersionNotFound {
+			t.Errorf("Expected version %s to be deleted, got %v", versionID, err)
+		}
+		if i == 2 && err != nil {
+			t.Errorf("Expected version %s to be kept, got %v", versionID, err)
+		}
+	}
+	if _, err = os.Stat(slashpath.Join(path, volume, "other")); !os.IsNotExist(err) {
+		t.Errorf("Expected the object without versions left to be deleted, got %v", err)
+	}
+}
@@ -100,6 +100,7 @@ type Credentials struct {
 	Status       string    `xml:"-" json:"status,omitempty"`
 	ParentUser   string    `xml:"-" json:"parentUser,omitempty"`
 	Groups       []string  `xml:"-" json:"groups,omitempty"`
+	Tenant       string    `xml:"-" json:"tenant,omitempty"`
 }
 
 func (cred Credentials) String() string {
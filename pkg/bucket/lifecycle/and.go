@@ -22,16 +22,24 @@ import (
 
 var errDuplicateTagKey = Errorf("Duplicate Tag Keys are not allowed")
 
-// And - a tag to combine a prefix and multiple tags for lifecycle configuration rule.
+// And - a tag to combine a prefix, multiple tags and object size limits
+// for lifecycle configuration rule.
 type And struct {
-	XMLName xml.Name `xml:"And"`
-	Prefix  Prefix   `xml:"Prefix,omitempty"`
-	Tags    []Tag    `xml:"Tag,omitempty"`
+	XMLName               xml.Name `xml:"And"`
+	Prefix                Prefix   `xml:"Prefix,omitempty"`
+	Tags                  []Tag    `xml:"Tag,omitempty"`
+	ObjectSizeGreaterThan int64    `xml:"ObjectSizeGreaterThan,omitempty"`
+	ObjectSizeLessThan    int64    `xml:"ObjectSizeLessThan,omitempty"`
 }
 
-// isEmpty returns true if Tags field is null
+// isEmpty returns true if no field of And is set
 func (a And) isEmpty() bool {
-	return len(a.Tags) == 0 && !a.Prefix.set
+	return len(a.Tags) == 0 && !a.Prefix.set && !a.hasObjectSize()
+}
+
+// hasObjectSize returns true if an object size limit is set
+func (a And) hasObjectSize() bool {
+	return a.ObjectSizeGreaterThan != 0 || a.ObjectSizeLessThan != 0
 }
 
 // Validate - validates the And field
@@ -39,14 +47,22 @@ func (a And) Validate() error {
 	emptyPrefix := !a.Prefix.set
 	emptyTags := len(a.Tags) == 0
 
-	if emptyPrefix && emptyTags {
+	if emptyPrefix && emptyTags && !a.hasObjectSize() {
 		return nil
 	}
 
-	if emptyPrefix && !emptyTags || !emptyPrefix && emptyTags {
+	// Without object size limits, And combines a prefix and tags.
+	if !a.hasObjectSize() && (emptyPrefix && !emptyTags || !emptyPrefix && emptyTags) {
 		return errXMLNotWellFormed
 	}
 
+	if a.ObjectSizeGreaterThan < 0 || a.ObjectSizeLessThan < 0 {
+		return errInvalidObjectSize
+	}
+	if a.ObjectSizeLessThan > 0 && a.ObjectSizeLessThan <= a.ObjectSizeGreaterThan {
+		return errInvalidObjectSize
+	}
+
 	if a.ContainsDuplicateTag() {
 		return errDuplicateTagKey
 	}
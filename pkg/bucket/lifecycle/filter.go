@@ -22,7 +22,8 @@ import (
 )
 
 var (
-	errInvalidFilter = Errorf("Filter must have exactly one of Prefix, Tag, or And specified")
+	errInvalidFilter     = Errorf("Filter must have exactly one of Prefix, Tag, ObjectSizeGreaterThan, ObjectSizeLessThan or And specified")
+	errInvalidObjectSize = Errorf("ObjectSizeLessThan must be greater than ObjectSizeGreaterThan and object sizes must not be negative")
 )
 
 // Filter - a filter for a lifecycle configuration Rule.
@@ -37,12 +38,18 @@ type Filter struct {
 
 	Tag    Tag
 	tagSet bool
+
+	// Object size limits in bytes, zero when not set.
+	ObjectSizeGreaterThan int64
+	ObjectSizeLessThan    int64
+
 	// Caching tags, only once
 	cachedTags []string
 }
 
 // MarshalXML - produces the xml representation of the Filter struct
-// only one of Prefix, And and Tag should be present in the output.
+// only one of Prefix, And, Tag and the object size limits should be
+// present in the output.
 func (f Filter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if err := e.EncodeToken(start); err != nil {
 		return err
@@ -57,6 +64,14 @@ func (f Filter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		if err := e.EncodeElement(f.Tag, xml.StartElement{Name: xml.Name{Local: "Tag"}}); err != nil {
 			return err
 		}
+	case f.ObjectSizeGreaterThan > 0:
+		if err := e.EncodeElement(f.ObjectSizeGreaterThan, xml.StartElement{Name: xml.Name{Local: "ObjectSizeGreaterThan"}}); err != nil {
+			return err
+		}
+	case f.ObjectSizeLessThan > 0:
+		if err := e.EncodeElement(f.ObjectSizeLessThan, xml.StartElement{Name: xml.Name{Local: "ObjectSizeLessThan"}}); err != nil {
+			return err
+		}
 	default:
 		// Always print Prefix field when both And & Tag are empty
 		if err := e.EncodeElement(f.Prefix, xml.StartElement{Name: xml.Name{Local: "Prefix"}}); err != nil {
@@ -103,6 +118,18 @@ func (f *Filter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error
 				}
 				f.Tag = tag
 				f.tagSet = true
+			case "ObjectSizeGreaterThan":
+				var size int64
+				if err = d.DecodeElement(&size, &se); err != nil {
+					return err
+				}
+				f.ObjectSizeGreaterThan = size
+			case "ObjectSizeLessThan":
+				var size int64
+				if err = d.DecodeElement(&size, &se); err != nil {
+					return err
+				}
+				f.ObjectSizeLessThan = size
 			default:
 				return errUnknownXMLTag
 			}
@@ -121,27 +148,26 @@ func (f Filter) Validate() error {
 	if f.IsEmpty() {
 		return errXMLNotWellFormed
 	}
-	// A Filter must have exactly one of Prefix, Tag, or And specified.
-	if !f.And.isEmpty() {
-		if f.Prefix.set {
-			return errInvalidFilter
-		}
-		if !f.Tag.IsEmpty() {
-			return errInvalidFilter
+	// A Filter must have exactly one of Prefix, Tag, ObjectSizeGreaterThan,
+	// ObjectSizeLessThan or And specified.
+	var n int
+	for _, set := range []bool{f.Prefix.set, !f.Tag.IsEmpty(), f.ObjectSizeGreaterThan != 0, f.ObjectSizeLessThan != 0, !f.And.isEmpty()} {
+		if set {
+			n++
 		}
+	}
+	if n > 1 {
+		return errInvalidFilter
+	}
+	if f.ObjectSizeGreaterThan < 0 || f.ObjectSizeLessThan < 0 {
+		return errInvalidObjectSize
+	}
+	if !f.And.isEmpty() {
 		if err := f.And.Validate(); err != nil {
 			return err
 		}
 	}
-	if f.Prefix.set {
-		if !f.Tag.IsEmpty() {
-			return errInvalidFilter
-		}
-	}
 	if !f.Tag.IsEmpty() {
-		if f.Prefix.set {
-			return errInvalidFilter
-		}
 		if err := f.Tag.Validate(); err != nil {
 			return err
 		}
@@ -175,3 +201,19 @@ func (f Filter) TestTags(tags []string) bool {
 	}
 	return true
 }
+
+// BySize returns true if sz satisfies the object size limits of the
+// Filter, it returns true if there is no limit in the underlying Filter.
+func (f Filter) BySize(sz int64) bool {
+	greaterThan, lessThan := f.ObjectSizeGreaterThan, f.ObjectSizeLessThan
+	if !f.And.isEmpty() {
+		greaterThan, lessThan = f.And.ObjectSizeGreaterThan, f.And.ObjectSizeLessThan
+	}
+	if greaterThan > 0 && sz <= greaterThan {
+		return false
+	}
+	if lessThan > 0 && sz >= lessThan {
+		return false
+	}
+	return true
+}
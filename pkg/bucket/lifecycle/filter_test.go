@@ -108,6 +108,61 @@ func TestUnsupportedFilters(t *testing.T) {
 						</Filter>`,
 			expectedErr: errInvalidFilter,
 		},
+		{ // Filter with ObjectSizeGreaterThan tag
+			inputXML: ` <Filter>
+							<ObjectSizeGreaterThan>1048576</ObjectSizeGreaterThan>
+						</Filter>`,
+			expectedErr: nil,
+		},
+		{ // Filter without And, Prefix and ObjectSizeLessThan tags
+			inputXML: ` <Filter>
+							<Prefix>key-prefix</Prefix>
+							<ObjectSizeLessThan>1048576</ObjectSizeLessThan>
+						</Filter>`,
+			expectedErr: errInvalidFilter,
+		},
+		{ // Filter without And and both object size tags
+			inputXML: ` <Filter>
+							<ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan>
+							<ObjectSizeLessThan>1048576</ObjectSizeLessThan>
+						</Filter>`,
+			expectedErr: errInvalidFilter,
+		},
+		{ // Filter with negative ObjectSizeLessThan tag
+			inputXML: ` <Filter>
+							<ObjectSizeLessThan>-1</ObjectSizeLessThan>
+						</Filter>`,
+			expectedErr: errInvalidObjectSize,
+		},
+		{ // Filter with And, Prefix & object size tags
+			inputXML: ` <Filter>
+							<And>
+							<Prefix>key-prefix</Prefix>
+							<ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan>
+							<ObjectSizeLessThan>1048576</ObjectSizeLessThan>
+							</And>
+						</Filter>`,
+			expectedErr: nil,
+		},
+		{ // Filter with And and object size tags only
+			inputXML: ` <Filter>
+							<And>
+							<ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan>
+							<ObjectSizeLessThan>1048576</ObjectSizeLessThan>
+							</And>
+						</Filter>`,
+			expectedErr: nil,
+		},
+		{ // Filter with And and ObjectSizeLessThan not greater than ObjectSizeGreaterThan
+			inputXML: ` <Filter>
+							<And>
+							<Prefix>key-prefix</Prefix>
+							<ObjectSizeGreaterThan>1048576</ObjectSizeGreaterThan>
+							<ObjectSizeLessThan>1024</ObjectSizeLessThan>
+							</And>
+						</Filter>`,
+			expectedErr: errInvalidObjectSize,
+		},
 	}
 	for i, tc := range testCases {
 		t.Run(fmt.Sprintf("Test %d", i+1), func(t *testing.T) {
@@ -123,3 +178,58 @@ func TestUnsupportedFilters(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterBySize(t *testing.T) {
+	testCases := []struct {
+		inputXML string
+		size     int64
+		expected bool
+	}{
+		{
+			inputXML: `<Filter><Prefix>key-prefix</Prefix></Filter>`,
+			size:     0,
+			expected: true,
+		},
+		{
+			inputXML: `<Filter><ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan></Filter>`,
+			size:     1024,
+			expected: false,
+		},
+		{
+			inputXML: `<Filter><ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan></Filter>`,
+			size:     1025,
+			expected: true,
+		},
+		{
+			inputXML: `<Filter><ObjectSizeLessThan>1024</ObjectSizeLessThan></Filter>`,
+			size:     1024,
+			expected: false,
+		},
+		{
+			inputXML: `<Filter><ObjectSizeLessThan>1024</ObjectSizeLessThan></Filter>`,
+			size:     0,
+			expected: true,
+		},
+		{
+			inputXML: `<Filter><And><Prefix>key-prefix</Prefix><ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan><ObjectSizeLessThan>4096</ObjectSizeLessThan></And></Filter>`,
+			size:     2048,
+			expected: true,
+		},
+		{
+			inputXML: `<Filter><And><Prefix>key-prefix</Prefix><ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan><ObjectSizeLessThan>4096</ObjectSizeLessThan></And></Filter>`,
+			size:     4096,
+			expected: false,
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Test %d", i+1), func(t *testing.T) {
+			var filter Filter
+			if err := xml.Unmarshal([]byte(tc.inputXML), &filter); err != nil {
+				t.Fatalf("%d: Expected no error but got %v", i+1, err)
+			}
+			if got := filter.BySize(tc.size); got != tc.expected {
+				t.Fatalf("%d: Expected %v but got %v", i+1, tc.expected, got)
+			}
+		})
+	}
+}
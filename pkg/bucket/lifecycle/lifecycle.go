@@ -136,6 +136,9 @@ func (lc Lifecycle) HasActiveRules(prefix string, recursive bool) bool {
 		if rule.NoncurrentVersionTransition.NoncurrentDays > 0 {
 			return true
 		}
+		if rule.Expiration.DeleteMarker.val {
+			return true
+		}
 		if rule.Expiration.IsNull() && rule.Transition.IsNull() {
 			continue
 		}
@@ -145,7 +148,21 @@ func (lc Lifecycle) HasActiveRules(prefix string, recursive bool) bool {
 		if !rule.Transition.IsDateNull() && rule.Transition.Date.Before(time.Now()) {
 			return true
 		}
-		if !rule.Expiration.IsDaysNull() || !rule.Transition.IsDaysNull() {
+		if !rule.Expiration.IsDaysNull() || !rule.Transition.IsDaysNull() || !rule.Transition.IsDaysSinceLastAccessNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// TracksAccessTime - returns whether the transition of the object
+// depends on the time it was last accessed.
+func (lc Lifecycle) TracksAccessTime(object string) bool {
+	for _, rule := range lc.Rules {
+		if rule.Status == Disabled {
+			continue
+		}
+		if strings.HasPrefix(object, rule.GetPrefix()) && !rule.Transition.IsDaysSinceLastAccessNull() {
 			return true
 		}
 	}
@@ -206,6 +223,11 @@ func (lc Lifecycle) FilterActionableRules(obj ObjectOpts) []Rule {
 		if !strings.HasPrefix(obj.Name, rule.GetPrefix()) {
 			continue
 		}
+		// Delete markers have no size, the object size limits
+		// only apply to objects.
+		if !obj.DeleteMarker && !rule.Filter.BySize(obj.Size) {
+			continue
+		}
 		// Indicates whether MinIO will remove a delete marker with no
 		// noncurrent versions. If set to true, the delete marker will
 		// be expired; if set to false the policy takes no action. This
@@ -245,6 +267,7 @@ func (lc Lifecycle) FilterActionableRules(obj ObjectOpts) []Rule {
 type ObjectOpts struct {
 	Name             string
 	UserTags         string
+	Size             int64
 	ModTime          time.Time
 	VersionID        string
 	IsLatest         bool
@@ -254,6 +277,12 @@ type ObjectOpts struct {
 	TransitionStatus string
 	RestoreOngoing   bool
 	RestoreExpires   time.Time
+	// AccessTime is the time the object was last read, the
+	// modification time is used when it is older or unknown.
+	AccessTime time.Time
+	// ExpiresAt is the time the object version was set to
+	// expire at when it was written, zero if it was not.
+	ExpiresAt time.Time
 }
 
 // ExpiredObjectDeleteMarker returns true if an object version referred to by o
@@ -263,6 +292,14 @@ func (o ObjectOpts) ExpiredObjectDeleteMarker() bool {
 	return o.DeleteMarker && o.NumVersions == 1
 }
 
+// lastAccessTime returns the time the object was last read or written.
+func (o ObjectOpts) lastAccessTime() time.Time {
+	if o.AccessTime.After(o.ModTime) {
+		return o.AccessTime
+	}
+	return o.ModTime
+}
+
 // ComputeAction returns the action to perform by evaluating all lifecycle rules
 // against the object name and its modification time.
 func (lc Lifecycle) ComputeAction(obj ObjectOpts) Action {
@@ -271,6 +308,15 @@ func (lc Lifecycle) ComputeAction(obj ObjectOpts) Action {
 		return action
 	}
 
+	// Object versions written with their own expiration are
+	// removed once it is reached, regardless of the rules.
+	if !obj.ExpiresAt.IsZero() && !obj.DeleteMarker && time.Now().After(obj.ExpiresAt) {
+		if obj.VersionID != "" {
+			return DeleteVersionAction
+		}
+		return DeleteAction
+	}
+
 	for _, rule := range lc.FilterActionableRules(obj) {
 		if obj.ExpiredObjectDeleteMarker() && rule.Expiration.DeleteMarker.val {
 			// Indicates whether MinIO will remove a delete marker with no noncurrent versions.
@@ -334,6 +380,10 @@ func (lc Lifecycle) ComputeAction(obj ObjectOpts) Action {
 					if time.Now().UTC().After(ExpectedExpiryTime(obj.ModTime, int(rule.Transition.Days))) {
 						action = TransitionAction
 					}
+				case !rule.Transition.IsDaysSinceLastAccessNull():
+					if time.Now().UTC().After(ExpectedExpiryTime(obj.lastAccessTime(), int(rule.Transition.DaysSinceLastAccess))) {
+						action = TransitionAction
+					}
 				}
 			}
 			if !obj.RestoreExpires.IsZero() && time.Now().After(obj.RestoreExpires) {
@@ -98,6 +98,18 @@ func TestParseAndValidateLifecycleConfig(t *testing.T) {
 			expectedParsingErr:    nil,
 			expectedValidationErr: nil,
 		},
+		// Lifecycle with a transition based on the last access
+		{
+			inputConfig:           `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Rule><ID>rule</ID><Filter></Filter><Status>Enabled</Status><Transition><DaysSinceLastAccess>30</DaysSinceLastAccess><StorageClass>WARM</StorageClass></Transition></Rule></LifecycleConfiguration>`,
+			expectedParsingErr:    nil,
+			expectedValidationErr: nil,
+		},
+		// Lifecycle with a transition based on both the last access and the creation
+		{
+			inputConfig:           `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Rule><ID>rule</ID><Filter></Filter><Status>Enabled</Status><Transition><Days>10</Days><DaysSinceLastAccess>30</DaysSinceLastAccess><StorageClass>WARM</StorageClass></Transition></Rule></LifecycleConfiguration>`,
+			expectedParsingErr:    nil,
+			expectedValidationErr: errTransitionInvalidAccess,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -204,11 +216,13 @@ func TestExpectedExpiryTime(t *testing.T) {
 
 func TestComputeActions(t *testing.T) {
 	testCases := []struct {
-		inputConfig    string
-		objectName     string
-		objectTags     string
-		objectModTime  time.Time
-		expectedAction Action
+		inputConfig      string
+		objectName       string
+		objectTags       string
+		objectSize       int64
+		objectModTime    time.Time
+		objectAccessTime time.Time
+		expectedAction   Action
 	}{
 		// Empty object name (unexpected case) should always return NoneAction
 		{
@@ -342,6 +356,60 @@ func TestComputeActions(t *testing.T) {
 			objectModTime:  time.Now().UTC().Add(-24 * time.Hour), // Created 1 day ago
 			expectedAction: DeleteAction,
 		},
+		// Should remove - object is larger than ObjectSizeGreaterThan and is expired based on specified Days
+		{
+			inputConfig:    `<LifecycleConfiguration><Rule><Filter><ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan></Filter><Status>Enabled</Status><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`,
+			objectName:     "foodir/fooobject",
+			objectSize:     2048,
+			objectModTime:  time.Now().UTC().Add(-48 * time.Hour), // Created 2 days ago
+			expectedAction: DeleteAction,
+		},
+		// Should not remove - object is not smaller than ObjectSizeLessThan
+		{
+			inputConfig:    `<LifecycleConfiguration><Rule><Filter><And><Prefix>foodir/</Prefix><ObjectSizeLessThan>1024</ObjectSizeLessThan></And></Filter><Status>Enabled</Status><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`,
+			objectName:     "foodir/fooobject",
+			objectSize:     1024,
+			objectModTime:  time.Now().UTC().Add(-48 * time.Hour), // Created 2 days ago
+			expectedAction: NoneAction,
+		},
+		// Should transition - object size is within the limits and is expired based on specified Days
+		{
+			inputConfig:    `<LifecycleConfiguration><Rule><Filter><And><Prefix>foodir/</Prefix><ObjectSizeGreaterThan>1024</ObjectSizeGreaterThan><ObjectSizeLessThan>4096</ObjectSizeLessThan></And></Filter><Status>Enabled</Status><Transition><Days>1</Days><StorageClass>WARM</StorageClass></Transition></Rule></LifecycleConfiguration>`,
+			objectName:     "foodir/fooobject",
+			objectSize:     2048,
+			objectModTime:  time.Now().UTC().Add(-48 * time.Hour), // Created 2 days ago
+			expectedAction: TransitionAction,
+		},
+		// Should transition - object was not accessed since it was created
+		{
+			inputConfig:    `<LifecycleConfiguration><Rule><Filter><Prefix>foodir/</Prefix></Filter><Status>Enabled</Status><Transition><DaysSinceLastAccess>30</DaysSinceLastAccess><StorageClass>WARM</StorageClass></Transition></Rule></LifecycleConfiguration>`,
+			objectName:     "foodir/fooobject",
+			objectModTime:  time.Now().UTC().Add(-60 * 24 * time.Hour), // Created 60 days ago
+			expectedAction: TransitionAction,
+		},
+		// Should not transition - object is old but was accessed recently
+		{
+			inputConfig:      `<LifecycleConfiguration><Rule><Filter><Prefix>foodir/</Prefix></Filter><Status>Enabled</Status><Transition><DaysSinceLastAccess>30</DaysSinceLastAccess><StorageClass>WARM</StorageClass></Transition></Rule></LifecycleConfiguration>`,
+			objectName:       "foodir/fooobject",
+			objectModTime:    time.Now().UTC().Add(-60 * 24 * time.Hour), // Created 60 days ago
+			objectAccessTime: time.Now().UTC().Add(-2 * 24 * time.Hour),  // Accessed 2 days ago
+			expectedAction:   NoneAction,
+		},
+		// Should transition - object was last accessed long ago
+		{
+			inputConfig:      `<LifecycleConfiguration><Rule><Filter><Prefix>foodir/</Prefix></Filter><Status>Enabled</Status><Transition><DaysSinceLastAccess>30</DaysSinceLastAccess><StorageClass>WARM</StorageClass></Transition></Rule></LifecycleConfiguration>`,
+			objectName:       "foodir/fooobject",
+			objectModTime:    time.Now().UTC().Add(-90 * 24 * time.Hour), // Created 90 days ago
+			objectAccessTime: time.Now().UTC().Add(-40 * 24 * time.Hour), // Accessed 40 days ago
+			expectedAction:   TransitionAction,
+		},
+		// Should not transition - object was created recently
+		{
+			inputConfig:    `<LifecycleConfiguration><Rule><Filter><Prefix>foodir/</Prefix></Filter><Status>Enabled</Status><Transition><DaysSinceLastAccess>30</DaysSinceLastAccess><StorageClass>WARM</StorageClass></Transition></Rule></LifecycleConfiguration>`,
+			objectName:     "foodir/fooobject",
+			objectModTime:  time.Now().UTC().Add(-10 * 24 * time.Hour), // Created 10 days ago
+			expectedAction: NoneAction,
+		},
 		// Should accept BucketLifecycleConfiguration root tag
 		{
 			inputConfig:    `<BucketLifecycleConfiguration><Rule><Filter><Prefix>foodir/</Prefix></Filter><Status>Enabled</Status><Expiration><Date>` + time.Now().Truncate(24*time.Hour).UTC().Add(-24*time.Hour).Format(time.RFC3339) + `</Date></Expiration></Rule></BucketLifecycleConfiguration>`,
@@ -359,10 +427,12 @@ func TestComputeActions(t *testing.T) {
 				t.Fatalf("Got unexpected error: %v", err)
 			}
 			if resultAction := lc.ComputeAction(ObjectOpts{
-				Name:     tc.objectName,
-				UserTags: tc.objectTags,
-				ModTime:  tc.objectModTime,
-				IsLatest: true,
+				Name:       tc.objectName,
+				UserTags:   tc.objectTags,
+				Size:       tc.objectSize,
+				ModTime:    tc.objectModTime,
+				AccessTime: tc.objectAccessTime,
+				IsLatest:   true,
 			}); resultAction != tc.expectedAction {
 				t.Fatalf("Expected action: `%v`, got: `%v`", tc.expectedAction, resultAction)
 			}
@@ -371,6 +441,120 @@ func TestComputeActions(t *testing.T) {
 	}
 }
 
+func TestObjectExpiresAt(t *testing.T) {
+	now := time.Now().UTC()
+	testCases := []struct {
+		expiresAt      time.Time
+		versionID      string
+		deleteMarker   bool
+		expectedAction Action
+	}{
+		// No expiration set
+		{
+			expectedAction: NoneAction,
+		},
+		// Not expired yet
+		{
+			expiresAt:      now.Add(time.Hour),
+			expectedAction: NoneAction,
+		},
+		// Expired object
+		{
+			expiresAt:      now.Add(-time.Minute),
+			expectedAction: DeleteAction,
+		},
+		// Expired object version
+		{
+			expiresAt:      now.Add(-time.Minute),
+			versionID:      "0b3a2a23-4d5e-4c8b-a2a2-8c2ea6a1a9cc",
+			expectedAction: DeleteVersionAction,
+		},
+		// Delete markers do not expire
+		{
+			expiresAt:      now.Add(-time.Minute),
+			deleteMarker:   true,
+			expectedAction: NoneAction,
+		},
+	}
+
+	for i, tc := range testCases {
+		// Applies without any rule.
+		if action := (Lifecycle{}).ComputeAction(ObjectOpts{
+			Name:         "foodir/fooobject",
+			ModTime:      now.Add(-time.Hour),
+			VersionID:    tc.versionID,
+			IsLatest:     true,
+			DeleteMarker: tc.deleteMarker,
+			ExpiresAt:    tc.expiresAt,
+		}); action != tc.expectedAction {
+			t.Fatalf("Test %d: expected action: `%v`, got: `%v`", i+1, tc.expectedAction, action)
+		}
+	}
+}
+
+func TestExpiredObjectDeleteMarker(t *testing.T) {
+	const inputConfig = `<LifecycleConfiguration><Rule><Filter><Prefix>foodir/</Prefix></Filter><Status>Enabled</Status><Expiration><ExpiredObjectDeleteMarker>true</ExpiredObjectDeleteMarker></Expiration></Rule></LifecycleConfiguration>`
+	testCases := []struct {
+		objectName     string
+		deleteMarker   bool
+		isLatest       bool
+		numVersions    int
+		expectedAction Action
+	}{
+		// Only remaining version is a delete marker
+		{
+			objectName:     "foodir/fooobject",
+			deleteMarker:   true,
+			isLatest:       true,
+			numVersions:    1,
+			expectedAction: DeleteVersionAction,
+		},
+		// Delete marker with noncurrent versions
+		{
+			objectName:     "foodir/fooobject",
+			deleteMarker:   true,
+			isLatest:       true,
+			numVersions:    3,
+			expectedAction: NoneAction,
+		},
+		// Only remaining version is not a delete marker
+		{
+			objectName:     "foodir/fooobject",
+			isLatest:       true,
+			numVersions:    1,
+			expectedAction: NoneAction,
+		},
+		// Prefix not matched
+		{
+			objectName:     "foxdir/fooobject",
+			deleteMarker:   true,
+			isLatest:       true,
+			numVersions:    1,
+			expectedAction: NoneAction,
+		},
+	}
+
+	lc, err := ParseLifecycleConfig(bytes.NewReader([]byte(inputConfig)))
+	if err != nil {
+		t.Fatalf("Got unexpected error: %v", err)
+	}
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("Test_%d", i+1), func(t *testing.T) {
+			if resultAction := lc.ComputeAction(ObjectOpts{
+				Name:         tc.objectName,
+				ModTime:      time.Now().UTC().Add(-24 * time.Hour),
+				VersionID:    "0bc5cfd4-0ae0-4d47-9b7a-7e4f0f6b0f4c",
+				DeleteMarker: tc.deleteMarker,
+				IsLatest:     tc.isLatest,
+				NumVersions:  tc.numVersions,
+			}); resultAction != tc.expectedAction {
+				t.Fatalf("Expected action: `%v`, got: `%v`", tc.expectedAction, resultAction)
+			}
+		})
+	}
+}
+
 func TestHasActiveRules(t *testing.T) {
 	testCases := []struct {
 		inputConfig    string
@@ -408,6 +592,11 @@ func TestHasActiveRules(t *testing.T) {
 			prefix:         "foodir/foobject",
 			expectedNonRec: false, expectedRec: false,
 		},
+		{ // expired object delete markers only
+			inputConfig:    `<LifecycleConfiguration><Rule><Filter><Prefix>foodir/</Prefix></Filter><Status>Enabled</Status><Expiration><ExpiredObjectDeleteMarker>true</ExpiredObjectDeleteMarker></Expiration></Rule></LifecycleConfiguration>`,
+			prefix:         "foodir/foobject",
+			expectedNonRec: true, expectedRec: true,
+		},
 	}
 
 	for i, tc := range testCases {
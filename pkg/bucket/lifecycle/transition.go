@@ -25,6 +25,7 @@ var (
 	errTransitionInvalidDays     = Errorf("Days must be 0 or greater when used with Transition")
 	errTransitionInvalidDate     = Errorf("Date must be provided in ISO 8601 format")
 	errTransitionInvalid         = Errorf("Exactly one of Days (0 or greater) or Date (positive ISO 8601 format) should be present inside Expiration.")
+	errTransitionInvalidAccess   = Errorf("DaysSinceLastAccess cannot be specified with Days or Date in Transition")
 	errTransitionDateNotMidnight = Errorf("'Date' must be at midnight GMT")
 )
 
@@ -97,11 +98,14 @@ func (tDays TransitionDays) MarshalXML(e *xml.Encoder, startElement xml.StartEle
 }
 
 // Transition - transition actions for a rule in lifecycle configuration.
+// DaysSinceLastAccess is a MinIO extension which transitions objects
+// that were not read for the given number of days.
 type Transition struct {
-	XMLName      xml.Name       `xml:"Transition"`
-	Days         TransitionDays `xml:"Days,omitempty"`
-	Date         TransitionDate `xml:"Date,omitempty"`
-	StorageClass string         `xml:"StorageClass,omitempty"`
+	XMLName             xml.Name       `xml:"Transition"`
+	Days                TransitionDays `xml:"Days,omitempty"`
+	Date                TransitionDate `xml:"Date,omitempty"`
+	DaysSinceLastAccess TransitionDays `xml:"DaysSinceLastAccess,omitempty"`
+	StorageClass        string         `xml:"StorageClass,omitempty"`
 
 	set bool
 }
@@ -134,10 +138,14 @@ func (t Transition) Validate() error {
 		return nil
 	}
 
-	if t.IsDaysNull() && t.IsDateNull() {
+	if t.IsNull() {
 		return errXMLNotWellFormed
 	}
 
+	if !t.IsDaysSinceLastAccessNull() && (!t.IsDaysNull() || !t.IsDateNull()) {
+		return errTransitionInvalidAccess
+	}
+
 	// Both transition days and date are specified
 	if !t.IsDaysNull() && !t.IsDateNull() {
 		return errTransitionInvalid
@@ -158,7 +166,12 @@ func (t Transition) IsDateNull() bool {
 	return t.Date.Time.IsZero()
 }
 
-// IsNull returns true if both date and days fields are null
+// IsDaysSinceLastAccessNull returns true if days since last access field is null
+func (t Transition) IsDaysSinceLastAccessNull() bool {
+	return t.DaysSinceLastAccess == TransitionDays(0)
+}
+
+// IsNull returns true if the date, days and days since last access fields are null
 func (t Transition) IsNull() bool {
-	return t.IsDaysNull() && t.IsDateNull()
+	return t.IsDaysNull() && t.IsDateNull() && t.IsDaysSinceLastAccessNull()
 }
@@ -210,6 +210,16 @@ func (dr *DefaultRetention) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 	return nil
 }
 
+// PrefixRule - default retention of the objects under a prefix, it
+// overrides the default retention of the bucket. A rule without
+// DefaultRetention applies no default retention to its objects.
+// This is a MinIO extension.
+type PrefixRule struct {
+	XMLName          xml.Name          `xml:"PrefixRule"`
+	Prefix           string            `xml:"Prefix"`
+	DefaultRetention *DefaultRetention `xml:"DefaultRetention,omitempty"`
+}
+
 // Config - object lock configuration specified in
 // https://docs.aws.amazon.com/AmazonS3/latest/API/Type_API_ObjectLockConfiguration.html
 type Config struct {
@@ -219,6 +229,7 @@ type Config struct {
 	Rule              *struct {
 		DefaultRetention DefaultRetention `xml:"DefaultRetention"`
 	} `xml:"Rule,omitempty"`
+	PrefixRules []PrefixRule `xml:"PrefixRule,omitempty"`
 }
 
 // UnmarshalXML - decodes XML data.
@@ -235,17 +246,36 @@ func (config *Config) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error
 		return fmt.Errorf("only 'Enabled' value is allowed to ObjectLockEnabled element")
 	}
 
+	prefixes := make(map[string]struct{}, len(parsedConfig.PrefixRules))
+	for _, rule := range parsedConfig.PrefixRules {
+		if rule.Prefix == "" {
+			return fmt.Errorf("Prefix must be specified for PrefixRule")
+		}
+		if _, ok := prefixes[rule.Prefix]; ok {
+			return fmt.Errorf("duplicate PrefixRule for prefix '%s'", rule.Prefix)
+		}
+		prefixes[rule.Prefix] = struct{}{}
+	}
+
 	*config = Config(parsedConfig)
 	return nil
 }
 
-// ToRetention - convert to Retention type.
-func (config *Config) ToRetention() Retention {
+// validity - returns the retention period of the default retention.
+func (dr DefaultRetention) validity(t time.Time) time.Duration {
+	if dr.Days != nil {
+		return t.AddDate(0, 0, int(*dr.Days)).Sub(t)
+	}
+	return t.AddDate(int(*dr.Years), 0, 0).Sub(t)
+}
+
+// toRetention - convert to Retention type with the given default retention.
+func (config *Config) toRetention(dr *DefaultRetention) Retention {
 	r := Retention{
 		LockEnabled: config.ObjectLockEnabled == "Enabled",
 	}
-	if config.Rule != nil {
-		r.Mode = config.Rule.DefaultRetention.Mode
+	if dr != nil {
+		r.Mode = dr.Mode
 
 		t, err := UTCNowNTP()
 		if err != nil {
@@ -255,18 +285,39 @@ func (config *Config) ToRetention() Retention {
 			return r
 		}
 
-		if config.Rule.DefaultRetention.Days != nil {
-			r.Validity = t.AddDate(0, 0, int(*config.Rule.DefaultRetention.Days)).Sub(t)
-		} else {
-			r.Validity = t.AddDate(int(*config.Rule.DefaultRetention.Years), 0, 0).Sub(t)
-		}
+		r.Validity = dr.validity(t)
 	}
 
 	return r
 }
 
-// Maximum 4KiB size per object lock config.
-const maxObjectLockConfigSize = 1 << 12
+// ToRetention - convert to Retention type.
+func (config *Config) ToRetention() Retention {
+	if config.Rule == nil {
+		return config.toRetention(nil)
+	}
+	return config.toRetention(&config.Rule.DefaultRetention)
+}
+
+// ToObjectRetention - convert to Retention type for the given object,
+// the longest prefix rule matching the object takes precedence over
+// the default retention of the bucket.
+func (config *Config) ToObjectRetention(object string) Retention {
+	var match *PrefixRule
+	for i, rule := range config.PrefixRules {
+		if strings.HasPrefix(object, rule.Prefix) && (match == nil || len(rule.Prefix) > len(match.Prefix)) {
+			match = &config.PrefixRules[i]
+		}
+	}
+	if match == nil {
+		return config.ToRetention()
+	}
+	return config.toRetention(match.DefaultRetention)
+}
+
+// Maximum 16KiB size per object lock config, to leave
+// room for the prefix rules.
+const maxObjectLockConfigSize = 1 << 14
 
 // ParseObjectLockConfig parses ObjectLockConfig from xml
 func ParseObjectLockConfig(reader io.Reader) (*Config, error) {
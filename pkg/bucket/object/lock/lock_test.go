@@ -169,6 +169,26 @@ func TestParseObjectLockConfig(t *testing.T) {
 			expectedErr: nil,
 			expectErr:   false,
 		},
+		{
+			value:       `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><PrefixRule><Prefix>audit/</Prefix><DefaultRetention><Mode>COMPLIANCE</Mode><Years>7</Years></DefaultRetention></PrefixRule><PrefixRule><Prefix>tmp/</Prefix></PrefixRule></ObjectLockConfiguration>`,
+			expectedErr: nil,
+			expectErr:   false,
+		},
+		{
+			value:       `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><PrefixRule><DefaultRetention><Mode>COMPLIANCE</Mode><Years>7</Years></DefaultRetention></PrefixRule></ObjectLockConfiguration>`,
+			expectedErr: fmt.Errorf("Prefix must be specified for PrefixRule"),
+			expectErr:   true,
+		},
+		{
+			value:       `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><PrefixRule><Prefix>tmp/</Prefix></PrefixRule><PrefixRule><Prefix>tmp/</Prefix></PrefixRule></ObjectLockConfiguration>`,
+			expectedErr: fmt.Errorf("duplicate PrefixRule for prefix 'tmp/'"),
+			expectErr:   true,
+		},
+		{
+			value:       `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><PrefixRule><Prefix>audit/</Prefix><DefaultRetention><Mode>COMPLIANCE</Mode><Days>0</Days></DefaultRetention></PrefixRule></ObjectLockConfiguration>`,
+			expectedErr: fmt.Errorf("Default retention period must be a positive integer value for 'Days'"),
+			expectErr:   true,
+		},
 	}
 	for _, tt := range tests {
 		_, err := ParseObjectLockConfig(strings.NewReader(tt.value))
@@ -184,6 +204,37 @@ func TestParseObjectLockConfig(t *testing.T) {
 	}
 }
 
+func TestConfigToObjectRetention(t *testing.T) {
+	config, err := ParseObjectLockConfig(strings.NewReader(`<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>1</Days></DefaultRetention></Rule><PrefixRule><Prefix>audit/</Prefix><DefaultRetention><Mode>COMPLIANCE</Mode><Years>7</Years></DefaultRetention></PrefixRule><PrefixRule><Prefix>audit/tmp/</Prefix></PrefixRule><PrefixRule><Prefix>tmp/</Prefix></PrefixRule></ObjectLockConfiguration>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		object   string
+		mode     RetMode
+		validity bool
+	}{
+		{"object", RetGovernance, true},
+		{"audit/object", RetCompliance, true},
+		{"audit/tmp/object", "", false},
+		{"tmp/object", "", false},
+		{"tmp", RetGovernance, true},
+	}
+	for _, tt := range tests {
+		r := config.ToObjectRetention(tt.object)
+		if !r.LockEnabled {
+			t.Errorf("%s: expected lock to be enabled", tt.object)
+		}
+		if r.Mode != tt.mode || (r.Validity > 0) != tt.validity {
+			t.Errorf("%s: expected mode %q with validity %t, got %q with %s", tt.object, tt.mode, tt.validity, r.Mode, r.Validity)
+		}
+	}
+	if r := config.ToObjectRetention("audit/object"); r.Validity < 7*365*24*time.Hour {
+		t.Errorf("expected a validity of 7 years, got %s", r.Validity)
+	}
+}
+
 func TestParseObjectRetention(t *testing.T) {
 	tests := []struct {
 		value       string
@@ -46,6 +46,10 @@ const (
 	// S3XAmzContentSha256 - set a static content-sha256 for all calls for a given action.
 	S3XAmzContentSha256 = "s3:x-amz-content-sha256"
 
+	// S3XAmzExpires - key representing the validity in seconds of presigned requests, the
+	// X-Amz-Expires query parameter of signature V4 presigned URLs.
+	S3XAmzExpires Key = "s3:x-amz-expires"
+
 	// S3XAmzStorageClass - key representing x-amz-storage-class HTTP header applicable to PutObject API
 	// only.
 	S3XAmzStorageClass Key = "s3:x-amz-storage-class"
@@ -116,6 +120,10 @@ const (
 
 	// S3AuthType - optionally use this condition key to restrict incoming requests to use a specific authentication method.
 	S3AuthType = "s3:authType"
+
+	// S3ExistingObjectTag - key prefix representing the tags of the existing object, used
+	// as "s3:ExistingObjectTag/<tag-key>" with the value of the tag.
+	S3ExistingObjectTag Key = "s3:ExistingObjectTag"
 )
 
 // AllSupportedKeys - is list of all all supported keys.
@@ -128,6 +136,7 @@ var AllSupportedKeys = append([]Key{
 	S3XAmzMetadataDirective,
 	S3XAmzStorageClass,
 	S3XAmzContentSha256,
+	S3XAmzExpires,
 	S3LocationConstraint,
 	S3Prefix,
 	S3Delimiter,
@@ -155,6 +164,7 @@ var CommonKeys = append([]Key{
 	S3SignatureVersion,
 	S3AuthType,
 	S3XAmzContentSha256,
+	S3XAmzExpires,
 	S3LocationConstraint,
 	AWSReferer,
 	AWSSourceIP,
@@ -180,8 +190,30 @@ func substFuncFromValues(values map[string][]string) func(string) string {
 	}
 }
 
+// ExistingObjectTagKey - returns the key representing the given tag of the existing object.
+func ExistingObjectTagKey(tagKey string) Key {
+	return S3ExistingObjectTag + "/" + Key(tagKey)
+}
+
+// baseKey - returns the key a tag specific key is derived from, the key itself otherwise.
+func (key Key) baseKey() Key {
+	if strings.HasPrefix(string(key), string(S3ExistingObjectTag)+"/") {
+		return S3ExistingObjectTag
+	}
+	return key
+}
+
+// IsExistingObjectTag - checks if key represents a tag of the existing object.
+func (key Key) IsExistingObjectTag() bool {
+	return key.baseKey() == S3ExistingObjectTag && key != S3ExistingObjectTag
+}
+
 // IsValid - checks if key is valid or not.
 func (key Key) IsValid() bool {
+	if key.baseKey() == S3ExistingObjectTag {
+		return len(key) > len(S3ExistingObjectTag)+1
+	}
+
 	for _, supKey := range AllSupportedKeys {
 		if supKey == key {
 			return true
@@ -261,6 +293,7 @@ func (set KeySet) Merge(mset KeySet) {
 }
 
 // Difference - returns a key set contains difference of two keys.
+// Tag specific keys are matched by the key they are derived from.
 // Example:
 //     keySet1 := ["one", "two", "three"]
 //     keySet2 := ["two", "four", "three"]
@@ -269,7 +302,7 @@ func (set KeySet) Difference(sset KeySet) KeySet {
 	nset := make(KeySet)
 
 	for k := range set {
-		if _, ok := sset[k]; !ok {
+		if _, ok := sset[k.baseKey()]; !ok {
 			nset.Add(k)
 		}
 	}
@@ -32,12 +32,16 @@ func TestKeyIsValid(t *testing.T) {
 		{S3XAmzServerSideEncryptionCustomerAlgorithm, true},
 		{S3XAmzMetadataDirective, true},
 		{S3XAmzStorageClass, true},
+		{S3XAmzExpires, true},
 		{S3LocationConstraint, true},
 		{S3Prefix, true},
 		{S3Delimiter, true},
 		{S3MaxKeys, true},
 		{AWSReferer, true},
 		{AWSSourceIP, true},
+		{ExistingObjectTagKey("security"), true},
+		{S3ExistingObjectTag, false},
+		{Key("s3:ExistingObjectTag/"), false},
 		{Key("foo"), false},
 	}
 
@@ -50,6 +54,25 @@ func TestKeyIsValid(t *testing.T) {
 	}
 }
 
+func TestKeyIsExistingObjectTag(t *testing.T) {
+	testCases := []struct {
+		key            Key
+		expectedResult bool
+	}{
+		{ExistingObjectTagKey("security"), true},
+		{S3ExistingObjectTag, false},
+		{S3Prefix, false},
+	}
+
+	for i, testCase := range testCases {
+		result := testCase.key.IsExistingObjectTag()
+
+		if testCase.expectedResult != result {
+			t.Fatalf("case %v: expected: %v, got: %v\n", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
 func TestKeyMarshalJSON(t *testing.T) {
 	testCases := []struct {
 		key            Key
@@ -83,6 +106,7 @@ func TestKeyName(t *testing.T) {
 	}{
 		{S3XAmzCopySource, "x-amz-copy-source"},
 		{AWSReferer, "Referer"},
+		{ExistingObjectTagKey("security"), "ExistingObjectTag/security"},
 	}
 
 	for i, testCase := range testCases {
@@ -101,6 +125,7 @@ func TestKeyUnmarshalJSON(t *testing.T) {
 		expectErr   bool
 	}{
 		{[]byte(`"s3:x-amz-copy-source"`), S3XAmzCopySource, false},
+		{[]byte(`"s3:ExistingObjectTag/security"`), ExistingObjectTagKey("security"), false},
 		{[]byte(`"foo"`), Key(""), true},
 	}
 
@@ -148,6 +173,7 @@ func TestKeySetDifference(t *testing.T) {
 	}{
 		{NewKeySet(), NewKeySet(S3XAmzCopySource), NewKeySet()},
 		{NewKeySet(S3Prefix, S3Delimiter, S3MaxKeys), NewKeySet(S3Delimiter, S3MaxKeys), NewKeySet(S3Prefix)},
+		{NewKeySet(ExistingObjectTagKey("security"), S3Prefix), NewKeySet(S3ExistingObjectTag), NewKeySet(S3Prefix)},
 	}
 
 	for i, testCase := range testCases {
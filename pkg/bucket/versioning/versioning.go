@@ -19,6 +19,7 @@ package versioning
 import (
 	"encoding/xml"
 	"io"
+	"strings"
 )
 
 // State - enabled/disabled/suspended states
@@ -32,12 +33,27 @@ const (
 	Suspended State = "Suspended"
 )
 
+// maxExcludedPrefixes - maximum number of prefixes which can be
+// excluded from versioning on a bucket.
+const maxExcludedPrefixes = 10
+
+// ExcludedPrefix - a prefix whose objects are not versioned even
+// though versioning is enabled on the bucket.
+type ExcludedPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
 // Versioning - Configuration for bucket versioning.
 type Versioning struct {
 	XMLNS   string   `xml:"xmlns,attr,omitempty"`
 	XMLName xml.Name `xml:"VersioningConfiguration"`
 	// MFADelete State    `xml:"MFADelete,omitempty"` // not supported yet.
 	Status State `xml:"Status,omitempty"`
+
+	// MinIO extension - objects under these prefixes are stored
+	// as if versioning was suspended, i.e. only a null version
+	// is kept for them.
+	ExcludedPrefixes []ExcludedPrefix `xml:"ExcludedPrefixes,omitempty"`
 }
 
 // Validate - validates the versioning configuration
@@ -53,6 +69,17 @@ func (v Versioning) Validate() error {
 	default:
 		return Errorf("unsupported Versioning status %s", v.Status)
 	}
+	if len(v.ExcludedPrefixes) > 0 && v.Status != Enabled {
+		return Errorf("excluded prefixes are only supported when versioning is enabled")
+	}
+	if len(v.ExcludedPrefixes) > maxExcludedPrefixes {
+		return Errorf("at most %d excluded prefixes are supported", maxExcludedPrefixes)
+	}
+	for _, p := range v.ExcludedPrefixes {
+		if p.Prefix == "" {
+			return Errorf("excluded prefix cannot be empty")
+		}
+	}
 	return nil
 }
 
@@ -66,6 +93,31 @@ func (v Versioning) Suspended() bool {
 	return v.Status == Suspended
 }
 
+// excluded - returns true if object matches one of the excluded prefixes.
+func (v Versioning) excluded(object string) bool {
+	for _, p := range v.ExcludedPrefixes {
+		if strings.HasPrefix(object, p.Prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrefixEnabled - returns true if versioning is enabled for object,
+// i.e. it is enabled on the bucket and object is not excluded.
+func (v Versioning) PrefixEnabled(object string) bool {
+	return v.Status == Enabled && !v.excluded(object)
+}
+
+// PrefixSuspended - returns true if versioning is suspended for object,
+// either on the whole bucket or because object is excluded.
+func (v Versioning) PrefixSuspended(object string) bool {
+	if v.Status == Suspended {
+		return true
+	}
+	return v.Status == Enabled && v.excluded(object)
+}
+
 // ParseConfig - parses data in given reader to VersioningConfiguration.
 func ParseConfig(reader io.Reader) (*Versioning, error) {
 	var v Versioning
@@ -0,0 +1,85 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package versioning
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseConfig(t *testing.T) {
+	testCases := []struct {
+		input     string
+		expectErr bool
+	}{
+		{`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`, false},
+		{`<VersioningConfiguration><Status>Suspended</Status></VersioningConfiguration>`, false},
+		{`<VersioningConfiguration><Status>Disabled</Status></VersioningConfiguration>`, true},
+		{`<VersioningConfiguration><Status>Enabled</Status><ExcludedPrefixes><Prefix>tmp/</Prefix></ExcludedPrefixes></VersioningConfiguration>`, false},
+		{`<VersioningConfiguration><Status>Suspended</Status><ExcludedPrefixes><Prefix>tmp/</Prefix></ExcludedPrefixes></VersioningConfiguration>`, true},
+		{`<VersioningConfiguration><Status>Enabled</Status><ExcludedPrefixes><Prefix></Prefix></ExcludedPrefixes></VersioningConfiguration>`, true},
+		{`<VersioningConfiguration><Status>Enabled</Status>` +
+			strings.Repeat(`<ExcludedPrefixes><Prefix>tmp/</Prefix></ExcludedPrefixes>`, maxExcludedPrefixes+1) +
+			`</VersioningConfiguration>`, true},
+	}
+
+	for i, testCase := range testCases {
+		_, err := ParseConfig(strings.NewReader(testCase.input))
+		if testCase.expectErr && err == nil {
+			t.Errorf("Test %d: expected error, got nil", i+1)
+		}
+		if !testCase.expectErr && err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+		}
+	}
+}
+
+func TestPrefixEnabled(t *testing.T) {
+	v, err := ParseConfig(strings.NewReader(`<VersioningConfiguration><Status>Enabled</Status>` +
+		`<ExcludedPrefixes><Prefix>tmp/</Prefix></ExcludedPrefixes>` +
+		`<ExcludedPrefixes><Prefix>_spark_staging/</Prefix></ExcludedPrefixes>` +
+		`</VersioningConfiguration>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		object    string
+		enabled   bool
+		suspended bool
+	}{
+		{"data/part-0001.parquet", true, false},
+		{"tmp/part-0001.parquet", false, true},
+		{"_spark_staging/job-1/part-0001", false, true},
+		{"tmpfile", true, false},
+		{"", true, false},
+	}
+
+	for i, testCase := range testCases {
+		if got := v.PrefixEnabled(testCase.object); got != testCase.enabled {
+			t.Errorf("Test %d: expected PrefixEnabled %v, got %v", i+1, testCase.enabled, got)
+		}
+		if got := v.PrefixSuspended(testCase.object); got != testCase.suspended {
+			t.Errorf("Test %d: expected PrefixSuspended %v, got %v", i+1, testCase.suspended, got)
+		}
+	}
+
+	suspended := Versioning{Status: Suspended}
+	if suspended.PrefixEnabled("data/") || !suspended.PrefixSuspended("data/") {
+		t.Error("expected suspended versioning to apply to all prefixes")
+	}
+}
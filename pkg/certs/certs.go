@@ -301,6 +301,21 @@ func (m *Manager) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate,
 	return nil, errors.New("certs: no server certificate is supported by peer")
 }
 
+// DNSNames returns the DNS SANs of all certificates
+// loaded by the Manager.
+func (m *Manager) DNSNames() []string {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var names []string
+	for _, certificate := range m.certificates {
+		if certificate.Leaf != nil {
+			names = append(names, certificate.Leaf.DNSNames...)
+		}
+	}
+	return names
+}
+
 // GetClientCertificate returns a TLS certificate for mTLS based on the
 // certificate request.
 //
@@ -57,6 +57,11 @@ func (arn *ARN) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	return nil
 }
 
+// ParseARN - parses string to ARN.
+func ParseARN(s string) (*ARN, error) {
+	return parseARN(s)
+}
+
 // parseARN - parses string to ARN.
 func parseARN(s string) (*ARN, error) {
 	// ARN must be in the format of arn:minio:sqs:<REGION>:<ID>:<TYPE>
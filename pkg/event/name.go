@@ -60,6 +60,8 @@ const (
 	ObjectTransitionAll
 	ObjectTransitionFailed
 	ObjectTransitionComplete
+	DriveFailurePredicted
+	TestEvent
 )
 
 // Expand - returns expanded values of abbreviated event type.
@@ -172,6 +174,10 @@ func (name Name) String() string {
 		return "s3:ObjectTransition:Failed"
 	case ObjectTransitionComplete:
 		return "s3:ObjectTransition:Complete"
+	case DriveFailurePredicted:
+		return "s3:Drive:FailurePredicted"
+	case TestEvent:
+		return "s3:TestEvent"
 	}
 
 	return ""
@@ -284,6 +290,10 @@ func ParseName(s string) (Name, error) {
 		return ObjectTransitionComplete, nil
 	case "s3:ObjectTransition:*":
 		return ObjectTransitionAll, nil
+	case "s3:Drive:FailurePredicted":
+		return DriveFailurePredicted, nil
+	case "s3:TestEvent":
+		return TestEvent, nil
 	default:
 		return 0, &ErrInvalidEventName{s}
 	}
@@ -26,6 +26,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/minio/minio/pkg/event"
 	xnet "github.com/minio/minio/pkg/net"
@@ -120,12 +121,13 @@ func (k KafkaArgs) Validate() error {
 
 // KafkaTarget - Kafka target.
 type KafkaTarget struct {
-	id         event.TargetID
-	args       KafkaArgs
-	producer   sarama.SyncProducer
-	config     *sarama.Config
-	store      Store
-	loggerOnce func(ctx context.Context, err error, id interface{}, errKind ...interface{})
+	id            event.TargetID
+	args          KafkaArgs
+	producer      sarama.SyncProducer
+	producerMutex sync.Mutex
+	config        *sarama.Config
+	store         Store
+	loggerOnce    func(ctx context.Context, err error, id interface{}, errKind ...interface{})
 }
 
 // ID - returns target ID.
@@ -143,9 +145,37 @@ func (target *KafkaTarget) IsActive() (bool, error) {
 	if !target.args.pingBrokers() {
 		return false, errNotConnected
 	}
+	if _, err := target.getProducer(); err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
+// getProducer - returns the producer, connecting first if the
+// brokers were not reachable so far.
+func (target *KafkaTarget) getProducer() (sarama.SyncProducer, error) {
+	target.producerMutex.Lock()
+	defer target.producerMutex.Unlock()
+
+	if target.producer != nil {
+		return target.producer, nil
+	}
+
+	brokers := []string{}
+	for _, broker := range target.args.Brokers {
+		brokers = append(brokers, broker.String())
+	}
+	producer, err := sarama.NewSyncProducer(brokers, target.config)
+	if err != nil {
+		if err != sarama.ErrOutOfBrokers {
+			return nil, err
+		}
+		return nil, errNotConnected
+	}
+	target.producer = producer
+	return producer, nil
+}
+
 // Save - saves the events to the store which will be replayed when the Kafka connection is active.
 func (target *KafkaTarget) Save(eventData event.Event) error {
 	if target.store != nil {
@@ -160,8 +190,9 @@ func (target *KafkaTarget) Save(eventData event.Event) error {
 
 // send - sends an event to the kafka.
 func (target *KafkaTarget) send(eventData event.Event) error {
-	if target.producer == nil {
-		return errNotConnected
+	producer, err := target.getProducer()
+	if err != nil {
+		return err
 	}
 	objectName, err := url.QueryUnescape(eventData.S3.Object.Key)
 	if err != nil {
@@ -180,7 +211,7 @@ func (target *KafkaTarget) send(eventData event.Event) error {
 		Value: sarama.ByteEncoder(data),
 	}
 
-	_, _, err = target.producer.SendMessage(&msg)
+	_, _, err = producer.SendMessage(&msg)
 
 	return err
 }
@@ -193,20 +224,6 @@ func (target *KafkaTarget) Send(eventKey string) error {
 		return err
 	}
 
-	if target.producer == nil {
-		brokers := []string{}
-		for _, broker := range target.args.Brokers {
-			brokers = append(brokers, broker.String())
-		}
-		target.producer, err = sarama.NewSyncProducer(brokers, target.config)
-		if err != nil {
-			if err != sarama.ErrOutOfBrokers {
-				return err
-			}
-			return errNotConnected
-		}
-	}
-
 	eventData, eErr := target.store.Get(eventKey)
 	if eErr != nil {
 		// The last event key in a successful batch will be sent in the channel atmost once by the replayEvents()
@@ -232,6 +249,9 @@ func (target *KafkaTarget) Send(eventKey string) error {
 
 // Close - closes underneath kafka connection.
 func (target *KafkaTarget) Close() error {
+	target.producerMutex.Lock()
+	defer target.producerMutex.Unlock()
+
 	if target.producer != nil {
 		return target.producer.Close()
 	}
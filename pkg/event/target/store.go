@@ -48,6 +48,11 @@ type Store interface {
 func replayEvents(store Store, doneCh <-chan struct{}, loggerOnce func(ctx context.Context, err error, id interface{}, kind ...interface{}), id event.TargetID) <-chan string {
 	eventKeyCh := make(chan string)
 
+	event.SetQueuedEventsFn(id, func() int {
+		names, _ := store.List()
+		return len(names)
+	})
+
 	go func() {
 		retryTicker := time.NewTicker(retryInterval)
 		defer retryTicker.Stop()
@@ -104,6 +109,7 @@ func sendEvents(target event.Target, eventKeyCh <-chan string, doneCh <-chan str
 	send := func(eventKey string) bool {
 		for {
 			err := target.Send(eventKey)
+			event.UpdateDeliveryStat(target.ID(), err)
 			if err == nil {
 				break
 			}
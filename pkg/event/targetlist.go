@@ -134,9 +134,15 @@ func (list *TargetList) Send(event Event, targetIDset TargetIDSet, resCh chan<-
 				go func(id TargetID, target Target) {
 					defer wg.Done()
 					tgtRes := TargetIDResult{ID: id}
-					if err := target.Save(event); err != nil {
+					err := target.Save(event)
+					if err != nil {
 						tgtRes.Err = err
 					}
+					// Events are delivered when saved unless they are
+					// queued, then they are recorded once replayed.
+					if err != nil || !target.HasQueueStore() {
+						UpdateDeliveryStat(id, err)
+					}
 					resCh <- tgtRes
 				}(id, target)
 			} else {
@@ -147,6 +153,44 @@ func (list *TargetList) Send(event Event, targetIDset TargetIDSet, resCh chan<-
 	}()
 }
 
+// Stats - checks the connection to all targets, which connects targets
+// that are not connected yet, and returns their delivery state.
+func (list *TargetList) Stats() []TargetStat {
+	stats := []TargetStat{}
+	for _, target := range list.Targets() {
+		stats = append(stats, getTargetStat(target))
+	}
+	return stats
+}
+
+// Stat - checks the connection to the target by target ID, which connects
+// it if it is not connected yet, and returns its delivery state.
+func (list *TargetList) Stat(id TargetID) (TargetStat, bool) {
+	list.RLock()
+	target, ok := list.targets[id]
+	list.RUnlock()
+	if !ok {
+		return TargetStat{}, false
+	}
+	return getTargetStat(target), true
+}
+
+// SendTest - delivers the event to the target by target ID right away,
+// or saves it in the queue store of the target if it has one.
+func (list *TargetList) SendTest(id TargetID, event Event) (bool, error) {
+	list.RLock()
+	target, ok := list.targets[id]
+	list.RUnlock()
+	if !ok {
+		return false, nil
+	}
+	err := target.Save(event)
+	if err != nil || !target.HasQueueStore() {
+		UpdateDeliveryStat(id, err)
+	}
+	return true, err
+}
+
 // NewTargetList - creates TargetList.
 func NewTargetList() *TargetList {
 	return &TargetList{targets: make(map[TargetID]Target)}
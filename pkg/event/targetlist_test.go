@@ -253,6 +253,58 @@ func TestTargetListSend(t *testing.T) {
 	}
 }
 
+func TestTargetListSendTest(t *testing.T) {
+	targetList := NewTargetList()
+	if err := targetList.Add(&ExampleTarget{TargetID{"5", "sendtest"}, false, false}); err != nil {
+		panic(err)
+	}
+	if err := targetList.Add(&ExampleTarget{TargetID{"6", "sendtest"}, true, false}); err != nil {
+		panic(err)
+	}
+
+	testCases := []struct {
+		targetID      TargetID
+		expectFound   bool
+		expectErr     bool
+		expectLastErr bool
+	}{
+		{TargetID{"1", "non-existent"}, false, false, false},
+		{TargetID{"5", "sendtest"}, true, false, false},
+		{TargetID{"6", "sendtest"}, true, true, true},
+	}
+
+	for i, testCase := range testCases {
+		found, err := targetList.SendTest(testCase.targetID, Event{EventName: TestEvent})
+		if found != testCase.expectFound {
+			t.Fatalf("test %v: found: expected: %v, got: %v", i+1, testCase.expectFound, found)
+		}
+		if expectErr := (err != nil); expectErr != testCase.expectErr {
+			t.Fatalf("test %v: error: expected: %v, got: %v", i+1, testCase.expectErr, expectErr)
+		}
+
+		stat, ok := targetList.Stat(testCase.targetID)
+		if ok != testCase.expectFound {
+			t.Fatalf("test %v: stat: expected: %v, got: %v", i+1, testCase.expectFound, ok)
+		}
+		if !ok {
+			continue
+		}
+		if stat.Active || stat.Err == nil {
+			t.Fatalf("test %v: expected inactive target with error, got: %v, %v", i+1, stat.Active, stat.Err)
+		}
+		if lastErr := (stat.LastErr != nil); lastErr != testCase.expectLastErr {
+			t.Fatalf("test %v: last error: expected: %v, got: %v", i+1, testCase.expectLastErr, lastErr)
+		}
+		if delivered := !stat.LastDelivery.IsZero(); delivered == testCase.expectLastErr {
+			t.Fatalf("test %v: last delivery: expected: %v, got: %v", i+1, !testCase.expectLastErr, delivered)
+		}
+	}
+
+	if stats := targetList.Stats(); len(stats) != 2 {
+		t.Fatalf("stats: expected: 2, got: %v", len(stats))
+	}
+}
+
 func TestNewTargetList(t *testing.T) {
 	if result := NewTargetList(); result == nil {
 		t.Fatalf("test: result: expected: <non-nil>, got: <nil>")
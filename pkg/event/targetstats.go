@@ -0,0 +1,112 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package event
+
+import (
+	"sync"
+	"time"
+)
+
+// TargetStat - connection and delivery state of a target.
+type TargetStat struct {
+	ID TargetID
+	// Active and Err are the result of checking the connection.
+	Active bool
+	Err    error
+	// LastErr is the last error delivering an event.
+	LastErr      error
+	LastErrTime  time.Time
+	LastDelivery time.Time
+	// QueuedEvents is the number of events in the queue
+	// store waiting to be delivered, if the target has one.
+	HasQueueStore bool
+	QueuedEvents  int
+}
+
+// deliveryStat - delivery state of a target.
+type deliveryStat struct {
+	lastErr      error
+	lastErrTime  time.Time
+	lastDelivery time.Time
+	queued       func() int
+}
+
+// deliveryStats - delivery state of all targets by target ID, kept
+// across target lists as targets are created again on config changes.
+var deliveryStats = struct {
+	sync.Mutex
+	m map[TargetID]*deliveryStat
+}{m: make(map[TargetID]*deliveryStat)}
+
+func getDeliveryStat(id TargetID) *deliveryStat {
+	stat, ok := deliveryStats.m[id]
+	if !ok {
+		stat = &deliveryStat{}
+		deliveryStats.m[id] = stat
+	}
+	return stat
+}
+
+// UpdateDeliveryStat - records the result of delivering an event to a
+// target, for targets delivering events from their queue store.
+func UpdateDeliveryStat(id TargetID, err error) {
+	deliveryStats.Lock()
+	defer deliveryStats.Unlock()
+
+	stat := getDeliveryStat(id)
+	if err != nil {
+		stat.lastErr = err
+		stat.lastErrTime = time.Now().UTC()
+		return
+	}
+	stat.lastDelivery = time.Now().UTC()
+}
+
+// SetQueuedEventsFn - sets the function returning the number of
+// events in the queue store of a target.
+func SetQueuedEventsFn(id TargetID, queued func() int) {
+	deliveryStats.Lock()
+	defer deliveryStats.Unlock()
+
+	getDeliveryStat(id).queued = queued
+}
+
+// getTargetStat - checks the connection to the target, which connects
+// targets that are not connected yet, and returns its delivery state.
+func getTargetStat(target Target) TargetStat {
+	stat := TargetStat{
+		ID:            target.ID(),
+		HasQueueStore: target.HasQueueStore(),
+	}
+	stat.Active, stat.Err = target.IsActive()
+
+	deliveryStats.Lock()
+	dstat, ok := deliveryStats.m[stat.ID]
+	var queued func() int
+	if ok {
+		stat.LastErr = dstat.lastErr
+		stat.LastErrTime = dstat.lastErrTime
+		stat.LastDelivery = dstat.lastDelivery
+		queued = dstat.queued
+	}
+	deliveryStats.Unlock()
+
+	if queued != nil {
+		stat.QueuedEvents = queued()
+	}
+	return stat
+}
@@ -18,16 +18,31 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
 const defaultFlushInterval = time.Duration(100) * time.Millisecond
 
+var errNoForwardTargets = errors.New("no hosts to forward the request to")
+
+const (
+	// Weight of the most recent sample in the moving average of
+	// the latency observed for a target.
+	latencyDecay = 0.2
+
+	// Duration for which a target is considered offline
+	// after a failed attempt to forward a request to it.
+	defaultOfflineDuration = 10 * time.Second
+)
+
 // Forwarder forwards all incoming HTTP requests to configured transport.
 type Forwarder struct {
 	RoundTripper http.RoundTripper
@@ -35,8 +50,21 @@ type Forwarder struct {
 	Logger       func(error)
 	ErrorHandler func(http.ResponseWriter, *http.Request, error)
 
+	// OfflineDuration is the duration for which a target is
+	// ranked last after a failed request, defaults to 10 seconds.
+	OfflineDuration time.Duration
+
 	// internal variables
 	rewriter *headerRewriter
+
+	mu      sync.RWMutex
+	targets map[string]*targetStats
+}
+
+// targetStats holds the latency and health observed for a target host.
+type targetStats struct {
+	latency      time.Duration
+	offlineUntil time.Time
 }
 
 // NewForwarder creates an instance of Forwarder based on the provided list of configuration options
@@ -45,6 +73,10 @@ func NewForwarder(f *Forwarder) *Forwarder {
 	if f.RoundTripper == nil {
 		f.RoundTripper = http.DefaultTransport
 	}
+	if f.OfflineDuration == 0 {
+		f.OfflineDuration = defaultOfflineDuration
+	}
+	f.targets = make(map[string]*targetStats)
 
 	return f
 }
@@ -58,7 +90,7 @@ func (f *Forwarder) ServeHTTP(w http.ResponseWriter, inReq *http.Request) {
 		Director: func(req *http.Request) {
 			f.modifyRequest(req, inReq.URL)
 		},
-		Transport:     f.RoundTripper,
+		Transport:     &statsRoundTripper{f},
 		FlushInterval: defaultFlushInterval,
 		ErrorHandler:  f.customErrHandler,
 	}
@@ -70,6 +102,138 @@ func (f *Forwarder) ServeHTTP(w http.ResponseWriter, inReq *http.Request) {
 	revproxy.ServeHTTP(w, outReq)
 }
 
+// ServeHTTPWithRetry forwards HTTP traffic to the fastest healthy
+// host among hosts. If forwarding fails before any response is
+// written, the request is retried on the remaining hosts in order
+// of preference, as long as the request has no body to replay.
+// Without any host the error handler answers the request.
+func (f *Forwarder) ServeHTTPWithRetry(w http.ResponseWriter, inReq *http.Request, hosts []string) {
+	if len(hosts) == 0 {
+		errHandler := f.customErrHandler
+		if f.ErrorHandler != nil {
+			errHandler = f.ErrorHandler
+		}
+		errHandler(w, inReq, errNoForwardTargets)
+		return
+	}
+	hosts = f.SortTargets(hosts)
+	replayable := inReq.Body == nil || inReq.Body == http.NoBody || inReq.ContentLength == 0
+	for i, host := range hosts {
+		target := copyURL(inReq.URL)
+		target.Host = host
+
+		outReq := new(http.Request)
+		*outReq = *inReq // includes shallow copies of maps, but we handle this in Director
+
+		var failed bool
+		revproxy := httputil.ReverseProxy{
+			Director: func(req *http.Request) {
+				f.modifyRequest(req, target)
+			},
+			Transport:     &statsRoundTripper{f},
+			FlushInterval: defaultFlushInterval,
+			ErrorHandler:  f.customErrHandler,
+		}
+		if f.ErrorHandler != nil {
+			revproxy.ErrorHandler = f.ErrorHandler
+		}
+		if replayable && i < len(hosts)-1 {
+			errHandler := revproxy.ErrorHandler
+			revproxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+				if inReq.Context().Err() != nil {
+					// Client went away, nothing to retry.
+					errHandler(w, r, err)
+					return
+				}
+				failed = true
+			}
+		}
+
+		revproxy.ServeHTTP(w, outReq)
+		if !failed {
+			return
+		}
+	}
+}
+
+// SortTargets returns hosts ordered by preference, hosts with lower
+// observed latency come first, hosts that recently failed come last.
+// Hosts without any observations are preferred so that they get
+// measured.
+func (f *Forwarder) SortTargets(hosts []string) []string {
+	type rankedHost struct {
+		host    string
+		offline bool
+		latency time.Duration
+	}
+
+	now := time.Now()
+	ranked := make([]rankedHost, 0, len(hosts))
+	f.mu.RLock()
+	for _, host := range hosts {
+		r := rankedHost{host: host}
+		if st, ok := f.targets[host]; ok {
+			r.offline = now.Before(st.offlineUntil)
+			r.latency = st.latency
+		}
+		ranked = append(ranked, r)
+	}
+	f.mu.RUnlock()
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].offline != ranked[j].offline {
+			return !ranked[i].offline
+		}
+		return ranked[i].latency < ranked[j].latency
+	})
+
+	sorted := make([]string, len(ranked))
+	for i, r := range ranked {
+		sorted[i] = r.host
+	}
+	return sorted
+}
+
+// observe records the outcome of a request forwarded to host.
+func (f *Forwarder) observe(host string, latency time.Duration, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	st, ok := f.targets[host]
+	if !ok {
+		st = &targetStats{}
+		f.targets[host] = st
+	}
+	if err != nil {
+		st.offlineUntil = time.Now().Add(f.OfflineDuration)
+		return
+	}
+	st.offlineUntil = time.Time{}
+	if st.latency == 0 {
+		st.latency = latency
+		return
+	}
+	st.latency = time.Duration(latencyDecay*float64(latency) + (1-latencyDecay)*float64(st.latency))
+}
+
+// statsRoundTripper records the time taken to receive the response
+// headers from each target along with any transport errors.
+type statsRoundTripper struct {
+	f *Forwarder
+}
+
+func (rt *statsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := rt.f.RoundTripper.RoundTrip(req)
+	if err != nil && req.Context().Err() != nil {
+		// Request was canceled by the client, this
+		// says nothing about the target.
+		return resp, err
+	}
+	rt.f.observe(req.URL.Host, time.Since(start), err)
+	return resp, err
+}
+
 // customErrHandler is originally implemented to avoid having the following error
 //    `http: proxy error: context canceled` printed by Golang
 func (f *Forwarder) customErrHandler(w http.ResponseWriter, r *http.Request, err error) {
@@ -0,0 +1,89 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestForwarderSortTargets(t *testing.T) {
+	f := NewForwarder(&Forwarder{})
+	f.observe("slow:9000", 100*time.Millisecond, nil)
+	f.observe("fast:9000", 10*time.Millisecond, nil)
+	f.observe("down:9000", time.Millisecond, errors.New("connection refused"))
+
+	got := f.SortTargets([]string{"down:9000", "slow:9000", "fast:9000", "new:9000"})
+	want := []string{"new:9000", "fast:9000", "slow:9000", "down:9000"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	// A successful request brings the target back online.
+	f.observe("down:9000", time.Millisecond, nil)
+	got = f.SortTargets([]string{"slow:9000", "down:9000"})
+	want = []string{"down:9000", "slow:9000"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestForwarderServeHTTPWithRetry(t *testing.T) {
+	online := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer online.Close()
+
+	offline := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	offlineURL, _ := url.Parse(offline.URL)
+	offline.Close()
+
+	onlineURL, _ := url.Parse(online.URL)
+
+	f := NewForwarder(&Forwarder{})
+	// Make the offline host preferred to force a retry.
+	f.observe(onlineURL.Host, time.Second, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "http://bucket.domain.com/object", nil)
+	req.URL.Scheme = "http"
+	rec := httptest.NewRecorder()
+	f.ServeHTTPWithRetry(rec, req, []string{offlineURL.Host, onlineURL.Host})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, rec.Code)
+	}
+
+	got := f.SortTargets([]string{offlineURL.Host, onlineURL.Host})
+	if got[0] != onlineURL.Host {
+		t.Fatalf("expected %s to be preferred after failure of %s, got %v", onlineURL.Host, offlineURL.Host, got)
+	}
+}
+
+func TestForwarderServeHTTPWithRetryNoHosts(t *testing.T) {
+	f := NewForwarder(&Forwarder{})
+
+	req := httptest.NewRequest(http.MethodGet, "http://bucket.domain.com/object", nil)
+	rec := httptest.NewRecorder()
+	f.ServeHTTPWithRetry(rec, req, nil)
+	if rec.Code != http.StatusBadGateway {
+		t.Fatalf("expected %d, got %d", http.StatusBadGateway, rec.Code)
+	}
+}
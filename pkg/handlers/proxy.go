@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 var (
@@ -46,6 +47,71 @@ var (
 	protoRegex = regexp.MustCompile(`(?i)^(;|,| )+(?:proto=)(https|http)`)
 )
 
+var (
+	trustedProxiesMu sync.RWMutex
+	trustedProxies   []*net.IPNet
+)
+
+// SetTrustedProxies sets the networks of the proxies whose forwarding
+// headers are trusted by GetSourceIP. The forwarding headers of all
+// clients are trusted while none is set.
+func SetTrustedProxies(proxies []*net.IPNet) {
+	trustedProxiesMu.Lock()
+	defer trustedProxiesMu.Unlock()
+	trustedProxies = proxies
+}
+
+func getTrustedProxies() []*net.IPNet {
+	trustedProxiesMu.RLock()
+	defer trustedProxiesMu.RUnlock()
+	return trustedProxies
+}
+
+func isTrustedProxy(proxies []*net.IPNet, addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedAddr returns the address of a forwarding header element
+// without quotes, brackets and port.
+func forwardedAddr(addr string) string {
+	addr = strings.Trim(strings.TrimSpace(addr), `"`)
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(addr, "["), "]")
+}
+
+// forwardedChain returns the addresses the request was forwarded for
+// by the X-Forwarded-For, X-Real-IP or RFC7239 Forwarded headers (in
+// that order), the client first.
+func forwardedChain(r *http.Request) (chain []string) {
+	if fwds := r.Header.Values(xForwardedFor); len(fwds) != 0 {
+		for _, addr := range strings.Split(strings.Join(fwds, ","), ",") {
+			chain = append(chain, forwardedAddr(addr))
+		}
+	} else if fwd := r.Header.Get(xRealIP); fwd != "" {
+		chain = append(chain, forwardedAddr(fwd))
+	} else if fwds := r.Header.Values(forwarded); len(fwds) != 0 {
+		for _, elem := range strings.Split(strings.Join(fwds, ","), ",") {
+			for _, pair := range strings.Split(elem, ";") {
+				if kv := strings.SplitN(strings.TrimSpace(pair), "=", 2); len(kv) == 2 && strings.EqualFold(kv[0], "for") {
+					chain = append(chain, forwardedAddr(kv[1]))
+				}
+			}
+		}
+	}
+	return chain
+}
+
 // GetSourceScheme retrieves the scheme from the X-Forwarded-Proto and RFC7239
 // Forwarded headers (in that order).
 func GetSourceScheme(r *http.Request) string {
@@ -109,14 +175,35 @@ func GetSourceIPFromHeaders(r *http.Request) string {
 }
 
 // GetSourceIP retrieves the IP from the request headers
-// and falls back to r.RemoteAddr when necessary.
+// and falls back to r.RemoteAddr when necessary. Once trusted
+// proxies are set, the headers are only used for the requests of
+// trusted proxies, the source IP is the last address forwarded
+// for which is not a trusted proxy.
 func GetSourceIP(r *http.Request) string {
-	addr := GetSourceIPFromHeaders(r)
-	if addr != "" {
-		return addr
+	proxies := getTrustedProxies()
+	if len(proxies) == 0 {
+		addr := GetSourceIPFromHeaders(r)
+		if addr != "" {
+			return addr
+		}
 	}
 
 	// Default to remote address if headers not set.
-	addr, _, _ = net.SplitHostPort(r.RemoteAddr)
+	addr, _, _ := net.SplitHostPort(r.RemoteAddr)
+	if len(proxies) == 0 || !isTrustedProxy(proxies, addr) {
+		return addr
+	}
+
+	chain := forwardedChain(r)
+	for i := len(chain) - 1; i >= 0; i-- {
+		if net.ParseIP(chain[i]) == nil {
+			// Not forwarded by a trusted proxy.
+			break
+		}
+		addr = chain[i]
+		if !isTrustedProxy(proxies, addr) {
+			break
+		}
+	}
 	return addr
 }
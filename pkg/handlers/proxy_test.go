@@ -17,6 +17,7 @@
 package handlers
 
 import (
+	"net"
 	"net/http"
 	"testing"
 )
@@ -81,3 +82,45 @@ func TestGetSourceIP(t *testing.T) {
 		}
 	}
 }
+
+// TestGetSourceIPTrustedProxies - check the forwarding headers are only
+// used for the requests of trusted proxies.
+func TestGetSourceIPTrustedProxies(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetTrustedProxies([]*net.IPNet{proxies})
+	defer SetTrustedProxies(nil)
+
+	testCases := []struct {
+		remoteAddr string
+		key        string
+		val        string
+		expected   string
+	}{
+		{"10.0.0.1:9000", xForwardedFor, "8.8.8.8", "8.8.8.8"},
+		// Not a trusted proxy.
+		{"192.168.1.1:9000", xForwardedFor, "8.8.8.8", "192.168.1.1"},
+		// Only the addresses added by trusted proxies are used.
+		{"10.0.0.1:9000", xForwardedFor, "1.2.3.4, 8.8.8.8, 10.0.0.2", "8.8.8.8"},
+		{"10.0.0.1:9000", xForwardedFor, "10.0.0.3, 10.0.0.2", "10.0.0.3"},
+		{"10.0.0.1:9000", xForwardedFor, "unknown, 10.0.0.2", "10.0.0.2"},
+		{"10.0.0.1:9000", xRealIP, "8.8.8.8", "8.8.8.8"},
+		{"10.0.0.1:9000", forwarded, `for=192.0.2.43, for="[2001:db8:cafe::17]:4711"`, "2001:db8:cafe::17"},
+		{"10.0.0.1:9000", forwarded, `for=192.0.2.60;proto=http;by=203.0.113.43`, "192.0.2.60"},
+		{"10.0.0.1:9000", "", "", "10.0.0.1"},
+	}
+	for i, testCase := range testCases {
+		req := &http.Request{
+			RemoteAddr: testCase.remoteAddr,
+			Header:     http.Header{},
+		}
+		if testCase.key != "" {
+			req.Header.Set(testCase.key, testCase.val)
+		}
+		if res := GetSourceIP(req); res != testCase.expected {
+			t.Errorf("Test %d: got %s want %s", i+1, res, testCase.expected)
+		}
+	}
+}
@@ -125,6 +125,13 @@ const (
 	// GetBucketQuotaAdminAction - allow getting bucket quota
 	GetBucketQuotaAdminAction = "admin:GetBucketQuota"
 
+	// Bucket throttle Actions
+
+	// SetBucketThrottleAdminAction - allow setting bucket request limits
+	SetBucketThrottleAdminAction = "admin:SetBucketThrottle"
+	// GetBucketThrottleAdminAction - allow getting bucket request limits
+	GetBucketThrottleAdminAction = "admin:GetBucketThrottle"
+
 	// Bucket Target admin Actions
 
 	// SetBucketTargetAction - allow setting bucket target
@@ -132,6 +139,61 @@ const (
 	// GetBucketTargetAction - allow getting bucket targets
 	GetBucketTargetAction = "admin:GetBucketTarget"
 
+	// Object lock Actions
+
+	// ObjectLockReportAdminAction - allow generating object lock compliance reports
+	ObjectLockReportAdminAction = "admin:ObjectLockReport"
+
+	// Batch job Actions
+
+	// BatchJobAdminAction - allow starting, monitoring and canceling batch jobs
+	BatchJobAdminAction = "admin:BatchJob"
+
+	// ExportBucketAdminAction - allow exporting the objects of a bucket
+	ExportBucketAdminAction = "admin:ExportBucket"
+
+	// ImportBucketAdminAction - allow restoring a bucket from an export
+	ImportBucketAdminAction = "admin:ImportBucket"
+
+	// Tenant Actions
+
+	// TenantAdminAction - allow creating, listing and removing tenants
+	TenantAdminAction = "admin:Tenant"
+
+	// Notification Actions
+
+	// ReplayEventsAdminAction - allow re-publishing logged bucket events
+	ReplayEventsAdminAction = "admin:ReplayEvents"
+
+	// ListMultipartUploadsAdminAction - allow listing the multipart uploads of all buckets
+	ListMultipartUploadsAdminAction = "admin:ListMultipartUploads"
+	// AbortMultipartUploadsAdminAction - allow forcibly aborting multipart uploads
+	AbortMultipartUploadsAdminAction = "admin:AbortMultipartUploads"
+
+	// CopyProgressAdminAction - allow monitoring the server-side copies in progress
+	CopyProgressAdminAction = "admin:CopyProgress"
+
+	// LogLevelAdminAction - allow viewing and changing log levels at runtime
+	LogLevelAdminAction = "admin:LogLevel"
+
+	// NotificationTargetsAdminAction - allow viewing the state of notification
+	// targets, reconnecting them and sending test events
+	NotificationTargetsAdminAction = "admin:NotificationTargets"
+
+	// MaintenanceAdminAction - allow viewing and changing the maintenance
+	// mode of nodes and pools
+	MaintenanceAdminAction = "admin:Maintenance"
+
+	// ListRequestsAdminAction - allow listing the S3 requests being served
+	ListRequestsAdminAction = "admin:ListRequests"
+
+	// CancelRequestAdminAction - allow canceling S3 requests being served
+	CancelRequestAdminAction = "admin:CancelRequest"
+
+	// BreakGlassAdminAction - allow viewing the state of the break-glass
+	// credential, enabling it and sealing it again
+	BreakGlassAdminAction = "admin:BreakGlass"
+
 	// AllAdminActions - provides all admin permissions
 	AllAdminActions = "admin:*"
 )
@@ -177,9 +239,28 @@ var supportedAdminActions = map[AdminAction]struct{}{
 	ListUserPoliciesAdminAction:     {},
 	SetBucketQuotaAdminAction:       {},
 	GetBucketQuotaAdminAction:       {},
+	SetBucketThrottleAdminAction:    {},
+	GetBucketThrottleAdminAction:    {},
 	SetBucketTargetAction:           {},
 	GetBucketTargetAction:           {},
+	ObjectLockReportAdminAction:     {},
+	BatchJobAdminAction:             {},
+	ExportBucketAdminAction:         {},
+	ImportBucketAdminAction:         {},
+	TenantAdminAction:               {},
+	ReplayEventsAdminAction:         {},
 	AllAdminActions:                 {},
+
+	ListMultipartUploadsAdminAction:  {},
+	AbortMultipartUploadsAdminAction: {},
+
+	CopyProgressAdminAction:        {},
+	LogLevelAdminAction:            {},
+	NotificationTargetsAdminAction: {},
+	MaintenanceAdminAction:         {},
+	ListRequestsAdminAction:        {},
+	CancelRequestAdminAction:       {},
+	BreakGlassAdminAction:          {},
 }
 
 // IsValid - checks if action is valid or not.
@@ -222,13 +303,29 @@ var adminActionConditionKeyMap = map[Action]condition.KeySet{
 	RemoveServiceAccountAdminAction: condition.NewKeySet(condition.AllSupportedAdminKeys...),
 	ListServiceAccountsAdminAction:  condition.NewKeySet(condition.AllSupportedAdminKeys...),
 
-	CreatePolicyAdminAction:     condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	DeletePolicyAdminAction:     condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	GetPolicyAdminAction:        condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	AttachPolicyAdminAction:     condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	ListUserPoliciesAdminAction: condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	SetBucketQuotaAdminAction:   condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	GetBucketQuotaAdminAction:   condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	SetBucketTargetAction:       condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	GetBucketTargetAction:       condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	CreatePolicyAdminAction:      condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	DeletePolicyAdminAction:      condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	GetPolicyAdminAction:         condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	AttachPolicyAdminAction:      condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	ListUserPoliciesAdminAction:  condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	SetBucketQuotaAdminAction:    condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	GetBucketQuotaAdminAction:    condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	SetBucketThrottleAdminAction: condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	GetBucketThrottleAdminAction: condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	SetBucketTargetAction:        condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	GetBucketTargetAction:        condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	ObjectLockReportAdminAction:  condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	BatchJobAdminAction:          condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	ExportBucketAdminAction:      condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	ImportBucketAdminAction:      condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	TenantAdminAction:            condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	ReplayEventsAdminAction:      condition.NewKeySet(condition.AllSupportedAdminKeys...),
+
+	CopyProgressAdminAction:        condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	LogLevelAdminAction:            condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	NotificationTargetsAdminAction: condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	MaintenanceAdminAction:         condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	ListRequestsAdminAction:        condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	CancelRequestAdminAction:       condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	BreakGlassAdminAction:          condition.NewKeySet(condition.AllSupportedAdminKeys...),
 }
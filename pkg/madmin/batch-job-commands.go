@@ -0,0 +1,333 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BatchJobType - type of operation performed by a batch job.
+type BatchJobType string
+
+// Supported batch job types.
+const (
+	// BatchJobLegalHold - applies or removes legal hold on
+	// all object versions matching the job filter.
+	BatchJobLegalHold BatchJobType = "legalhold"
+
+	// BatchJobPurge - permanently removes all object versions
+	// and delete markers matching the job filter, versions
+	// protected by object lock are skipped.
+	BatchJobPurge BatchJobType = "purge"
+
+	// BatchJobMigrate - copies the bucket configuration and all
+	// object versions and delete markers matching the job filter
+	// to a remote bucket, preserving version IDs.
+	BatchJobMigrate BatchJobType = "migrate"
+
+	// BatchJobReencrypt - seals the object keys of all SSE-S3
+	// object versions matching the job filter with a new data
+	// key of the current KMS key, in place, e.g. after switching
+	// KMS. Other object versions are skipped.
+	BatchJobReencrypt BatchJobType = "reencrypt"
+
+	// BatchJobReplicationAudit - compares all object versions and
+	// delete markers matching the job filter with the replication
+	// target of the bucket, reporting the versions missing on the
+	// target, differing from it, and the extra versions the target
+	// has. Nothing is changed unless repairs are requested.
+	BatchJobReplicationAudit BatchJobType = "replicationaudit"
+)
+
+// BatchJobState - state of a batch job.
+type BatchJobState string
+
+// Batch job states.
+const (
+	BatchJobRunning   BatchJobState = "running"
+	BatchJobCompleted BatchJobState = "completed"
+	BatchJobFailed    BatchJobState = "failed"
+	BatchJobCanceled  BatchJobState = "canceled"
+)
+
+// BatchJobFilter - selects the object versions a batch job applies to.
+type BatchJobFilter struct {
+	Prefix string `json:"prefix,omitempty"`
+
+	// All tags must be present on an object version
+	// with the same value for it to match.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Object versions are matched only when older (or newer)
+	// than the given duration at the time they are scanned.
+	OlderThan time.Duration `json:"olderThan,omitempty"`
+	NewerThan time.Duration `json:"newerThan,omitempty"`
+}
+
+// BatchJobLegalHoldOptions - options of a legal hold batch job.
+type BatchJobLegalHoldOptions struct {
+	// Status is either "ON" or "OFF".
+	Status string `json:"status"`
+}
+
+// BatchJobMigrateOptions - options of a migrate batch job.
+type BatchJobMigrateOptions struct {
+	// ARN of the remote target, set on the job bucket with
+	// SetRemoteTarget, which the bucket is migrated to.
+	TargetARN string `json:"targetArn"`
+
+	// Compare the size and ETag of every copied object
+	// version with the one read back from the target.
+	Verify bool `json:"verify,omitempty"`
+
+	// Once all object versions were copied, copy the bucket
+	// configuration again along with the object versions
+	// written in the meantime, until no new ones are found.
+	// The cutover does not block writes, they must be stopped
+	// beforehand. Versions deleted from the source after they
+	// were copied are not deleted from the target.
+	Cutover bool `json:"cutover,omitempty"`
+}
+
+// BatchJobReencryptOptions - options of a reencrypt batch job.
+type BatchJobReencryptOptions struct {
+	// ID of the KMS key to re-encrypt with, the
+	// default key of the KMS when empty.
+	KeyID string `json:"keyId,omitempty"`
+}
+
+// BatchJobReplicationAuditOptions - options of a replication audit batch job.
+type BatchJobReplicationAuditOptions struct {
+	// Queue the replication of the object versions and delete
+	// markers missing on the target or differing from it. Extra
+	// versions on the target are only reported.
+	Repair bool `json:"repair,omitempty"`
+}
+
+// BatchJobRequest - describes a batch job to be started.
+type BatchJobRequest struct {
+	Type   BatchJobType   `json:"type"`
+	Bucket string         `json:"bucket"`
+	Filter BatchJobFilter `json:"filter"`
+
+	// Number of object versions processed in parallel,
+	// the server picks a default when zero.
+	Workers int `json:"workers,omitempty"`
+
+	LegalHold *BatchJobLegalHoldOptions `json:"legalHold,omitempty"`
+	Migrate   *BatchJobMigrateOptions   `json:"migrate,omitempty"`
+	Reencrypt *BatchJobReencryptOptions `json:"reencrypt,omitempty"`
+
+	ReplicationAudit *BatchJobReplicationAuditOptions `json:"replicationAudit,omitempty"`
+
+	// Runs the job again this long after it started, once it is
+	// done, e.g. for periodic audits. Canceling the job, while
+	// it runs or waits for its next run, stops the schedule.
+	Interval time.Duration `json:"interval,omitempty"`
+}
+
+// BatchJobStatus - progress of a batch job.
+type BatchJobStatus struct {
+	ID         string          `json:"id"`
+	Request    BatchJobRequest `json:"request"`
+	Node       string          `json:"node"`
+	State      BatchJobState   `json:"state"`
+	Started    time.Time       `json:"started"`
+	LastUpdate time.Time       `json:"lastUpdate"`
+
+	// Last object name fully processed, a resumed
+	// job continues after this object.
+	Checkpoint string `json:"checkpoint,omitempty"`
+
+	Scanned   uint64 `json:"scanned"`
+	Matched   uint64 `json:"matched"`
+	Succeeded uint64 `json:"succeeded"`
+	Skipped   uint64 `json:"skipped"`
+	Failed    uint64 `json:"failed"`
+
+	// Drift is the number of object versions found to differ
+	// between the bucket and its replication target.
+	Drift uint64 `json:"drift,omitempty"`
+
+	Error string `json:"error,omitempty"`
+
+	// NextRun is when a periodic job is started again,
+	// zero once it was started or if it is not periodic.
+	NextRun time.Time `json:"nextRun,omitempty"`
+}
+
+// StartBatchJob - starts a new batch job and returns its initial status.
+func (adm *AdminClient) StartBatchJob(ctx context.Context, req BatchJobRequest) (status BatchJobStatus, err error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return status, err
+	}
+
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/batch-jobs/start",
+		content: data,
+	}
+
+	// Execute POST on /minio/admin/v3/batch-jobs/start
+	resp, err := adm.executeMethod(ctx, http.MethodPost, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return status, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return status, httpRespToErrorResponse(resp)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&status)
+	return status, err
+}
+
+// ListBatchJobs - lists the status of all batch jobs.
+func (adm *AdminClient) ListBatchJobs(ctx context.Context) (jobs []BatchJobStatus, err error) {
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/batch-jobs/list",
+	}
+
+	// Execute GET on /minio/admin/v3/batch-jobs/list
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// BatchJobStatus - returns the status of the batch job with the given id.
+func (adm *AdminClient) BatchJobStatus(ctx context.Context, id string) (status BatchJobStatus, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("id", id)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/batch-jobs/status",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/batch-jobs/status
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return status, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return status, httpRespToErrorResponse(resp)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&status)
+	return status, err
+}
+
+// CancelBatchJob - cancels a running batch job, the job stops
+// after the batch of object versions in progress.
+func (adm *AdminClient) CancelBatchJob(ctx context.Context, id string) error {
+	queryValues := url.Values{}
+	queryValues.Set("id", id)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/batch-jobs/cancel",
+		queryValues: queryValues,
+	}
+
+	// Execute POST on /minio/admin/v3/batch-jobs/cancel
+	resp, err := adm.executeMethod(ctx, http.MethodPost, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+	return nil
+}
+
+// RemoveBatchJob - removes the status and the manifest of a
+// batch job which is no longer running.
+func (adm *AdminClient) RemoveBatchJob(ctx context.Context, id string) error {
+	queryValues := url.Values{}
+	queryValues.Set("id", id)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/batch-jobs/remove",
+		queryValues: queryValues,
+	}
+
+	// Execute DELETE on /minio/admin/v3/batch-jobs/remove
+	resp, err := adm.executeMethod(ctx, http.MethodDelete, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+	return nil
+}
+
+// BatchJobManifest - returns the result manifest of a batch job as
+// CSV with one "object,versionId,result,error" record per processed
+// object version, result being one of "ok", "skipped", "failed" or,
+// for replication audits, "drift" with the difference found as error.
+// The caller must close the returned reader.
+func (adm *AdminClient) BatchJobManifest(ctx context.Context, id string) (io.ReadCloser, error) {
+	queryValues := url.Values{}
+	queryValues.Set("id", id)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/batch-jobs/manifest",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/batch-jobs/manifest
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	if err != nil {
+		closeResponse(resp)
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer closeResponse(resp)
+		return nil, httpRespToErrorResponse(resp)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,90 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// BreakGlassStatus - state of the break-glass credential, an emergency
+// credential with root privileges which is sealed, and rejected, unless
+// it was explicitly enabled for a limited time. Requests authenticated
+// with it are flagged in the audit log.
+type BreakGlassStatus struct {
+	// Configured is false if the server has no break-glass credential.
+	Configured bool   `json:"configured"`
+	AccessKey  string `json:"accessKey,omitempty"`
+
+	Enabled bool `json:"enabled"`
+	// ExpiresAt is when the credential is sealed again.
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+	// EnabledBy is the access key which enabled the credential.
+	EnabledBy string `json:"enabledBy,omitempty"`
+}
+
+// BreakGlassStatus - returns the state of the break-glass credential.
+func (adm *AdminClient) BreakGlassStatus(ctx context.Context) (BreakGlassStatus, error) {
+	return adm.breakGlass(ctx, http.MethodGet, nil)
+}
+
+// EnableBreakGlass - enables the break-glass credential for the given
+// duration, or for the server default if zero, and returns its state.
+func (adm *AdminClient) EnableBreakGlass(ctx context.Context, duration time.Duration) (BreakGlassStatus, error) {
+	v := url.Values{}
+	v.Set("enable", strconv.FormatBool(true))
+	if duration > 0 {
+		v.Set("duration", duration.String())
+	}
+	return adm.breakGlass(ctx, http.MethodPost, v)
+}
+
+// DisableBreakGlass - seals the break-glass credential again.
+func (adm *AdminClient) DisableBreakGlass(ctx context.Context) (BreakGlassStatus, error) {
+	v := url.Values{}
+	v.Set("enable", strconv.FormatBool(false))
+	return adm.breakGlass(ctx, http.MethodPost, v)
+}
+
+func (adm *AdminClient) breakGlass(ctx context.Context, method string, v url.Values) (status BreakGlassStatus, err error) {
+	// Execute GET or POST on /minio/admin/v3/breakglass
+	resp, err := adm.executeMethod(ctx, method, requestData{
+		relPath:     adminAPIPrefix + "/breakglass",
+		queryValues: v,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return status, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return status, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return status, err
+	}
+	err = json.Unmarshal(b, &status)
+	return status, err
+}
@@ -0,0 +1,217 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Names of the tar entries written before and after the object
+// versions of a bucket export. Bucket configuration files are
+// written under BucketExportConfigPrefix, right after the info.
+const (
+	BucketExportInfoFile     = ".minio-export/info.json"
+	BucketExportConfigPrefix = ".minio-export/config/"
+	BucketExportSummaryFile  = ".minio-export/summary.json"
+)
+
+// PAX record keys holding the version information of the object
+// entries of a bucket export.
+const (
+	BucketExportPAXVersionID    = "MINIO.versionid"
+	BucketExportPAXIsLatest     = "MINIO.islatest"
+	BucketExportPAXDeleteMarker = "MINIO.deletemarker"
+	BucketExportPAXETag         = "MINIO.etag"
+	BucketExportPAXTags         = "MINIO.tags"
+	BucketExportPAXMetadata     = "MINIO.metadata"
+	BucketExportPAXSSE          = "MINIO.sse"
+	BucketExportPAXSkipped      = "MINIO.skipped"
+)
+
+// ExportBucketOptions - options of a bucket export.
+type ExportBucketOptions struct {
+	Prefix string
+
+	// Only object versions created after After and no later
+	// than Before are exported, Before defaults to the time
+	// the export started.
+	After  time.Time
+	Before time.Time
+
+	// Export only the version of each object which was the
+	// latest one at Before instead of all versions.
+	LatestOnly bool
+}
+
+// BucketExportInfo - describes a bucket export, saved as the
+// first entry of the export.
+type BucketExportInfo struct {
+	Bucket     string    `json:"bucket"`
+	Prefix     string    `json:"prefix,omitempty"`
+	After      time.Time `json:"after,omitempty"`
+	Before     time.Time `json:"before"`
+	LatestOnly bool      `json:"latestOnly,omitempty"`
+
+	Versioned   bool `json:"versioned"`
+	LockEnabled bool `json:"lockEnabled,omitempty"`
+}
+
+// BucketExportSummary - totals of a bucket export, saved as the
+// last entry of the export. An export without a summary is
+// incomplete.
+type BucketExportSummary struct {
+	Objects       uint64 `json:"objects"`
+	Versions      uint64 `json:"versions"`
+	DeleteMarkers uint64 `json:"deleteMarkers"`
+	Skipped       uint64 `json:"skipped"`
+	Bytes         int64  `json:"bytes"`
+}
+
+// ExportBucket - streams an export of the objects in bucket as a PAX
+// tar archive. Each object version is an entry named after the object,
+// versions of the same object are written oldest first, and their
+// version information is saved as PAX records. The caller must close
+// the returned reader.
+func (adm *AdminClient) ExportBucket(ctx context.Context, bucket string, opts ExportBucketOptions) (io.ReadCloser, error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+	if opts.Prefix != "" {
+		queryValues.Set("prefix", opts.Prefix)
+	}
+	if !opts.After.IsZero() {
+		queryValues.Set("after", opts.After.Format(time.RFC3339Nano))
+	}
+	if !opts.Before.IsZero() {
+		queryValues.Set("before", opts.Before.Format(time.RFC3339Nano))
+	}
+	if opts.LatestOnly {
+		queryValues.Set("latest", strconv.FormatBool(opts.LatestOnly))
+	}
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/export-bucket",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/export-bucket
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	if err != nil {
+		closeResponse(resp)
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer closeResponse(resp)
+		return nil, httpRespToErrorResponse(resp)
+	}
+	return resp.Body, nil
+}
+
+// BucketImportConflict - how a bucket import handles objects
+// which already exist in the target bucket.
+type BucketImportConflict string
+
+// Supported bucket import conflict policies.
+const (
+	// BucketImportSkip - existing objects are left as they are.
+	BucketImportSkip BucketImportConflict = "skip"
+	// BucketImportOverwrite - existing objects are overwritten, or
+	// get new versions if the bucket is versioned, and the bucket
+	// configuration of an existing bucket is replaced.
+	BucketImportOverwrite BucketImportConflict = "overwrite"
+	// BucketImportFail - the import stops at the first existing object.
+	BucketImportFail BucketImportConflict = "fail"
+)
+
+// ImportBucketOptions - options of a bucket import.
+type ImportBucketOptions struct {
+	// Location of the export archive, which must have been
+	// uploaded as an object to the same deployment.
+	ArchiveBucket string
+	ArchiveObject string
+
+	// Defaults to BucketImportSkip.
+	Conflict BucketImportConflict
+
+	// Do not restore the bucket configuration saved in the archive.
+	SkipConfig bool
+}
+
+// BucketImportSummary - totals of a bucket import.
+type BucketImportSummary struct {
+	Created       bool     `json:"created"`
+	Configs       []string `json:"configs,omitempty"`
+	Objects       uint64   `json:"objects"`
+	Versions      uint64   `json:"versions"`
+	DeleteMarkers uint64   `json:"deleteMarkers"`
+	Skipped       uint64   `json:"skipped"`
+	Bytes         int64    `json:"bytes"`
+
+	// Set if the import stopped before the end of the archive.
+	Error string `json:"error,omitempty"`
+}
+
+// ImportBucket - restores bucket from an archive created by
+// ExportBucket, the bucket is created if it does not exist.
+// The summary is returned along with an error if the import
+// stopped before the end of the archive.
+func (adm *AdminClient) ImportBucket(ctx context.Context, bucket string, opts ImportBucketOptions) (summary BucketImportSummary, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+	queryValues.Set("archive-bucket", opts.ArchiveBucket)
+	queryValues.Set("archive-object", opts.ArchiveObject)
+	if opts.Conflict != "" {
+		queryValues.Set("conflict", string(opts.Conflict))
+	}
+	if opts.SkipConfig {
+		queryValues.Set("skip-config", strconv.FormatBool(opts.SkipConfig))
+	}
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/import-bucket",
+		queryValues: queryValues,
+	}
+
+	// Execute POST on /minio/admin/v3/import-bucket
+	resp, err := adm.executeMethod(ctx, http.MethodPost, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return summary, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return summary, httpRespToErrorResponse(resp)
+	}
+
+	// The server sends whitespace to keep the
+	// connection alive until the import is done.
+	if err = json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		return summary, err
+	}
+	if summary.Error != "" {
+		return summary, errors.New(summary.Error)
+	}
+	return summary, nil
+}
@@ -0,0 +1,112 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ConfigBackupInfo - a backup of the IAM and server configuration.
+type ConfigBackupInfo struct {
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+	Size    int64     `json:"size"`
+}
+
+// ConfigRestoreSummary - result of a configuration backup restore.
+type ConfigRestoreSummary struct {
+	Backup string `json:"backup"`
+	Files  int    `json:"files"`
+}
+
+// ListConfigBackups - lists the configuration backups saved to bucket,
+// oldest first. The bucket set in the backup configuration is used
+// if bucket is empty.
+func (adm *AdminClient) ListConfigBackups(ctx context.Context, bucket string) ([]ConfigBackupInfo, error) {
+	queryValues := url.Values{}
+	if bucket != "" {
+		queryValues.Set("bucket", bucket)
+	}
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/config-backups",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/config-backups
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []ConfigBackupInfo
+	if err = json.Unmarshal(respBytes, &backups); err != nil {
+		return nil, err
+	}
+	return backups, nil
+}
+
+// RestoreConfigBackup - restores the IAM and server configuration saved
+// in the backup name of bucket. The bucket set in the backup
+// configuration is used if bucket is empty. The servers must be
+// restarted for the restored configuration to take effect.
+func (adm *AdminClient) RestoreConfigBackup(ctx context.Context, bucket, name string) (summary ConfigRestoreSummary, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("name", name)
+	if bucket != "" {
+		queryValues.Set("bucket", bucket)
+	}
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/restore-config-backup",
+		queryValues: queryValues,
+	}
+
+	// Execute POST on /minio/admin/v3/restore-config-backup
+	resp, err := adm.executeMethod(ctx, http.MethodPost, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return summary, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return summary, httpRespToErrorResponse(resp)
+	}
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return summary, err
+	}
+	err = json.Unmarshal(respBytes, &summary)
+	return summary, err
+}
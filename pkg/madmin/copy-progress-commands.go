@@ -0,0 +1,77 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// CopyProgress describes a server-side copy in progress, either a
+// CopyObject or an UploadPartCopy when UploadID is set.
+type CopyProgress struct {
+	ID         string `json:"id"`
+	Node       string `json:"node,omitempty"`
+	SrcBucket  string `json:"srcBucket"`
+	SrcObject  string `json:"srcObject"`
+	DstBucket  string `json:"dstBucket"`
+	DstObject  string `json:"dstObject"`
+	UploadID   string `json:"uploadID,omitempty"`
+	PartNumber int    `json:"partNumber,omitempty"`
+	// TotalBytes is the number of bytes to copy, -1 if unknown.
+	TotalBytes  int64 `json:"totalBytes"`
+	CopiedBytes int64 `json:"copiedBytes"`
+	// BandwidthLimit is the maximum number of bytes copied per
+	// second, zero if the copy is not throttled.
+	BandwidthLimit int64     `json:"bandwidthLimit,omitempty"`
+	StartTime      time.Time `json:"startTime"`
+}
+
+// CopyProgress - lists the server-side copies in progress on all
+// servers of the cluster, oldest first.
+func (adm *AdminClient) CopyProgress(ctx context.Context) ([]CopyProgress, error) {
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/copy-progress",
+	}
+
+	// Execute GET on /minio/admin/v3/copy-progress
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var copies []CopyProgress
+	if err = json.Unmarshal(b, &copies); err != nil {
+		return nil, err
+	}
+
+	return copies, nil
+}
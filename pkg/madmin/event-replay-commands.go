@@ -0,0 +1,92 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ReplayEventsOptions - selects the logged bucket events to replay.
+type ReplayEventsOptions struct {
+	Bucket string
+	Prefix string
+
+	// Events emitted between Start and End are replayed, End
+	// defaults to now.
+	Start time.Time
+	End   time.Time
+
+	// ARN of the notification target the events are sent to,
+	// e.g. "arn:minio:sqs::1:webhook".
+	ARN string
+}
+
+// ReplayEventsResult - outcome of an event replay.
+type ReplayEventsResult struct {
+	Replayed int `json:"replayed"`
+
+	// Set if the replay stopped before all the events were sent.
+	Error string `json:"error,omitempty"`
+}
+
+// ReplayEvents - sends the bucket events logged by the server again to
+// a notification target, so its consumers can catch up on the events
+// they missed. Only the events the notification rules of the bucket
+// send to the target are replayed. The number of events replayed is
+// returned along with an error if the replay stopped early.
+func (adm *AdminClient) ReplayEvents(ctx context.Context, opts ReplayEventsOptions) (result ReplayEventsResult, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", opts.Bucket)
+	queryValues.Set("prefix", opts.Prefix)
+	queryValues.Set("arn", opts.ARN)
+	queryValues.Set("start", opts.Start.Format(time.RFC3339Nano))
+	if !opts.End.IsZero() {
+		queryValues.Set("end", opts.End.Format(time.RFC3339Nano))
+	}
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/replay-events",
+		queryValues: queryValues,
+	}
+
+	// Execute POST on /minio/admin/v3/replay-events
+	resp, err := adm.executeMethod(ctx, http.MethodPost, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return result, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return result, httpRespToErrorResponse(resp)
+	}
+
+	// The server sends whitespace to keep the
+	// connection alive until the replay is done.
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return result, err
+	}
+	if result.Error != "" {
+		return result, errors.New(result.Error)
+	}
+	return result, nil
+}
@@ -84,6 +84,10 @@ type HealTaskStatus struct {
 	StartTime     time.Time `json:"startTime"`
 	HealSettings  HealOpts  `json:"settings"`
 
+	// ScanRate is the number of items scanned per second,
+	// sampled over the last minutes.
+	ScanRate float64 `json:"scanRate,omitempty"`
+
 	Items []HealResultItem `json:"items,omitempty"`
 }
 
@@ -300,6 +304,10 @@ func (adm *AdminClient) Heal(ctx context.Context, bucket, prefix string,
 type BgHealState struct {
 	ScannedItemsCount int64
 
+	// ScanRate is the number of items scanned per second by all
+	// servers, sampled over the last minutes.
+	ScanRate float64 `json:"scan_rate,omitempty"`
+
 	HealDisks []string
 
 	// SetStatus contains information for each set.
@@ -314,6 +322,12 @@ type SetStatus struct {
 	HealStatus   string `json:"heal_status"`
 	HealPriority string `json:"heal_priority"`
 	Disks        []Disk `json:"disks"`
+
+	// Throughput of the healing drives of the set, and estimated
+	// time until they are healed, see EstimateHealProgress.
+	ObjectsPerSec float64       `json:"objects_per_sec,omitempty"`
+	BytesPerSec   float64       `json:"bytes_per_sec,omitempty"`
+	ETA           time.Duration `json:"eta,omitempty"`
 }
 
 // HealingDisk contains information about
@@ -350,6 +364,7 @@ type HealingDisk struct {
 func (b *BgHealState) Merge(others ...BgHealState) {
 	for _, other := range others {
 		b.ScannedItemsCount += other.ScannedItemsCount
+		b.ScanRate += other.ScanRate
 		if len(b.Sets) == 0 {
 			b.Sets = make([]SetStatus, len(other.Sets))
 			copy(b.Sets, other.Sets)
@@ -391,6 +406,47 @@ func (b *BgHealState) Merge(others ...BgHealState) {
 	})
 }
 
+// EstimateHealProgress computes the heal throughput of each set from
+// the heal info of its healing drives. The time remaining is estimated
+// from the space used on the healing drives, relative to the most used
+// drive of the set which is not healing.
+func (b *BgHealState) EstimateHealProgress() {
+	now := time.Now()
+	for i := range b.Sets {
+		set := &b.Sets[i]
+		set.ObjectsPerSec, set.BytesPerSec, set.ETA = 0, 0, 0
+
+		var used uint64
+		for _, disk := range set.Disks {
+			if !disk.Healing && disk.State == DriveStateOk && disk.UsedSpace > used {
+				used = disk.UsedSpace
+			}
+		}
+
+		for _, disk := range set.Disks {
+			info := disk.HealInfo
+			if !disk.Healing || info == nil || info.Started.IsZero() {
+				continue
+			}
+			if elapsed := info.LastUpdate.Sub(info.Started).Seconds(); elapsed > 0 {
+				set.ObjectsPerSec += float64(info.ObjectsHealed) / elapsed
+				set.BytesPerSec += float64(info.BytesDone) / elapsed
+			}
+			if used == 0 || disk.UsedSpace == 0 {
+				continue
+			}
+			done := float64(disk.UsedSpace) / float64(used)
+			if done >= 1 {
+				continue
+			}
+			elapsed := now.Sub(info.Started)
+			if eta := time.Duration(float64(elapsed) * (1 - done) / done); eta > set.ETA {
+				set.ETA = eta
+			}
+		}
+	}
+}
+
 // BackgroundHealStatus returns the background heal status of the
 // current server or cluster.
 func (adm *AdminClient) BackgroundHealStatus(ctx context.Context) (BgHealState, error) {
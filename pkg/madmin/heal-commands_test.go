@@ -19,6 +19,7 @@ package madmin
 
 import (
 	"testing"
+	"time"
 )
 
 // Tests heal drives missing and offline counts.
@@ -71,3 +72,47 @@ func TestHealDriveCounts(t *testing.T) {
 		t.Errorf("Expected '4', got %d after missing disks", i)
 	}
 }
+
+// Tests the heal throughput and time remaining of healing sets.
+func TestEstimateHealProgress(t *testing.T) {
+	started := time.Now().Add(-time.Hour)
+	state := BgHealState{
+		Sets: []SetStatus{
+			{
+				ID: "0-0",
+				Disks: []Disk{
+					{State: DriveStateOk, UsedSpace: 1000},
+					{State: DriveStateOk, UsedSpace: 800},
+					{State: DriveStateOk, UsedSpace: 250, Healing: true, HealInfo: &HealingDisk{
+						Started:       started,
+						LastUpdate:    started.Add(100 * time.Second),
+						ObjectsHealed: 500,
+						BytesDone:     2000,
+					}},
+				},
+			},
+			{
+				ID: "0-1",
+				Disks: []Disk{
+					{State: DriveStateOk, UsedSpace: 1000},
+					{State: DriveStateOk, UsedSpace: 1000},
+				},
+			},
+		},
+	}
+	state.EstimateHealProgress()
+
+	healing := state.Sets[0]
+	if healing.ObjectsPerSec != 5 || healing.BytesPerSec != 20 {
+		t.Errorf("Expected 5 objects/s and 20 bytes/s, got %v and %v", healing.ObjectsPerSec, healing.BytesPerSec)
+	}
+	// A quarter healed in an hour, three more hours to go.
+	if healing.ETA < 3*time.Hour || healing.ETA > 3*time.Hour+time.Minute {
+		t.Errorf("Expected an ETA of 3h, got %v", healing.ETA)
+	}
+
+	healthy := state.Sets[1]
+	if healthy.ObjectsPerSec != 0 || healthy.BytesPerSec != 0 || healthy.ETA != 0 {
+		t.Errorf("Expected no heal progress, got %#v", healthy)
+	}
+}
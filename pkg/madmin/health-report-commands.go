@@ -0,0 +1,85 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// HealthReportInfo - a health report collected by the server
+// as configured in the diagnostics configuration.
+type HealthReportInfo struct {
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+	Size    int64     `json:"size"`
+}
+
+// ListHealthReports - lists the health reports kept by the
+// server, oldest first.
+func (adm *AdminClient) ListHealthReports(ctx context.Context) ([]HealthReportInfo, error) {
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/health-reports",
+	}
+
+	// Execute GET on /minio/admin/v3/health-reports
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	var reports []HealthReportInfo
+	if err = json.NewDecoder(resp.Body).Decode(&reports); err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+// GetHealthReport - fetches the health report name.
+func (adm *AdminClient) GetHealthReport(ctx context.Context, name string) (HealthInfo, error) {
+	queryValues := url.Values{}
+	queryValues.Set("name", name)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/health-report",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/health-report
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return HealthInfo{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return HealthInfo{}, httpRespToErrorResponse(resp)
+	}
+
+	var info HealthInfo
+	err = json.NewDecoder(resp.Body).Decode(&info)
+	return info, err
+}
@@ -146,6 +146,18 @@ type BucketUsageInfo struct {
 
 	ObjectsCount         uint64            `json:"objectsCount"`
 	ObjectSizesHistogram map[string]uint64 `json:"objectsSizesHistogram"`
+
+	// Object versions per storage class, and per remote tier
+	// for the versions transitioned to a tier.
+	StorageClasses map[string]TierStats `json:"storageClasses,omitempty"`
+	Tiers          map[string]TierStats `json:"tiers,omitempty"`
+}
+
+// TierStats - total size and number of object versions
+// of a storage class or of a remote tier.
+type TierStats struct {
+	Size         uint64 `json:"size"`
+	ObjectsCount uint64 `json:"objectsCount"`
 }
 
 // DataUsageInfo represents data usage stats of the underlying Object API
@@ -318,14 +330,31 @@ type ServerProperties struct {
 	Disks      []Disk            `json:"drives,omitempty"`
 	PoolNumber int               `json:"poolNumber,omitempty"`
 	MemStats   runtime.MemStats  `json:"mem_stats"`
+	// Maintenance is set if the server is in maintenance mode
+	// and rejects writes.
+	Maintenance bool `json:"maintenance,omitempty"`
 }
 
 // DiskMetrics has the information about XL Storage APIs
 // the number of calls of each API and the moving average of
-// the duration of each API.
+// the duration of each API, and the statistics of the drive
+// over the last minute.
 type DiskMetrics struct {
 	APILatencies map[string]string `json:"apiLatencies,omitempty"`
 	APICalls     map[string]uint64 `json:"apiCalls,omitempty"`
+
+	// Percentiles of the duration of reads and writes,
+	// keyed by percentile: "p50", "p90" and "p99".
+	ReadLatencies  map[string]time.Duration `json:"readLatencies,omitempty"`
+	WriteLatencies map[string]time.Duration `json:"writeLatencies,omitempty"`
+	// Number of calls in progress.
+	QueueDepth uint64 `json:"queueDepth"`
+	// Percentage of time at least one call was in progress.
+	Utilization float64 `json:"utilization"`
+	// Number of calls which failed since the server started.
+	TotalErrors uint64 `json:"totalErrors"`
+	// Number of bitrot errors detected since the server started.
+	BitrotErrors uint64 `json:"bitrotErrors"`
 }
 
 // Disk holds Disk information
@@ -0,0 +1,88 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// LogLevel - logging verbosity of a server subsystem.
+type LogLevel string
+
+// Supported log levels.
+const (
+	LogLevelInfo  LogLevel = "info"
+	LogLevelDebug LogLevel = "debug"
+)
+
+// NodeLogLevels - log level of every subsystem of a server.
+type NodeLogLevels struct {
+	Node       string              `json:"node"`
+	Subsystems map[string]LogLevel `json:"subsystems,omitempty"`
+	Error      string              `json:"error,omitempty"`
+}
+
+// SetLogLevel - sets the log level of a subsystem (iam, replication, heal,
+// storage, scanner or listing) on the given nodes, all subsystems if subsys
+// is empty. The level is set on all nodes when none are given. It returns
+// the resulting log levels of the nodes.
+func (adm *AdminClient) SetLogLevel(ctx context.Context, subsys string, level LogLevel, nodes ...string) ([]NodeLogLevels, error) {
+	v := url.Values{}
+	v.Set("subsys", subsys)
+	v.Set("level", string(level))
+	if len(nodes) > 0 {
+		v.Set("nodes", strings.Join(nodes, ","))
+	}
+	return adm.logLevels(ctx, http.MethodPost, v)
+}
+
+// GetLogLevels - returns the log level of every subsystem on all nodes.
+func (adm *AdminClient) GetLogLevels(ctx context.Context) ([]NodeLogLevels, error) {
+	return adm.logLevels(ctx, http.MethodGet, nil)
+}
+
+func (adm *AdminClient) logLevels(ctx context.Context, method string, v url.Values) ([]NodeLogLevels, error) {
+	// Execute GET or POST on /minio/admin/v3/log-level
+	resp, err := adm.executeMethod(ctx, method, requestData{
+		relPath:     adminAPIPrefix + "/log-level",
+		queryValues: v,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var levels []NodeLogLevels
+	if err = json.Unmarshal(b, &levels); err != nil {
+		return nil, err
+	}
+	return levels, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// MaintenanceStatus - nodes and pools in maintenance mode. Nodes in
+// maintenance reject all writes sent to them, pools in maintenance
+// reject writes to the objects they store and receive no new objects.
+type MaintenanceStatus struct {
+	// Nodes are the host:port of the servers in maintenance.
+	Nodes []string `json:"nodes"`
+	// Pools are the indexes of the pools in maintenance.
+	Pools []int `json:"pools"`
+}
+
+// MaintenanceStatus - returns the nodes and pools in maintenance mode.
+func (adm *AdminClient) MaintenanceStatus(ctx context.Context) (MaintenanceStatus, error) {
+	return adm.maintenance(ctx, http.MethodGet, nil)
+}
+
+// SetNodeMaintenance - puts the node, given as host:port, into maintenance
+// mode or takes it out of it, and returns the resulting state.
+func (adm *AdminClient) SetNodeMaintenance(ctx context.Context, node string, enable bool) (MaintenanceStatus, error) {
+	v := url.Values{}
+	v.Set("node", node)
+	v.Set("enable", strconv.FormatBool(enable))
+	return adm.maintenance(ctx, http.MethodPost, v)
+}
+
+// SetPoolMaintenance - puts the pool with the given index into maintenance
+// mode or takes it out of it, and returns the resulting state.
+func (adm *AdminClient) SetPoolMaintenance(ctx context.Context, pool int, enable bool) (MaintenanceStatus, error) {
+	v := url.Values{}
+	v.Set("pool", strconv.Itoa(pool))
+	v.Set("enable", strconv.FormatBool(enable))
+	return adm.maintenance(ctx, http.MethodPost, v)
+}
+
+func (adm *AdminClient) maintenance(ctx context.Context, method string, v url.Values) (status MaintenanceStatus, err error) {
+	// Execute GET or POST on /minio/admin/v3/maintenance
+	resp, err := adm.executeMethod(ctx, method, requestData{
+		relPath:     adminAPIPrefix + "/maintenance",
+		queryValues: v,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return status, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return status, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return status, err
+	}
+	err = json.Unmarshal(b, &status)
+	return status, err
+}
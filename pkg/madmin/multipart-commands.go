@@ -0,0 +1,152 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// MultipartUploadInfo describes an in-progress multipart upload.
+// Bucket and Object are empty for uploads started before servers
+// recorded them, such uploads are only removed once they expire.
+type MultipartUploadInfo struct {
+	Bucket    string    `json:"bucket,omitempty"`
+	Object    string    `json:"object,omitempty"`
+	UploadID  string    `json:"uploadID"`
+	Initiated time.Time `json:"initiated"`
+	Initiator string    `json:"initiator,omitempty"`
+	Parts     int       `json:"parts"`
+	Size      int64     `json:"size"`
+}
+
+// ListMultipartUploadsOptions selects the multipart uploads listed.
+type ListMultipartUploadsOptions struct {
+	// Only list the uploads of this bucket, all buckets if empty.
+	Bucket string
+	// Only list the uploads of objects with this prefix.
+	Prefix string
+	// Only list the uploads initiated at least this long ago.
+	OlderThan time.Duration
+	// Maximum number of uploads listed, defaults to 1000.
+	MaxUploads int
+}
+
+// ListMultipartUploadsResult holds the oldest multipart uploads
+// selected, Truncated is set if there are more.
+type ListMultipartUploadsResult struct {
+	Uploads   []MultipartUploadInfo `json:"uploads"`
+	Truncated bool                  `json:"truncated"`
+}
+
+// AbortMultipartUploadError describes a multipart upload which
+// could not be aborted.
+type AbortMultipartUploadError struct {
+	Bucket   string `json:"bucket"`
+	Object   string `json:"object"`
+	UploadID string `json:"uploadID"`
+	Error    string `json:"error"`
+}
+
+// AbortMultipartUploadsResult holds the outcome of a forced abort.
+type AbortMultipartUploadsResult struct {
+	Aborted int                         `json:"aborted"`
+	Errors  []AbortMultipartUploadError `json:"errors,omitempty"`
+}
+
+// ListMultipartUploads - lists the in-progress multipart uploads of
+// all buckets of the cluster, oldest first.
+func (adm *AdminClient) ListMultipartUploads(ctx context.Context, opts ListMultipartUploadsOptions) (result ListMultipartUploadsResult, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", opts.Bucket)
+	queryValues.Set("prefix", opts.Prefix)
+	if opts.OlderThan > 0 {
+		queryValues.Set("older-than", opts.OlderThan.String())
+	}
+	if opts.MaxUploads > 0 {
+		queryValues.Set("max-uploads", strconv.Itoa(opts.MaxUploads))
+	}
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/list-multipart-uploads",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/list-multipart-uploads
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return result, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return result, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return result, err
+	}
+	if err = json.Unmarshal(b, &result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// AbortMultipartUploads - forcibly aborts the given multipart uploads,
+// only their Bucket, Object and UploadID are used.
+func (adm *AdminClient) AbortMultipartUploads(ctx context.Context, uploads []MultipartUploadInfo) (result AbortMultipartUploadsResult, err error) {
+	data, err := json.Marshal(uploads)
+	if err != nil {
+		return result, err
+	}
+
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/abort-multipart-uploads",
+		content: data,
+	}
+
+	// Execute POST on /minio/admin/v3/abort-multipart-uploads
+	resp, err := adm.executeMethod(ctx, http.MethodPost, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return result, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return result, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return result, err
+	}
+	if err = json.Unmarshal(b, &result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,94 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// NotificationTargetStatus - connection and delivery state
+// of a notification target on a server.
+type NotificationTargetStatus struct {
+	Node string `json:"node"`
+	// Target is the target ID, e.g. "1:kafka".
+	Target string `json:"target"`
+	Online bool   `json:"online"`
+	// Error is why the target is offline.
+	Error string `json:"error,omitempty"`
+	// LastError is the last error delivering an event.
+	LastError     string    `json:"lastError,omitempty"`
+	LastErrorTime time.Time `json:"lastErrorTime,omitempty"`
+	LastDelivery  time.Time `json:"lastDelivery,omitempty"`
+	QueueStore    bool      `json:"queueStore"`
+	QueuedEvents  int       `json:"queuedEvents"`
+}
+
+// NotificationTargetsStatus - returns the state of the notification
+// targets on all servers. Checking the state connects targets that
+// are not connected yet.
+func (adm *AdminClient) NotificationTargetsStatus(ctx context.Context) ([]NotificationTargetStatus, error) {
+	return adm.notificationTargets(ctx, http.MethodGet, "", nil)
+}
+
+// ReconnectNotificationTarget - connects the notification target, e.g.
+// "1:kafka", on all servers where it is not connected and returns its
+// resulting state.
+func (adm *AdminClient) ReconnectNotificationTarget(ctx context.Context, target string) ([]NotificationTargetStatus, error) {
+	v := url.Values{}
+	v.Set("target", target)
+	return adm.notificationTargets(ctx, http.MethodPost, "/reconnect", v)
+}
+
+// SendTestNotification - sends an s3:TestEvent event to the notification
+// target, e.g. "1:kafka", from all servers and returns its resulting state.
+func (adm *AdminClient) SendTestNotification(ctx context.Context, target string) ([]NotificationTargetStatus, error) {
+	v := url.Values{}
+	v.Set("target", target)
+	return adm.notificationTargets(ctx, http.MethodPost, "/test", v)
+}
+
+func (adm *AdminClient) notificationTargets(ctx context.Context, method, path string, v url.Values) ([]NotificationTargetStatus, error) {
+	// Execute GET or POST on /minio/admin/v3/notification-targets
+	resp, err := adm.executeMethod(ctx, method, requestData{
+		relPath:     adminAPIPrefix + "/notification-targets" + path,
+		queryValues: v,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var targets []NotificationTargetStatus
+	if err = json.Unmarshal(b, &targets); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
@@ -0,0 +1,93 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ObjectLockBucketReport - summary of the object versions in a bucket
+// which are currently protected by retention or legal hold.
+type ObjectLockBucketReport struct {
+	Bucket string `json:"bucket"`
+
+	// Default retention configured on the bucket, if any.
+	DefaultMode     string `json:"defaultMode,omitempty"`
+	DefaultValidity string `json:"defaultValidity,omitempty"`
+
+	// Number of object versions scanned.
+	Versions uint64 `json:"versions"`
+
+	// Number of object versions under active retention.
+	Governance uint64 `json:"governance"`
+	Compliance uint64 `json:"compliance"`
+
+	// Number of object versions with legal hold on.
+	LegalHold uint64 `json:"legalHold"`
+
+	// Range of retain-until dates across all versions
+	// under active retention.
+	EarliestRetainUntil *time.Time `json:"earliestRetainUntil,omitempty"`
+	LatestRetainUntil   *time.Time `json:"latestRetainUntil,omitempty"`
+}
+
+// ObjectLockReport - object lock compliance report of one or more buckets.
+type ObjectLockReport struct {
+	Generated time.Time                `json:"generated"`
+	Buckets   []ObjectLockBucketReport `json:"buckets"`
+}
+
+// ObjectLockReport - returns a report of the objects under retention
+// or legal hold in bucket, all buckets with object lock enabled are
+// reported if bucket is empty.
+func (adm *AdminClient) ObjectLockReport(ctx context.Context, bucket string) (report ObjectLockReport, err error) {
+	queryValues := url.Values{}
+	if bucket != "" {
+		queryValues.Set("bucket", bucket)
+	}
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/object-lock-report",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/object-lock-report
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return report, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return report, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return report, err
+	}
+	if err = json.Unmarshal(b, &report); err != nil {
+		return report, err
+	}
+	return report, nil
+}
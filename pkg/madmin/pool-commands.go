@@ -0,0 +1,105 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+// ErasureSetStatus - status of a single erasure set.
+type ErasureSetStatus struct {
+	PoolIndex int `json:"pool_index"`
+	SetIndex  int `json:"set_index"`
+
+	DrivesOnline  int `json:"drives_online"`
+	DrivesOffline int `json:"drives_offline"`
+	DrivesHealing int `json:"drives_healing"`
+
+	TotalSpace     uint64 `json:"totalspace"`
+	UsedSpace      uint64 `json:"usedspace"`
+	AvailableSpace uint64 `json:"availspace"`
+
+	ReadQuorum  int `json:"read_quorum"`
+	WriteQuorum int `json:"write_quorum"`
+
+	// Number of additional drives that may go offline before
+	// read/write quorum is lost, negative if quorum is already lost.
+	ReadTolerance  int `json:"read_tolerance"`
+	WriteTolerance int `json:"write_tolerance"`
+
+	// Heal status as reported by the background healer.
+	HealStatus string `json:"heal_status,omitempty"`
+
+	// Drives currently being healed with their progress.
+	Healing []HealingDisk `json:"healing,omitempty"`
+}
+
+// PoolStatus - status of a pool and all its erasure sets.
+type PoolStatus struct {
+	PoolIndex int `json:"pool_index"`
+
+	DrivesOnline  int `json:"drives_online"`
+	DrivesOffline int `json:"drives_offline"`
+	DrivesHealing int `json:"drives_healing"`
+
+	TotalSpace     uint64 `json:"totalspace"`
+	UsedSpace      uint64 `json:"usedspace"`
+	AvailableSpace uint64 `json:"availspace"`
+
+	// Lowest tolerance across all the erasure sets of the pool.
+	ReadTolerance  int `json:"read_tolerance"`
+	WriteTolerance int `json:"write_tolerance"`
+
+	// Maintenance is set if the pool is in maintenance mode, its
+	// objects can be read but not modified.
+	Maintenance bool `json:"maintenance"`
+
+	Sets []ErasureSetStatus `json:"sets"`
+}
+
+// PoolsStatus - returns the status of every pool and erasure set
+// in the cluster.
+func (adm *AdminClient) PoolsStatus(ctx context.Context) ([]PoolStatus, error) {
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet,
+		requestData{relPath: adminAPIPrefix + "/pools/status"})
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check response http status code
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	// Unmarshal the server's json response
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []PoolStatus
+	if err = json.Unmarshal(respBytes, &pools); err != nil {
+		return nil, err
+	}
+	return pools, nil
+}
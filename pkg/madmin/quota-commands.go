@@ -44,6 +44,11 @@ func (t QuotaType) IsValid() bool {
 type BucketQuota struct {
 	Quota uint64    `json:"quota"`
 	Type  QuotaType `json:"quotatype,omitempty"`
+
+	// ObjectCount is the maximum number of objects in the bucket,
+	// a hard limit whatever the quota type, zero for no limit.
+	// Versions of an object count as one object.
+	ObjectCount uint64 `json:"objectcount,omitempty"`
 }
 
 // IsValid returns false if quota is invalid
@@ -0,0 +1,92 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// InflightRequest - an S3 request being served by a server.
+type InflightRequest struct {
+	// ID is the x-amz-request-id of the request.
+	ID string `json:"id"`
+	// Node is the server serving the request.
+	Node       string `json:"node"`
+	API        string `json:"api"`
+	Bucket     string `json:"bucket,omitempty"`
+	Object     string `json:"object,omitempty"`
+	AccessKey  string `json:"accessKey,omitempty"`
+	RemoteHost string `json:"remoteHost"`
+
+	StartTime     time.Time     `json:"startTime"`
+	Elapsed       time.Duration `json:"elapsed"`
+	BytesReceived int64         `json:"bytesReceived"`
+	BytesSent     int64         `json:"bytesSent"`
+}
+
+// InflightRequests - returns the S3 requests being served by all
+// servers, the longest running first.
+func (adm *AdminClient) InflightRequests(ctx context.Context) ([]InflightRequest, error) {
+	// Execute GET on /minio/admin/v3/requests
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+		relPath: adminAPIPrefix + "/requests",
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var reqs []InflightRequest
+	err = json.Unmarshal(b, &reqs)
+	return reqs, err
+}
+
+// CancelRequest - cancels the S3 request with the given ID on the
+// server serving it, the client of the request sees it failing.
+func (adm *AdminClient) CancelRequest(ctx context.Context, id string) error {
+	queryValues := url.Values{}
+	queryValues.Set("id", id)
+
+	// Execute POST on /minio/admin/v3/requests/cancel
+	resp, err := adm.executeMethod(ctx, http.MethodPost, requestData{
+		relPath:     adminAPIPrefix + "/requests/cancel",
+		queryValues: queryValues,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+	return nil
+}
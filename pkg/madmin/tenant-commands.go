@@ -0,0 +1,138 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio/pkg/auth"
+)
+
+// TenantInfo - describes a tenant, an isolated credential domain
+// of the deployment. The identities of a tenant can only access
+// the buckets whose name starts with BucketPrefix.
+type TenantInfo struct {
+	Name         string    `json:"name"`
+	RootUser     string    `json:"rootUser"`
+	BucketPrefix string    `json:"bucketPrefix"`
+	Created      time.Time `json:"created"`
+}
+
+// AddTenantReq is the request body of the add tenant admin call.
+type AddTenantReq struct {
+	Name      string `json:"name"`
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+}
+
+// AddTenant - creates the tenant name with the given root credential.
+// The root user of a tenant has full access to the buckets of the
+// tenant and manages the users of the tenant with the user APIs.
+func (adm *AdminClient) AddTenant(ctx context.Context, name, accessKey, secretKey string) error {
+	if !auth.IsAccessKeyValid(accessKey) {
+		return auth.ErrInvalidAccessKeyLength
+	}
+
+	if !auth.IsSecretKeyValid(secretKey) {
+		return auth.ErrInvalidSecretKeyLength
+	}
+
+	data, err := json.Marshal(AddTenantReq{
+		Name:      name,
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+	})
+	if err != nil {
+		return err
+	}
+	econfigBytes, err := EncryptData(adm.getSecretKey(), data)
+	if err != nil {
+		return err
+	}
+
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/add-tenant",
+		content: econfigBytes,
+	}
+
+	// Execute PUT on /minio/admin/v3/add-tenant to create a tenant.
+	resp, err := adm.executeMethod(ctx, http.MethodPut, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// RemoveTenant - removes the tenant name and all of its users. A
+// tenant can only be removed once all of its buckets are deleted.
+func (adm *AdminClient) RemoveTenant(ctx context.Context, name string) error {
+	queryValues := url.Values{}
+	queryValues.Set("name", name)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/remove-tenant",
+		queryValues: queryValues,
+	}
+
+	// Execute DELETE on /minio/admin/v3/remove-tenant to remove a tenant.
+	resp, err := adm.executeMethod(ctx, http.MethodDelete, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// ListTenants - lists the tenants of the deployment.
+func (adm *AdminClient) ListTenants(ctx context.Context) ([]TenantInfo, error) {
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/list-tenants",
+	}
+
+	// Execute GET on /minio/admin/v3/list-tenants to list tenants.
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	var tenants []TenantInfo
+	if err = json.NewDecoder(resp.Body).Decode(&tenants); err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
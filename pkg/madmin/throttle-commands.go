@@ -0,0 +1,107 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// BucketThrottle holds the request rate and concurrency limits of
+// a bucket, enforced by each server. Read limits apply to GET and
+// HEAD requests, write limits to all other requests. A limit set
+// to '0' is disabled.
+type BucketThrottle struct {
+	ReadRPS          uint64 `json:"readRPS,omitempty"`
+	WriteRPS         uint64 `json:"writeRPS,omitempty"`
+	ReadConcurrency  uint64 `json:"readConcurrency,omitempty"`
+	WriteConcurrency uint64 `json:"writeConcurrency,omitempty"`
+}
+
+// IsEmpty returns true if no limit is set.
+func (t BucketThrottle) IsEmpty() bool {
+	return t == BucketThrottle{}
+}
+
+// GetBucketThrottle - get the request limits of a bucket
+func (adm *AdminClient) GetBucketThrottle(ctx context.Context, bucket string) (t BucketThrottle, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/get-bucket-throttle",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/get-bucket-throttle
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return t, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return t, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return t, err
+	}
+	if err = json.Unmarshal(b, &t); err != nil {
+		return t, err
+	}
+
+	return t, nil
+}
+
+// SetBucketThrottle - sets the request limits of a bucket, an empty
+// throttle removes all limits.
+func (adm *AdminClient) SetBucketThrottle(ctx context.Context, bucket string, throttle *BucketThrottle) error {
+	data, err := json.Marshal(throttle)
+	if err != nil {
+		return err
+	}
+
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/set-bucket-throttle",
+		queryValues: queryValues,
+		content:     data,
+	}
+
+	// Execute PUT on /minio/admin/v3/set-bucket-throttle to set the request limits of a bucket.
+	resp, err := adm.executeMethod(ctx, http.MethodPut, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
@@ -41,6 +41,11 @@ type LockEntry struct {
 	ID         string    `json:"id"`         // UID to uniquely identify request of client.
 	// Represents quorum number of servers required to hold this lock, used to look for stale locks.
 	Quorum int `json:"quorum"`
+	// Elapsed is how long the lock has been held.
+	Elapsed time.Duration `json:"elapsed"`
+	// LastRefresh is when the owner last confirmed it still holds the lock,
+	// a lock which is refreshed but held for long is wedged on its owner.
+	LastRefresh time.Time `json:"lastRefresh"`
 }
 
 // LockEntries - To sort the locks
@@ -62,13 +67,32 @@ func (l LockEntries) Swap(i, j int) {
 type TopLockOpts struct {
 	Count int
 	Stale bool
+	// OlderThan lists only the locks held for longer than this.
+	OlderThan time.Duration
+}
+
+// ForceUnlockOpts force unlock options
+type ForceUnlockOpts struct {
+	Paths []string
+	// ID releases only the lock with this ID on the paths, as
+	// listed by TopLocks, instead of all the locks on them.
+	ID string
 }
 
 // ForceUnlock force unlocks input paths...
 func (adm *AdminClient) ForceUnlock(ctx context.Context, paths ...string) error {
+	return adm.ForceUnlockWithOpts(ctx, ForceUnlockOpts{Paths: paths})
+}
+
+// ForceUnlockWithOpts - forcibly releases the locks on the given paths,
+// or only the lock with the given ID.
+func (adm *AdminClient) ForceUnlockWithOpts(ctx context.Context, opts ForceUnlockOpts) error {
 	// Execute POST on /minio/admin/v3/force-unlock
 	queryVals := make(url.Values)
-	queryVals.Set("paths", strings.Join(paths, ","))
+	queryVals.Set("paths", strings.Join(opts.Paths, ","))
+	if opts.ID != "" {
+		queryVals.Set("id", opts.ID)
+	}
 	resp, err := adm.executeMethod(ctx,
 		http.MethodPost,
 		requestData{
@@ -97,6 +121,9 @@ func (adm *AdminClient) TopLocksWithOpts(ctx context.Context, opts TopLockOpts)
 	queryVals := make(url.Values)
 	queryVals.Set("count", strconv.Itoa(opts.Count))
 	queryVals.Set("stale", strconv.FormatBool(opts.Stale))
+	if opts.OlderThan > 0 {
+		queryVals.Set("older-than", opts.OlderThan.String())
+	}
 	resp, err := adm.executeMethod(ctx,
 		http.MethodGet,
 		requestData{
@@ -96,6 +96,7 @@ type UserInfo struct {
 	PolicyName string        `json:"policyName,omitempty"`
 	Status     AccountStatus `json:"status"`
 	MemberOf   []string      `json:"memberOf,omitempty"`
+	Tenant     string        `json:"tenant,omitempty"`
 }
 
 // RemoveUser - remove a user.
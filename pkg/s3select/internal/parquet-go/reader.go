@@ -89,10 +89,11 @@ type Reader struct {
 	rowGroups      []*parquet.RowGroup
 	rowGroupIndex  int
 
-	nameList    []string
-	columnNames set.StringSet
-	columns     map[string]*column
-	rowIndex    int64
+	nameList       []string
+	columnNames    set.StringSet
+	columns        map[string]*column
+	rowIndex       int64
+	rowGroupFilter func(rowGroup *parquet.RowGroup) bool
 }
 
 // NewReader - creates new parquet reader. Reader calls getReaderFunc to get required data range for given columnNames. If columnNames is empty, all columns are used.
@@ -117,8 +118,24 @@ func NewReader(getReaderFunc GetReaderFunc, columnNames set.StringSet) (*Reader,
 	}, nil
 }
 
+// SchemaElements - returns the schema of the parquet file.
+func (reader *Reader) SchemaElements() []*parquet.SchemaElement {
+	return reader.schemaElements
+}
+
+// SetRowGroupFilter - sets a function called before reading a row group. If f returns false, the row group is skipped.
+func (reader *Reader) SetRowGroupFilter(f func(rowGroup *parquet.RowGroup) bool) {
+	reader.rowGroupFilter = f
+}
+
 // Read - reads single record.
 func (reader *Reader) Read() (record *Record, err error) {
+	if reader.columns == nil && reader.rowGroupFilter != nil {
+		for reader.rowGroupIndex < len(reader.rowGroups) && !reader.rowGroupFilter(reader.rowGroups[reader.rowGroupIndex]) {
+			reader.rowGroupIndex++
+		}
+	}
+
 	if reader.rowGroupIndex >= len(reader.rowGroups) {
 		return nil, io.EOF
 	}
@@ -133,6 +150,10 @@ func (reader *Reader) Read() (record *Record, err error) {
 		if err != nil {
 			return nil, err
 		}
+		if reader.columns == nil {
+			// None of the columns is in the file.
+			reader.columns = make(map[string]*column)
+		}
 
 		reader.rowIndex = 0
 	}
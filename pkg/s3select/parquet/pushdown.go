@@ -0,0 +1,183 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+
+	parquetgen "github.com/minio/minio/pkg/s3select/internal/parquet-go/gen-go/parquet"
+	"github.com/minio/minio/pkg/s3select/sql"
+)
+
+// rowGroupMatches returns false when the statistics of the row group
+// show none of its rows can satisfy all the predicates.
+func rowGroupMatches(rowGroup *parquetgen.RowGroup, schemaElements []*parquetgen.SchemaElement, predicates []sql.ColumnPredicate) bool {
+	for _, p := range predicates {
+		min, max, ok := columnBounds(rowGroup, schemaElements, p.Column)
+		if !ok {
+			continue
+		}
+
+		var excluded bool
+		switch p.Operator {
+		case "=":
+			c1, ok1 := compareValues(p.Value, min)
+			c2, ok2 := compareValues(p.Value, max)
+			excluded = (ok1 && c1 < 0) || (ok2 && c2 > 0)
+		case "<":
+			c, ok := compareValues(min, p.Value)
+			excluded = ok && c >= 0
+		case "<=":
+			c, ok := compareValues(min, p.Value)
+			excluded = ok && c > 0
+		case ">":
+			c, ok := compareValues(max, p.Value)
+			excluded = ok && c <= 0
+		case ">=":
+			c, ok := compareValues(max, p.Value)
+			excluded = ok && c < 0
+		}
+		if excluded {
+			return false
+		}
+	}
+	return true
+}
+
+// columnBounds returns the minimum and maximum values of a top level
+// column in a row group, as records hold them.
+func columnBounds(rowGroup *parquetgen.RowGroup, schemaElements []*parquetgen.SchemaElement, column string) (min, max *sql.Value, ok bool) {
+	var meta *parquetgen.ColumnMetaData
+	for _, chunk := range rowGroup.GetColumns() {
+		if m := chunk.GetMetaData(); m != nil && len(m.GetPathInSchema()) == 1 && m.GetPathInSchema()[0] == column {
+			meta = m
+			break
+		}
+	}
+	if meta == nil || meta.GetStatistics() == nil {
+		return nil, nil, false
+	}
+	stats := meta.GetStatistics()
+
+	var schema *parquetgen.SchemaElement
+	for _, se := range schemaElements {
+		if se != nil && se.Name == column {
+			schema = se
+			break
+		}
+	}
+	if schema == nil {
+		return nil, nil, false
+	}
+
+	minData, maxData := stats.GetMinValue(), stats.GetMaxValue()
+	if !stats.IsSetMinValue() || !stats.IsSetMaxValue() {
+		// The deprecated fields use signed comparison, only
+		// valid for numbers.
+		if meta.GetType() == parquetgen.Type_BYTE_ARRAY || !stats.IsSetMin() || !stats.IsSetMax() {
+			return nil, nil, false
+		}
+		minData, maxData = stats.GetMin(), stats.GetMax()
+	}
+
+	min, ok = decodeStatValue(meta.GetType(), schema.ConvertedType, minData)
+	if !ok {
+		return nil, nil, false
+	}
+	max, ok = decodeStatValue(meta.GetType(), schema.ConvertedType, maxData)
+	if !ok {
+		return nil, nil, false
+	}
+	return min, max, true
+}
+
+// decodeStatValue decodes a plain encoded statistics value, values
+// the reader converts, such as dates and timestamps, are not decoded.
+func decodeStatValue(t parquetgen.Type, convertedType *parquetgen.ConvertedType, b []byte) (*sql.Value, bool) {
+	switch t {
+	case parquetgen.Type_INT32, parquetgen.Type_INT64, parquetgen.Type_FLOAT, parquetgen.Type_DOUBLE:
+		if convertedType != nil {
+			switch *convertedType {
+			case parquetgen.ConvertedType_INT_8, parquetgen.ConvertedType_INT_16,
+				parquetgen.ConvertedType_INT_32, parquetgen.ConvertedType_INT_64:
+			default:
+				return nil, false
+			}
+		}
+	case parquetgen.Type_BYTE_ARRAY:
+		if convertedType != nil && *convertedType != parquetgen.ConvertedType_UTF8 {
+			return nil, false
+		}
+		return sql.FromString(string(b)), true
+	default:
+		return nil, false
+	}
+
+	switch {
+	case t == parquetgen.Type_INT32 && len(b) == 4:
+		return sql.FromInt(int64(int32(binary.LittleEndian.Uint32(b)))), true
+	case t == parquetgen.Type_INT64 && len(b) == 8:
+		return sql.FromInt(int64(binary.LittleEndian.Uint64(b))), true
+	case t == parquetgen.Type_FLOAT && len(b) == 4:
+		f := float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+		return sql.FromFloat(f), !math.IsNaN(f)
+	case t == parquetgen.Type_DOUBLE && len(b) == 8:
+		f := math.Float64frombits(binary.LittleEndian.Uint64(b))
+		return sql.FromFloat(f), !math.IsNaN(f)
+	}
+	return nil, false
+}
+
+// compareValues compares two numbers or two strings, the same way
+// records are compared in a WHERE clause.
+func compareValues(a, b *sql.Value) (int, bool) {
+	if x, ok := a.ToInt(); ok {
+		if y, ok := b.ToInt(); ok {
+			switch {
+			case x < y:
+				return -1, true
+			case x > y:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if x, ok := a.ToFloat(); ok {
+		if y, ok := b.ToFloat(); ok {
+			switch {
+			case x < y:
+				return -1, true
+			case x > y:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if x, ok := a.ToString(); ok {
+		if y, ok := b.ToString(); ok {
+			switch {
+			case x < y:
+				return -1, true
+			case x > y:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	return 0, false
+}
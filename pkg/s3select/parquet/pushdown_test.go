@@ -0,0 +1,89 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"encoding/binary"
+	"testing"
+
+	parquetgen "github.com/minio/minio/pkg/s3select/internal/parquet-go/gen-go/parquet"
+	"github.com/minio/minio/pkg/s3select/sql"
+)
+
+func TestRowGroupMatches(t *testing.T) {
+	int64Stat := func(v int64) []byte {
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, uint64(v))
+		return b
+	}
+	date := parquetgen.ConvertedType_DATE
+	rowGroup := &parquetgen.RowGroup{
+		Columns: []*parquetgen.ColumnChunk{
+			{MetaData: &parquetgen.ColumnMetaData{
+				Type:         parquetgen.Type_INT64,
+				PathInSchema: []string{"id"},
+				Statistics:   &parquetgen.Statistics{MinValue: int64Stat(-10), MaxValue: int64Stat(20)},
+			}},
+			{MetaData: &parquetgen.ColumnMetaData{
+				Type:         parquetgen.Type_BYTE_ARRAY,
+				PathInSchema: []string{"name"},
+				Statistics:   &parquetgen.Statistics{MinValue: []byte("bar"), MaxValue: []byte("foo")},
+			}},
+			{MetaData: &parquetgen.ColumnMetaData{
+				Type:         parquetgen.Type_INT32,
+				PathInSchema: []string{"day"},
+				Statistics:   &parquetgen.Statistics{MinValue: []byte{0, 0, 0, 0}, MaxValue: []byte{1, 0, 0, 0}},
+			}},
+		},
+	}
+	schemaElements := []*parquetgen.SchemaElement{
+		{Name: "schema"},
+		{Name: "id"},
+		{Name: "name"},
+		{Name: "day", ConvertedType: &date},
+	}
+
+	testCases := []struct {
+		predicates []sql.ColumnPredicate
+		matches    bool
+	}{
+		{nil, true},
+		{[]sql.ColumnPredicate{{Column: "id", Operator: "=", Value: sql.FromInt(5)}}, true},
+		{[]sql.ColumnPredicate{{Column: "id", Operator: "=", Value: sql.FromInt(21)}}, false},
+		{[]sql.ColumnPredicate{{Column: "id", Operator: "<", Value: sql.FromInt(-10)}}, false},
+		{[]sql.ColumnPredicate{{Column: "id", Operator: "<=", Value: sql.FromInt(-10)}}, true},
+		{[]sql.ColumnPredicate{{Column: "id", Operator: ">", Value: sql.FromFloat(19.5)}}, true},
+		{[]sql.ColumnPredicate{{Column: "id", Operator: ">=", Value: sql.FromFloat(20.5)}}, false},
+		{[]sql.ColumnPredicate{{Column: "name", Operator: "=", Value: sql.FromString("baz")}}, true},
+		{[]sql.ColumnPredicate{{Column: "name", Operator: ">", Value: sql.FromString("foo")}}, false},
+		// Values of different types are not compared.
+		{[]sql.ColumnPredicate{{Column: "name", Operator: ">", Value: sql.FromInt(1)}}, true},
+		// Dates are read as timestamps.
+		{[]sql.ColumnPredicate{{Column: "day", Operator: ">", Value: sql.FromInt(5)}}, true},
+		// Columns without statistics.
+		{[]sql.ColumnPredicate{{Column: "other", Operator: ">", Value: sql.FromInt(5)}}, true},
+		{[]sql.ColumnPredicate{
+			{Column: "id", Operator: ">", Value: sql.FromInt(0)},
+			{Column: "name", Operator: "<", Value: sql.FromString("bar")},
+		}, false},
+	}
+	for i, testCase := range testCases {
+		if matches := rowGroupMatches(rowGroup, schemaElements, testCase.predicates); matches != testCase.matches {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.matches, matches)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/bcicen/jstream"
+	"github.com/minio/minio-go/v7/pkg/set"
 	parquetgo "github.com/minio/minio/pkg/s3select/internal/parquet-go"
 	parquetgen "github.com/minio/minio/pkg/s3select/internal/parquet-go/gen-go/parquet"
 	jsonfmt "github.com/minio/minio/pkg/s3select/json"
@@ -114,14 +115,21 @@ func (r *Reader) Close() error {
 	return r.reader.Close()
 }
 
-// NewReader - creates new Parquet reader using readerFunc callback.
-func NewReader(getReaderFunc func(offset, length int64) (io.ReadCloser, error), args *ReaderArgs) (r *Reader, err error) {
+// NewReader - creates new Parquet reader using readerFunc callback. Only
+// the columns referenced by stmt are read, and the row groups in which
+// no row can pass its WHERE clause are skipped.
+func NewReader(getReaderFunc func(offset, length int64) (io.ReadCloser, error), args *ReaderArgs, stmt *sql.SelectStatement) (r *Reader, err error) {
 	defer func() {
 		if rec := recover(); rec != nil {
 			err = fmt.Errorf("panic reading parquet header: %v", rec)
 		}
 	}()
-	reader, err := parquetgo.NewReader(getReaderFunc, nil)
+
+	var columnNames set.StringSet
+	if columns := stmt.Columns(); columns != nil {
+		columnNames = set.CreateStringSet(columns...)
+	}
+	reader, err := parquetgo.NewReader(getReaderFunc, columnNames)
 	if err != nil {
 		if err != io.EOF {
 			return nil, errParquetParsingError(err)
@@ -130,6 +138,13 @@ func NewReader(getReaderFunc func(offset, length int64) (io.ReadCloser, error),
 		return nil, err
 	}
 
+	if predicates := stmt.WherePredicates(); len(predicates) > 0 {
+		schemaElements := reader.SchemaElements()
+		reader.SetRowGroupFilter(func(rowGroup *parquetgen.RowGroup) bool {
+			return rowGroupMatches(rowGroup, schemaElements, predicates)
+		})
+	}
+
 	return &Reader{
 		args:   args,
 		reader: reader,
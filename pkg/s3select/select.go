@@ -343,7 +343,7 @@ func (s3Select *S3Select) Open(getReader func(offset, length int64) (io.ReadClos
 			return errors.New("parquet format parsing not enabled on server")
 		}
 		var err error
-		s3Select.recordReader, err = parquet.NewReader(getReader, &s3Select.Input.ParquetArgs)
+		s3Select.recordReader, err = parquet.NewReader(getReader, &s3Select.Input.ParquetArgs, s3Select.statement)
 		return err
 	}
 
@@ -0,0 +1,300 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import "sort"
+
+// Column analysis - Columnar formats such as Parquet can avoid reading
+// the columns not referenced by the query, and the parts of the input
+// in which no record can pass the WHERE clause.
+
+// ColumnPredicate is a comparison of a column with a literal value
+// which holds for all the records passing the WHERE clause.
+type ColumnPredicate struct {
+	Column string
+	// Operator is one of "=", "<", "<=", ">" and ">=".
+	Operator string
+	Value    *Value
+}
+
+// Columns returns the sorted names of the top level columns referenced
+// by the query, or nil when the query needs all of them.
+func (e *SelectStatement) Columns() []string {
+	if e.selectAST.Expression.All || len(e.selectAST.From.Table.PathExpr) > 0 {
+		return nil
+	}
+
+	all := false
+	columns := make(map[string]struct{})
+	visit := func(p *JSONPath) {
+		column, ok := e.pathColumn(p)
+		if !ok {
+			all = true
+			return
+		}
+		columns[column] = struct{}{}
+	}
+	e.selectAST.Expression.walkPaths(visit)
+	if e.selectAST.Where != nil {
+		e.selectAST.Where.walkPaths(visit)
+	}
+	if all || len(columns) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(columns))
+	for column := range columns {
+		names = append(names, column)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WherePredicates returns the comparisons of a column with a literal
+// value the WHERE clause requires, other conditions are ignored.
+func (e *SelectStatement) WherePredicates() (predicates []ColumnPredicate) {
+	where := e.selectAST.Where
+	if where == nil || len(where.And) != 1 || len(e.selectAST.From.Table.PathExpr) > 0 {
+		return nil
+	}
+
+	for _, cond := range where.And[0].Condition {
+		if cond.Operand == nil || cond.Operand.ConditionRHS == nil {
+			continue
+		}
+		rhs := cond.Operand.ConditionRHS
+		switch {
+		case rhs.Compare != nil:
+			op, ok := comparisonOperators[rhs.Compare.Operator]
+			if !ok {
+				continue
+			}
+			if column, ok := e.operandColumn(cond.Operand.Operand); ok {
+				if value, ok := operandLiteral(rhs.Compare.Operand); ok {
+					predicates = append(predicates, ColumnPredicate{Column: column, Operator: rhs.Compare.Operator, Value: value})
+				}
+			} else if column, ok := e.operandColumn(rhs.Compare.Operand); ok {
+				if value, ok := operandLiteral(cond.Operand.Operand); ok {
+					predicates = append(predicates, ColumnPredicate{Column: column, Operator: op, Value: value})
+				}
+			}
+
+		case rhs.Between != nil && !rhs.Between.Not:
+			column, ok := e.operandColumn(cond.Operand.Operand)
+			if !ok {
+				continue
+			}
+			if start, ok := operandLiteral(rhs.Between.Start); ok {
+				predicates = append(predicates, ColumnPredicate{Column: column, Operator: opGte, Value: start})
+			}
+			if end, ok := operandLiteral(rhs.Between.End); ok {
+				predicates = append(predicates, ColumnPredicate{Column: column, Operator: opLte, Value: end})
+			}
+		}
+	}
+	return predicates
+}
+
+// Comparison operators which can be pushed down, mapped to the
+// operator obtained by swapping the operands.
+var comparisonOperators = map[string]string{
+	opEq:  opEq,
+	opLt:  opGt,
+	opLte: opGte,
+	opGt:  opLt,
+	opGte: opLte,
+}
+
+// pathColumn returns the top level column a path expression refers
+// to, the same way path expressions are evaluated.
+func (e *SelectStatement) pathColumn(p *JSONPath) (string, bool) {
+	alias := e.tableAlias
+	if alias == "" {
+		alias = baseTableName
+	}
+	pathExpr := p.StripTableAlias(alias)
+	if len(pathExpr) == 0 {
+		return p.BaseKey.String(), true
+	}
+	if pathExpr[0].Key == nil {
+		return "", false
+	}
+	return pathExpr[0].Key.keyString(), true
+}
+
+// operandColumn returns the column of an operand consisting only of a
+// column without nested path.
+func (e *SelectStatement) operandColumn(o *Operand) (string, bool) {
+	if len(o.Right) > 0 || len(o.Left.Right) > 0 || o.Left.Left.Primary == nil {
+		return "", false
+	}
+	p := o.Left.Left.Primary.JPathExpr
+	if p == nil {
+		return "", false
+	}
+	alias := e.tableAlias
+	if alias == "" {
+		alias = baseTableName
+	}
+	if len(p.StripTableAlias(alias)) > 1 {
+		return "", false
+	}
+	return e.pathColumn(p)
+}
+
+// operandLiteral returns the value of an operand consisting only of a
+// number or string literal.
+func operandLiteral(o *Operand) (*Value, bool) {
+	if len(o.Right) > 0 || len(o.Left.Right) > 0 {
+		return nil, false
+	}
+	term := o.Left.Left.Primary
+	negated := o.Left.Left.Negated != nil
+	if negated {
+		term = o.Left.Left.Negated.Term
+	}
+	if term == nil || term.Value == nil {
+		return nil, false
+	}
+	lit := term.Value
+	if lit.Int == nil && lit.Float == nil && (lit.String == nil || negated) {
+		return nil, false
+	}
+	v, err := lit.evalNode(nil)
+	if err != nil {
+		return nil, false
+	}
+	if negated {
+		v.negate()
+	}
+	return v, true
+}
+
+// walkPaths calls visit for each path expression of the tree.
+
+func (e *SelectExpression) walkPaths(visit func(*JSONPath)) {
+	for _, ex := range e.Expressions {
+		ex.Expression.walkPaths(visit)
+	}
+}
+
+func (e *Expression) walkPaths(visit func(*JSONPath)) {
+	for _, ac := range e.And {
+		for _, cond := range ac.Condition {
+			cond.walkPaths(visit)
+		}
+	}
+}
+
+func (e *Condition) walkPaths(visit func(*JSONPath)) {
+	if e.Not != nil {
+		e.Not.walkPaths(visit)
+		return
+	}
+	e.Operand.Operand.walkPaths(visit)
+	rhs := e.Operand.ConditionRHS
+	switch {
+	case rhs == nil:
+	case rhs.Compare != nil:
+		rhs.Compare.Operand.walkPaths(visit)
+	case rhs.Between != nil:
+		rhs.Between.Start.walkPaths(visit)
+		rhs.Between.End.walkPaths(visit)
+	case rhs.In != nil:
+		rhs.In.ListExpression.walkPaths(visit)
+	case rhs.Like != nil:
+		rhs.Like.Pattern.walkPaths(visit)
+		if rhs.Like.EscapeChar != nil {
+			rhs.Like.EscapeChar.walkPaths(visit)
+		}
+	}
+}
+
+func (e *Operand) walkPaths(visit func(*JSONPath)) {
+	if e == nil {
+		return
+	}
+	e.Left.walkPaths(visit)
+	for _, r := range e.Right {
+		r.Right.walkPaths(visit)
+	}
+}
+
+func (e *MultOp) walkPaths(visit func(*JSONPath)) {
+	e.Left.walkPaths(visit)
+	for _, r := range e.Right {
+		r.Right.walkPaths(visit)
+	}
+}
+
+func (e *UnaryTerm) walkPaths(visit func(*JSONPath)) {
+	if e.Negated != nil {
+		e.Negated.Term.walkPaths(visit)
+	} else {
+		e.Primary.walkPaths(visit)
+	}
+}
+
+func (e *PrimaryTerm) walkPaths(visit func(*JSONPath)) {
+	if e == nil {
+		return
+	}
+	switch {
+	case e.JPathExpr != nil:
+		visit(e.JPathExpr)
+	case e.ListExpr != nil:
+		for _, ex := range e.ListExpr.Elements {
+			ex.walkPaths(visit)
+		}
+	case e.SubExpression != nil:
+		e.SubExpression.walkPaths(visit)
+	case e.FuncCall != nil:
+		e.FuncCall.walkPaths(visit)
+	}
+}
+
+func (e *FuncExpr) walkPaths(visit func(*JSONPath)) {
+	switch {
+	case e.SFunc != nil:
+		for _, arg := range e.SFunc.ArgsList {
+			arg.walkPaths(visit)
+		}
+	case e.Count != nil:
+		if e.Count.ExprArg != nil {
+			e.Count.ExprArg.walkPaths(visit)
+		}
+	case e.Cast != nil:
+		e.Cast.Expr.walkPaths(visit)
+	case e.Substring != nil:
+		e.Substring.Expr.walkPaths(visit)
+		e.Substring.From.walkPaths(visit)
+		e.Substring.For.walkPaths(visit)
+		e.Substring.Arg2.walkPaths(visit)
+		e.Substring.Arg3.walkPaths(visit)
+	case e.Extract != nil:
+		e.Extract.From.walkPaths(visit)
+	case e.Trim != nil:
+		e.Trim.TrimChars.walkPaths(visit)
+		e.Trim.TrimFrom.walkPaths(visit)
+	case e.DateAdd != nil:
+		e.DateAdd.Quantity.walkPaths(visit)
+		e.DateAdd.Timestamp.walkPaths(visit)
+	case e.DateDiff != nil:
+		e.DateDiff.Timestamp1.walkPaths(visit)
+		e.DateDiff.Timestamp2.walkPaths(visit)
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestColumns(t *testing.T) {
+	cases := []struct {
+		query   string
+		columns []string
+	}{
+		{"SELECT * FROM S3Object", nil},
+		{"SELECT s.* FROM S3Object s", nil},
+		{"SELECT COUNT(*) FROM S3Object", nil},
+		{"SELECT one, two FROM S3Object", []string{"one", "two"}},
+		{"SELECT s.one FROM S3Object s WHERE s.three > 2", []string{"one", "three"}},
+		{`SELECT s."two" FROM S3Object s WHERE s.one['x'] = 'a'`, []string{"one", "two"}},
+		{"SELECT UPPER(two) FROM S3Object WHERE NOT (one BETWEEN 1 AND three)", []string{"one", "three", "two"}},
+		{"SELECT SUBSTRING(two, one, three) FROM S3Object", []string{"one", "three", "two"}},
+		{"SELECT s[0] FROM S3Object s", nil},
+	}
+	for i, tc := range cases {
+		stmt, err := ParseSelectStatement(tc.query)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if columns := stmt.Columns(); !reflect.DeepEqual(columns, tc.columns) {
+			t.Errorf("%d: expected %v, got %v", i, tc.columns, columns)
+		}
+	}
+}
+
+func TestWherePredicates(t *testing.T) {
+	cases := []struct {
+		query      string
+		predicates []string
+	}{
+		{"SELECT * FROM S3Object", nil},
+		{"SELECT * FROM S3Object WHERE one > 2", []string{"one > 2"}},
+		{"SELECT * FROM S3Object s WHERE s.one >= -2.5 AND 'b' < s.two", []string{"one >= -2.5", "two > b"}},
+		{"SELECT * FROM S3Object WHERE one BETWEEN 1 AND 3", []string{"one >= 1", "one <= 3"}},
+		{"SELECT * FROM S3Object WHERE one > 2 OR two = 'b'", nil},
+		{"SELECT * FROM S3Object WHERE one <> 2 AND NOT two = 'b' AND one + 1 > 2 AND one > two", nil},
+		{"SELECT * FROM S3Object WHERE one NOT BETWEEN 1 AND 3 AND (two = 'b')", nil},
+		{"SELECT * FROM S3Object s WHERE s.one.two = 1 AND one = TRUE", nil},
+	}
+	for i, tc := range cases {
+		stmt, err := ParseSelectStatement(tc.query)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		var predicates []string
+		for _, p := range stmt.WherePredicates() {
+			predicates = append(predicates, fmt.Sprintf("%s %s %s", p.Column, p.Operator, p.Value.CSVString()))
+		}
+		if !reflect.DeepEqual(predicates, tc.predicates) {
+			t.Errorf("%d: expected %v, got %v", i, tc.predicates, predicates)
+		}
+	}
+}